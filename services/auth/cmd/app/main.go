@@ -0,0 +1,101 @@
+package main
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/dzhordano/ecom-thing/services/auth/internal/application/service"
+	"github.com/dzhordano/ecom-thing/services/auth/internal/config"
+	"github.com/dzhordano/ecom-thing/services/auth/internal/infrastructure/hasher"
+	"github.com/dzhordano/ecom-thing/services/auth/internal/infrastructure/keys"
+	"github.com/dzhordano/ecom-thing/services/auth/internal/infrastructure/repository/pg"
+	"github.com/dzhordano/ecom-thing/services/auth/internal/infrastructure/tracing/tracer"
+	grpc_server "github.com/dzhordano/ecom-thing/services/auth/internal/interfaces/grpc_server"
+	"github.com/dzhordano/ecom-thing/services/auth/internal/interfaces/grpc_server/interceptors"
+	"github.com/dzhordano/ecom-thing/services/auth/pkg/logger"
+)
+
+func main() {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	cfg := config.MustNew()
+
+	log := logger.MustInit(
+		cfg.Logger.Level,
+		cfg.Logger.LogFile,
+		cfg.Logger.Encoding,
+		cfg.Logger.Development,
+	)
+	defer log.Sync()
+
+	db := pg.MustNewPGXPool(ctx, cfg.PG.DSN())
+	defer db.Close()
+
+	tp, err := tracer.NewTracerProvider(cfg.Tracing.URL, "auth")
+	if err != nil {
+		log.Panic("error creating tracer provider", "error", err)
+	}
+	tracer.SetGlobalTracerProvider(tp)
+	defer func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := tp.Shutdown(ctx); err != nil {
+			log.Error("error shutting down tracer provider", "error", err)
+			return
+		}
+		log.Debug("tracer provider closed")
+	}()
+
+	km := keys.NewManager(log, pg.NewKeyRepository(db), keys.Config{
+		AccessTTL:      cfg.Tokens.AccessTTL,
+		RotationPeriod: cfg.Tokens.KeyRotationPeriod,
+		JWKSFile:       cfg.Tokens.JWKSFile,
+	})
+	if err := km.Init(ctx); err != nil {
+		log.Error("error initializing signing keys", "error", err)
+		return
+	}
+	go km.Run(ctx, cfg.Tokens.KeyCheckInterval)
+
+	svc := service.NewAuthService(
+		log,
+		pg.NewUserRepository(db),
+		pg.NewTokenRepository(db),
+		hasher.NewBcryptHasher(cfg.Tokens.BcryptCost),
+		km,
+		cfg.Tokens.RefreshTTL,
+	)
+
+	q := make(chan os.Signal, 1)
+	signal.Notify(q, syscall.SIGTERM, syscall.SIGINT, os.Interrupt)
+
+	srv := grpc_server.MustNew(
+		log,
+		grpc_server.NewAuthHandler(svc),
+		grpc_server.WithAddr(cfg.GRPC.Addr()),
+		grpc_server.WithRateLimiter(cfg.RateLimiter.Limit, cfg.RateLimiter.Burst),
+		grpc_server.WithCircuitBreakerSettings(
+			cfg.CircuitBreaker.MaxRequests,
+			cfg.CircuitBreaker.Interval,
+			cfg.CircuitBreaker.Timeout,
+		),
+		grpc_server.WithTracerProvider(tp),
+		grpc_server.WithAuthenticator(interceptors.NewAuthenticator(km.KeyFunc)),
+		grpc_server.WithJWKSProvider(km),
+	)
+
+	go func() {
+		if err := srv.Run(ctx); err != nil {
+			log.Panic("error running server", "error", err)
+		}
+	}()
+
+	<-q
+	cancel()
+	srv.GracefulStop()
+
+	log.Info("graceful shutdown completed")
+}
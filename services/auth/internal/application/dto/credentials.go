@@ -0,0 +1,11 @@
+package dto
+
+type RegisterRequest struct {
+	Email    string
+	Password string
+}
+
+type LoginRequest struct {
+	Email    string
+	Password string
+}
@@ -0,0 +1,32 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"github.com/dzhordano/ecom-thing/services/auth/internal/application/dto"
+	"github.com/dzhordano/ecom-thing/services/auth/internal/domain"
+)
+
+type PasswordHasher interface {
+	Hash(password string) (string, error)
+	// Compare returns error if password does not match hash.
+	Compare(hash, password string) error
+}
+
+// TokenIssuer signs access tokens with current signing key.
+type TokenIssuer interface {
+	Issue(p domain.Principal) (token string, expiresAt time.Time, err error)
+}
+
+// AuthService registers users and issues token pairs for them.
+//
+// GetMe operates on behalf of domain.Principal stored in context.
+type AuthService interface {
+	Register(ctx context.Context, req dto.RegisterRequest) (*domain.User, error)
+	Login(ctx context.Context, req dto.LoginRequest) (*domain.TokenPair, error)
+	Refresh(ctx context.Context, refreshToken string) (*domain.TokenPair, error)
+	// Logout revokes session refresh token belongs to, or every session of its owner if allSessions is set.
+	Logout(ctx context.Context, refreshToken string, allSessions bool) error
+	GetMe(ctx context.Context) (*domain.User, error)
+}
@@ -0,0 +1,266 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/dzhordano/ecom-thing/services/auth/internal/application/dto"
+	"github.com/dzhordano/ecom-thing/services/auth/internal/application/interfaces"
+	"github.com/dzhordano/ecom-thing/services/auth/internal/domain"
+	"github.com/dzhordano/ecom-thing/services/auth/internal/domain/repository"
+	"github.com/dzhordano/ecom-thing/services/auth/pkg/logger"
+	"github.com/google/uuid"
+)
+
+type AuthService struct {
+	log        logger.Logger
+	users      repository.UserRepository
+	tokens     repository.TokenRepository
+	hasher     interfaces.PasswordHasher
+	issuer     interfaces.TokenIssuer
+	refreshTTL time.Duration
+
+	// dummyHash is compared against on unknown email, so login time does not reveal registered emails.
+	dummyHash string
+}
+
+func NewAuthService(
+	l logger.Logger,
+	users repository.UserRepository,
+	tokens repository.TokenRepository,
+	hasher interfaces.PasswordHasher,
+	issuer interfaces.TokenIssuer,
+	refreshTTL time.Duration,
+) interfaces.AuthService {
+	dummy, err := hasher.Hash(uuid.NewString())
+	if err != nil {
+		l.Panic("failed to hash dummy password", "error", err)
+	}
+
+	return &AuthService{
+		log:        l,
+		users:      users,
+		tokens:     tokens,
+		hasher:     hasher,
+		issuer:     issuer,
+		refreshTTL: refreshTTL,
+		dummyHash:  dummy,
+	}
+}
+
+// Register implements interfaces.AuthService.
+func (a *AuthService) Register(ctx context.Context, req dto.RegisterRequest) (*domain.User, error) {
+	email := domain.NormalizeEmail(req.Email)
+
+	if err := domain.ValidateEmail(email); err != nil {
+		a.log.Error("failed to register user", "error", err)
+		return nil, domain.NewAppError(err, err.Error())
+	}
+
+	if err := domain.ValidatePassword(req.Password); err != nil {
+		a.log.Error("failed to register user", "error", err)
+		return nil, domain.NewAppError(err, err.Error())
+	}
+
+	hash, err := a.hasher.Hash(req.Password)
+	if err != nil {
+		a.log.Error("failed to register user", "error", err)
+		return nil, domain.NewAppError(err, "failed to hash password")
+	}
+
+	user, err := domain.NewUser(email, hash)
+	if err != nil {
+		a.log.Error("failed to register user", "error", err)
+		return nil, domain.NewAppError(err, "failed to create user")
+	}
+
+	if err := user.Validate(); err != nil {
+		a.log.Error("failed to register user", "error", err)
+		return nil, domain.NewAppError(err, err.Error())
+	}
+
+	if err := a.users.Save(ctx, user); err != nil {
+		a.log.Error("failed to register user", "error", err)
+		if errors.Is(err, domain.ErrUserAlreadyExists) {
+			return nil, domain.NewAppError(err, "user already exists")
+		}
+		return nil, domain.NewAppError(err, "failed to save user")
+	}
+
+	a.log.Debug("user registered", "user_id", user.ID.String())
+
+	return user, nil
+}
+
+// Login implements interfaces.AuthService.
+func (a *AuthService) Login(ctx context.Context, req dto.LoginRequest) (*domain.TokenPair, error) {
+	user, err := a.users.GetByEmail(ctx, domain.NormalizeEmail(req.Email))
+	if err != nil {
+		a.log.Error("failed to login", "error", err)
+		if errors.Is(err, domain.ErrUserNotFound) {
+			_ = a.hasher.Compare(a.dummyHash, req.Password)
+			return nil, domain.NewAppError(domain.ErrInvalidCredentials, "invalid email or password")
+		}
+		return nil, domain.NewAppError(err, "failed to get user")
+	}
+
+	if err := a.hasher.Compare(user.PasswordHash, req.Password); err != nil {
+		a.log.Error("failed to login", "error", err, "user_id", user.ID.String())
+		return nil, domain.NewAppError(domain.ErrInvalidCredentials, "invalid email or password")
+	}
+
+	familyId, err := uuid.NewUUID()
+	if err != nil {
+		a.log.Error("failed to login", "error", err)
+		return nil, domain.NewAppError(err, "failed to create session")
+	}
+
+	refresh, plain, err := domain.NewRefreshToken(user.ID, familyId, a.refreshTTL)
+	if err != nil {
+		a.log.Error("failed to login", "error", err)
+		return nil, domain.NewAppError(err, "failed to create refresh token")
+	}
+
+	if err := a.tokens.Save(ctx, refresh); err != nil {
+		a.log.Error("failed to login", "error", err, "user_id", user.ID.String())
+		return nil, domain.NewAppError(err, "failed to save refresh token")
+	}
+
+	pair, err := a.tokenPair(user, refresh, plain)
+	if err != nil {
+		a.log.Error("failed to login", "error", err, "user_id", user.ID.String())
+		return nil, err
+	}
+
+	a.log.Debug("user logged in", "user_id", user.ID.String())
+
+	return pair, nil
+}
+
+// Refresh implements interfaces.AuthService.
+func (a *AuthService) Refresh(ctx context.Context, refreshToken string) (*domain.TokenPair, error) {
+	old, err := a.activeRefreshToken(ctx, refreshToken)
+	if err != nil {
+		a.log.Error("failed to refresh token", "error", err)
+		return nil, err
+	}
+
+	user, err := a.users.GetById(ctx, old.UserID)
+	if err != nil {
+		a.log.Error("failed to refresh token", "error", err, "user_id", old.UserID.String())
+		return nil, domain.NewAppError(err, "failed to get user")
+	}
+
+	refresh, plain, err := domain.NewRefreshToken(user.ID, old.FamilyID, a.refreshTTL)
+	if err != nil {
+		a.log.Error("failed to refresh token", "error", err)
+		return nil, domain.NewAppError(err, "failed to create refresh token")
+	}
+
+	if err := a.tokens.Rotate(ctx, old, refresh); err != nil {
+		a.log.Error("failed to refresh token", "error", err, "user_id", user.ID.String())
+		if errors.Is(err, domain.ErrInvalidToken) {
+			return nil, domain.NewAppError(err, "invalid refresh token")
+		}
+		return nil, domain.NewAppError(err, "failed to rotate refresh token")
+	}
+
+	pair, err := a.tokenPair(user, refresh, plain)
+	if err != nil {
+		a.log.Error("failed to refresh token", "error", err, "user_id", user.ID.String())
+		return nil, err
+	}
+
+	a.log.Debug("token refreshed", "user_id", user.ID.String())
+
+	return pair, nil
+}
+
+// Logout implements interfaces.AuthService.
+func (a *AuthService) Logout(ctx context.Context, refreshToken string, allSessions bool) error {
+	token, err := a.activeRefreshToken(ctx, refreshToken)
+	if err != nil {
+		a.log.Error("failed to logout", "error", err)
+		return err
+	}
+
+	now := time.Now().UTC()
+
+	if allSessions {
+		err = a.tokens.RevokeByUser(ctx, token.UserID, now)
+	} else {
+		err = a.tokens.RevokeFamily(ctx, token.FamilyID, now)
+	}
+	if err != nil {
+		a.log.Error("failed to logout", "error", err, "user_id", token.UserID.String())
+		return domain.NewAppError(err, "failed to revoke refresh token")
+	}
+
+	a.log.Debug("user logged out", "user_id", token.UserID.String(), "all_sessions", allSessions)
+
+	return nil
+}
+
+// GetMe implements interfaces.AuthService.
+func (a *AuthService) GetMe(ctx context.Context) (*domain.User, error) {
+	p, ok := domain.PrincipalFromContext(ctx)
+	if !ok {
+		a.log.Error("failed to get user", "error", domain.ErrUnauthenticated)
+		return nil, domain.NewAppError(domain.ErrUnauthenticated, "unauthenticated")
+	}
+
+	user, err := a.users.GetById(ctx, p.UserID)
+	if err != nil {
+		a.log.Error("failed to get user", "error", err, "user_id", p.UserID.String())
+		return nil, domain.NewAppError(err, "failed to get user")
+	}
+
+	return user, nil
+}
+
+// activeRefreshToken looks up refresh token and checks it can still be used.
+//
+// Presenting already rotated token means it leaked, so whole family gets revoked.
+func (a *AuthService) activeRefreshToken(ctx context.Context, refreshToken string) (*domain.RefreshToken, error) {
+	token, err := a.tokens.GetByHash(ctx, domain.HashRefreshToken(refreshToken))
+	if err != nil {
+		if errors.Is(err, domain.ErrTokenNotFound) {
+			return nil, domain.NewAppError(domain.ErrInvalidToken, "invalid refresh token")
+		}
+		return nil, domain.NewAppError(err, "failed to get refresh token")
+	}
+
+	now := time.Now().UTC()
+
+	if token.IsRevoked() {
+		a.log.Warn("revoked refresh token reused, revoking family",
+			"user_id", token.UserID.String(),
+			"family_id", token.FamilyID.String(),
+		)
+		if err := a.tokens.RevokeFamily(ctx, token.FamilyID, now); err != nil {
+			return nil, domain.NewAppError(err, "failed to revoke refresh token")
+		}
+		return nil, domain.NewAppError(domain.ErrInvalidToken, "invalid refresh token")
+	}
+
+	if token.IsExpired(now) {
+		return nil, domain.NewAppError(domain.ErrInvalidToken, "refresh token expired")
+	}
+
+	return token, nil
+}
+
+func (a *AuthService) tokenPair(user *domain.User, refresh *domain.RefreshToken, plain string) (*domain.TokenPair, error) {
+	access, expiresAt, err := a.issuer.Issue(user.Principal())
+	if err != nil {
+		return nil, domain.NewAppError(err, "failed to issue access token")
+	}
+
+	return &domain.TokenPair{
+		AccessToken:      access,
+		AccessExpiresAt:  expiresAt,
+		RefreshToken:     plain,
+		RefreshExpiresAt: refresh.ExpiresAt,
+	}, nil
+}
@@ -0,0 +1,101 @@
+package config
+
+import (
+	"log"
+	"net"
+	"time"
+
+	"github.com/ilyakaznacheev/cleanenv"
+	"github.com/joho/godotenv"
+)
+
+type Config struct {
+	Environment      string `env:"APP_ENV" env-default:"local"`
+	Logger           LoggerConfig
+	GRPC             GRPCServiceConfig `env-prefix:"GRPC_"`
+	PG               PostgresConfig
+	RateLimiter      RateLimiterConfig
+	CircuitBreaker   CircuitBreakerConfig
+	Tracing          TracingConfig
+	Tokens           TokensConfig
+	ProfilingEnabled bool `env:"PROFILING_ENABLED" env-default:"false"`
+}
+
+type LoggerConfig struct {
+	Development bool   `env:"LOG_DEVELOPMENT" end-default:"false"`
+	Level       string `env:"LOG_LEVEL" env-default:"debug"`
+	LogFile     string `env:"LOG_OUTPUT_FILE" env-default:"logs/auth.log"`
+	Encoding    string `env:"LOG_ENCODING" env-default:"json"`
+}
+
+// Базовая конфигурация для gRPC-сервисов.
+type GRPCServiceConfig struct {
+	Host string `env:"HOST" env-default:"localhost"`
+	Port string `env:"PORT"`
+}
+
+// Addr возвращает корректно сформированный адрес.
+func (g GRPCServiceConfig) Addr() string {
+	return net.JoinHostPort(g.Host, g.Port)
+}
+
+// TODO maybe порт тестовой бд тоже нужен
+type PostgresConfig struct {
+	Host     string `env:"PG_HOST" env-default:"localhost"`
+	Port     string `env:"PG_PORT" env-default:"5432"`
+	User     string `env:"PG_USER" env-default:"postgres"`
+	Password string `env:"PG_PASSWORD" env-default:"postgres"`
+	DBName   string `env:"PG_DBNAME" env-default:"postgres"`
+	SSLMode  string `env:"PG_SSLMODE" env-default:"disable"`
+}
+
+func (p *PostgresConfig) DSN() string {
+	return "host=" + p.Host + " port=" + p.Port + " user=" + p.User + " password=" + p.Password + " dbname=" + p.DBName + " sslmode=" + p.SSLMode
+}
+
+func (p *PostgresConfig) URL() string {
+	return "postgres://" + p.User + ":" + p.Password + "@" + p.Host + ":" + p.Port + "/" + p.DBName + "?sslmode=" + p.SSLMode
+}
+
+type RateLimiterConfig struct {
+	Limit int `env:"RATE_LIMITER_LIMIT" env-default:"150"`
+	Burst int `env:"RATE_LIMITER_BURST" env-default:"150"`
+}
+
+type CircuitBreakerConfig struct {
+	MaxRequests uint32        `env:"CIRCUIT_BREAKER_MAX_REQUESTS" env-default:"5"`
+	Interval    time.Duration `env:"CIRCUIT_BREAKER_INTERVAL" env-default:"60s"`
+	Timeout     time.Duration `env:"CIRCUIT_BREAKER_TIMEOUT" env-default:"5s"`
+}
+
+type TracingConfig struct {
+	URL string `env:"JAEGER_EXP_URL" env-default:"http://localhost:14268/api/traces"`
+}
+
+// TokensConfig describes issued tokens and signing key rotation.
+type TokensConfig struct {
+	AccessTTL  time.Duration `env:"ACCESS_TOKEN_TTL" env-default:"15m"`
+	RefreshTTL time.Duration `env:"REFRESH_TOKEN_TTL" env-default:"720h"`
+	// How long one key signs tokens before being replaced.
+	KeyRotationPeriod time.Duration `env:"SIGNING_KEY_ROTATION_PERIOD" env-default:"24h"`
+	// How often rotation is checked. Also picks up keys rotated by other instances.
+	KeyCheckInterval time.Duration `env:"SIGNING_KEY_CHECK_INTERVAL" env-default:"1m"`
+	// Public keys are written here for services that read AUTH_JWKS_FILE.
+	JWKSFile   string `env:"JWKS_OUTPUT_FILE"`
+	BcryptCost int    `env:"BCRYPT_COST" env-default:"10"`
+}
+
+// MustNew Reads .env file and returns Config.
+func MustNew() *Config {
+	if err := godotenv.Load(); err != nil {
+		log.Fatalf("config: error loading .env file: %v", err)
+	}
+
+	var cfg Config
+
+	if err := cleanenv.ReadEnv(&cfg); err != nil {
+		log.Fatalf("error reading config: %v", err)
+	}
+
+	return &cfg
+}
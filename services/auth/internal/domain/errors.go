@@ -0,0 +1,83 @@
+package domain
+
+import (
+	"errors"
+
+	"google.golang.org/grpc/codes"
+)
+
+var (
+	ErrInvalidArgument    = errors.New("invalid argument")
+	ErrInvalidEmail       = errors.New("invalid email")
+	ErrInvalidPassword    = errors.New("invalid password")
+	ErrUserAlreadyExists  = errors.New("user already exists")
+	ErrUserNotFound       = errors.New("user not found")
+	ErrInvalidCredentials = errors.New("invalid credentials")
+	ErrInvalidToken       = errors.New("invalid token")
+	ErrTokenNotFound      = errors.New("token not found")
+	ErrUnauthenticated    = errors.New("unauthenticated")
+
+	// Critical ones --->
+	ErrNoSigningKey = errors.New("no signing key")
+	ErrInternal     = errors.New("internal error")
+)
+
+var CriticalErrors = map[error]struct{}{
+	ErrNoSigningKey: {},
+	ErrInternal:     {},
+}
+
+// If errors are critical, stacktrace will be included in logs.
+func CheckIfCriticalError(err error) bool {
+	_, ok := CriticalErrors[err]
+	return ok
+}
+
+type AppError struct {
+	Code error
+	Msg  string
+}
+
+func NewAppError(code error, message string) *AppError {
+	return &AppError{
+		Code: code,
+		Msg:  message,
+	}
+}
+
+func (e *AppError) Error() string {
+	return e.Msg
+}
+
+func (e *AppError) Unwrap() error {
+	return e.Code
+}
+
+func (e *AppError) Is(target error) bool {
+	return errors.Is(e.Code, target)
+}
+
+func (e *AppError) GRPCCode() codes.Code {
+	switch {
+	case errors.Is(e.Code, ErrInvalidArgument):
+		return codes.InvalidArgument
+	case errors.Is(e.Code, ErrInvalidEmail):
+		return codes.InvalidArgument
+	case errors.Is(e.Code, ErrInvalidPassword):
+		return codes.InvalidArgument
+	case errors.Is(e.Code, ErrUserAlreadyExists):
+		return codes.AlreadyExists
+	case errors.Is(e.Code, ErrUserNotFound):
+		return codes.NotFound
+	case errors.Is(e.Code, ErrInvalidCredentials):
+		return codes.Unauthenticated
+	case errors.Is(e.Code, ErrInvalidToken):
+		return codes.Unauthenticated
+	case errors.Is(e.Code, ErrTokenNotFound):
+		return codes.Unauthenticated
+	case errors.Is(e.Code, ErrUnauthenticated):
+		return codes.Unauthenticated
+	default:
+		return codes.Internal
+	}
+}
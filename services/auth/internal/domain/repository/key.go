@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/dzhordano/ecom-thing/services/auth/internal/domain"
+)
+
+type KeyRepository interface {
+	Save(ctx context.Context, key *domain.SigningKey) error
+	// ListActive returns keys not expired at given time, newest first.
+	ListActive(ctx context.Context, at time.Time) ([]*domain.SigningKey, error)
+	DeleteExpired(ctx context.Context, at time.Time) error
+}
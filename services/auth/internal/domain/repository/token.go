@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/dzhordano/ecom-thing/services/auth/internal/domain"
+	"github.com/google/uuid"
+)
+
+type TokenRepository interface {
+	Save(ctx context.Context, token *domain.RefreshToken) error
+	GetByHash(ctx context.Context, tokenHash string) (*domain.RefreshToken, error)
+	// Rotate revokes old token and saves its replacement atomically.
+	// Returns domain.ErrInvalidToken if old token was revoked concurrently.
+	Rotate(ctx context.Context, old, replacement *domain.RefreshToken) error
+	RevokeFamily(ctx context.Context, familyId uuid.UUID, at time.Time) error
+	RevokeByUser(ctx context.Context, userId uuid.UUID, at time.Time) error
+}
@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/dzhordano/ecom-thing/services/auth/internal/domain"
+	"github.com/google/uuid"
+)
+
+type UserRepository interface {
+	Save(ctx context.Context, user *domain.User) error
+	GetById(ctx context.Context, userId uuid.UUID) (*domain.User, error)
+	GetByEmail(ctx context.Context, email string) (*domain.User, error)
+}
@@ -0,0 +1,40 @@
+package domain
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const SigningKeyBits = 2048
+
+// SigningKey is RSA key used to sign access tokens.
+//
+// Newest key is used for signing. Older keys stay published until ExpiresAt,
+// so tokens signed before rotation remain verifiable.
+type SigningKey struct {
+	ID         string
+	PrivateKey *rsa.PrivateKey
+	CreatedAt  time.Time
+	ExpiresAt  time.Time
+}
+
+func NewSigningKey(createdAt time.Time, lifetime time.Duration) (*SigningKey, error) {
+	pk, err := rsa.GenerateKey(rand.Reader, SigningKeyBits)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SigningKey{
+		ID:         uuid.NewString(),
+		PrivateKey: pk,
+		CreatedAt:  createdAt,
+		ExpiresAt:  createdAt.Add(lifetime),
+	}, nil
+}
+
+func (k *SigningKey) IsExpired(now time.Time) bool {
+	return !now.Before(k.ExpiresAt)
+}
@@ -0,0 +1,74 @@
+package domain
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const refreshTokenBytes = 32
+
+// TokenPair is returned to client on login and refresh.
+type TokenPair struct {
+	AccessToken      string
+	AccessExpiresAt  time.Time
+	RefreshToken     string
+	RefreshExpiresAt time.Time
+}
+
+// RefreshToken is a persisted opaque refresh token. Only its hash is stored.
+//
+// Every refresh rotates token: old one is revoked and points to its replacement.
+// Tokens issued from one login share FamilyID, so reuse of revoked token revokes the whole chain.
+type RefreshToken struct {
+	ID         uuid.UUID
+	UserID     uuid.UUID
+	FamilyID   uuid.UUID
+	TokenHash  string
+	ExpiresAt  time.Time
+	CreatedAt  time.Time
+	RevokedAt  *time.Time
+	ReplacedBy *uuid.UUID
+}
+
+// NewRefreshToken generates token for user. Returns entity to be stored and plain token for client.
+func NewRefreshToken(userId, familyId uuid.UUID, ttl time.Duration) (*RefreshToken, string, error) {
+	id, err := uuid.NewUUID()
+	if err != nil {
+		return nil, "", err
+	}
+
+	b := make([]byte, refreshTokenBytes)
+	if _, err := rand.Read(b); err != nil {
+		return nil, "", err
+	}
+
+	plain := base64.RawURLEncoding.EncodeToString(b)
+	now := time.Now().UTC()
+
+	return &RefreshToken{
+		ID:        id,
+		UserID:    userId,
+		FamilyID:  familyId,
+		TokenHash: HashRefreshToken(plain),
+		ExpiresAt: now.Add(ttl),
+		CreatedAt: now,
+	}, plain, nil
+}
+
+func HashRefreshToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func (t *RefreshToken) IsRevoked() bool {
+	return t.RevokedAt != nil
+}
+
+func (t *RefreshToken) IsExpired(now time.Time) bool {
+	return !now.Before(t.ExpiresAt)
+}
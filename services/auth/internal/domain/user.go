@@ -0,0 +1,113 @@
+package domain
+
+import (
+	"fmt"
+	"net/mail"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	MaxEmailLength    = 254
+	MinPasswordLength = 8
+	MaxPasswordLength = 72 // bcrypt ignores bytes past 72.
+)
+
+type User struct {
+	ID           uuid.UUID
+	Email        string
+	PasswordHash string
+	Roles        []Role
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// NewUser creates user with default role. Email is normalized.
+func NewUser(email, passwordHash string) (*User, error) {
+	userId, err := uuid.NewUUID()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+
+	return &User{
+		ID:           userId,
+		Email:        NormalizeEmail(email),
+		PasswordHash: passwordHash,
+		Roles:        []Role{RoleUser},
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}, nil
+}
+
+func NormalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+func ValidateEmail(email string) error {
+	if len(email) == 0 || len(email) > MaxEmailLength {
+		return ErrInvalidEmail
+	}
+
+	addr, err := mail.ParseAddress(email)
+	if err != nil || addr.Address != email {
+		return ErrInvalidEmail
+	}
+
+	return nil
+}
+
+func ValidatePassword(password string) error {
+	if len(password) < MinPasswordLength {
+		return fmt.Errorf("%w: must be at least %d characters", ErrInvalidPassword, MinPasswordLength)
+	}
+
+	if len(password) > MaxPasswordLength {
+		return fmt.Errorf("%w: must be at most %d bytes", ErrInvalidPassword, MaxPasswordLength)
+	}
+
+	return nil
+}
+
+func (u *User) Validate() error {
+	var errs []string
+
+	if err := ValidateEmail(u.Email); err != nil {
+		errs = append(errs, err.Error())
+	}
+
+	if u.PasswordHash == "" {
+		errs = append(errs, "password hash is required")
+	}
+
+	for _, r := range u.Roles {
+		if r == RoleSystem {
+			errs = append(errs, "system role can not be assigned to user")
+		}
+	}
+
+	if u.CreatedAt.After(u.UpdatedAt) {
+		errs = append(errs, "created at must be before updated at")
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("%w: %s", ErrInvalidArgument, strings.Join(errs, ", "))
+	}
+
+	return nil
+}
+
+func (u *User) HasRole(r Role) bool {
+	return slices.Contains(u.Roles, r)
+}
+
+func (u *User) Principal() Principal {
+	return Principal{
+		UserID: u.ID,
+		Roles:  u.Roles,
+	}
+}
@@ -0,0 +1,36 @@
+package hasher
+
+import (
+	"github.com/dzhordano/ecom-thing/services/auth/internal/application/interfaces"
+	"golang.org/x/crypto/bcrypt"
+)
+
+type BcryptHasher struct {
+	cost int
+}
+
+// NewBcryptHasher creates hasher with given cost. Zero cost means bcrypt.DefaultCost.
+func NewBcryptHasher(cost int) interfaces.PasswordHasher {
+	if cost == 0 {
+		cost = bcrypt.DefaultCost
+	}
+
+	return &BcryptHasher{
+		cost: cost,
+	}
+}
+
+// Hash implements interfaces.PasswordHasher.
+func (b *BcryptHasher) Hash(password string) (string, error) {
+	h, err := bcrypt.GenerateFromPassword([]byte(password), b.cost)
+	if err != nil {
+		return "", err
+	}
+
+	return string(h), nil
+}
+
+// Compare implements interfaces.PasswordHasher.
+func (b *BcryptHasher) Compare(hash, password string) error {
+	return bcrypt.CompareHashAndPassword([]byte(hash), []byte(password))
+}
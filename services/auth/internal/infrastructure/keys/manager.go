@@ -0,0 +1,245 @@
+package keys
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/dzhordano/ecom-thing/services/auth/internal/application/interfaces"
+	"github.com/dzhordano/ecom-thing/services/auth/internal/domain"
+	"github.com/dzhordano/ecom-thing/services/auth/internal/domain/repository"
+	"github.com/dzhordano/ecom-thing/services/auth/pkg/logger"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+)
+
+const Issuer = "ecom-thing-auth"
+
+// Claims are access token claims. Other services parse the same layout.
+type Claims struct {
+	Roles []string `json:"roles"`
+	jwt.RegisteredClaims
+}
+
+type Config struct {
+	AccessTTL      time.Duration
+	RotationPeriod time.Duration
+	// JWKSFile is rewritten with public keys after every change, if set.
+	JWKSFile string
+}
+
+// Manager signs access tokens and rotates signing keys.
+//
+// Key is used for signing during RotationPeriod and published for AccessTTL more,
+// so every token it signed can still be verified after rotation.
+type Manager struct {
+	log  logger.Logger
+	repo repository.KeyRepository
+	cfg  Config
+
+	mu   sync.RWMutex
+	keys []*domain.SigningKey // Newest first.
+
+	now func() time.Time
+}
+
+func NewManager(log logger.Logger, repo repository.KeyRepository, cfg Config) *Manager {
+	return &Manager{
+		log:  log,
+		repo: repo,
+		cfg:  cfg,
+		now: func() time.Time {
+			return time.Now().UTC()
+		},
+	}
+}
+
+var _ interfaces.TokenIssuer = (*Manager)(nil)
+
+// Init loads keys from storage and creates first one if needed. Must be called before Issue.
+func (m *Manager) Init(ctx context.Context) error {
+	return m.Rotate(ctx)
+}
+
+// Run checks whether signing key should be rotated every interval until ctx is done.
+func (m *Manager) Run(ctx context.Context, interval time.Duration) {
+	t := time.NewTicker(interval)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+			if err := m.Rotate(ctx); err != nil {
+				m.log.Error("failed to rotate signing keys", "error", err)
+			}
+		}
+	}
+}
+
+// Rotate reloads keys from storage, so keys created by other instances are picked up,
+// and creates new signing key if newest one is older than rotation period.
+func (m *Manager) Rotate(ctx context.Context) error {
+	now := m.now()
+
+	if err := m.repo.DeleteExpired(ctx, now); err != nil {
+		return fmt.Errorf("delete expired keys: %w", err)
+	}
+
+	keys, err := m.repo.ListActive(ctx, now)
+	if err != nil {
+		return fmt.Errorf("list keys: %w", err)
+	}
+
+	if len(keys) == 0 || !now.Before(keys[0].CreatedAt.Add(m.cfg.RotationPeriod)) {
+		key, err := domain.NewSigningKey(now, m.cfg.RotationPeriod+m.cfg.AccessTTL)
+		if err != nil {
+			return fmt.Errorf("generate key: %w", err)
+		}
+
+		if err := m.repo.Save(ctx, key); err != nil {
+			return fmt.Errorf("save key: %w", err)
+		}
+
+		keys = append([]*domain.SigningKey{key}, keys...)
+
+		m.log.Info("signing key rotated", "kid", key.ID)
+	}
+
+	m.mu.Lock()
+	m.keys = keys
+	m.mu.Unlock()
+
+	if m.cfg.JWKSFile != "" {
+		if err := m.writeJWKS(); err != nil {
+			return fmt.Errorf("write jwks: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// Issue implements interfaces.TokenIssuer.
+func (m *Manager) Issue(p domain.Principal) (string, time.Time, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	if len(m.keys) == 0 {
+		return "", time.Time{}, domain.ErrNoSigningKey
+	}
+
+	key := m.keys[0]
+	now := m.now()
+	expiresAt := now.Add(m.cfg.AccessTTL)
+
+	roles := make([]string, 0, len(p.Roles))
+	for _, r := range p.Roles {
+		roles = append(roles, string(r))
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, Claims{
+		Roles: roles,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    Issuer,
+			Subject:   p.UserID.String(),
+			ID:        uuid.NewString(),
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+		},
+	})
+	token.Header["kid"] = key.ID
+
+	signed, err := token.SignedString(key.PrivateKey)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	return signed, expiresAt, nil
+}
+
+// KeyFunc resolves public key for token verification by its kid.
+func (m *Manager) KeyFunc(t *jwt.Token) (any, error) {
+	kid, _ := t.Header["kid"].(string)
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	for _, k := range m.keys {
+		if k.ID == kid {
+			return &k.PrivateKey.PublicKey, nil
+		}
+	}
+
+	return nil, fmt.Errorf("unknown key id %q", kid)
+}
+
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+}
+
+// JWKS returns public keys as JSON Web Key Set.
+func (m *Manager) JWKS() ([]byte, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	set := struct {
+		Keys []jwk `json:"keys"`
+	}{
+		Keys: make([]jwk, 0, len(m.keys)),
+	}
+
+	for _, k := range m.keys {
+		pub := k.PrivateKey.PublicKey
+		set.Keys = append(set.Keys, jwk{
+			Kty: "RSA",
+			Kid: k.ID,
+			Use: "sig",
+			Alg: "RS256",
+			N:   base64.RawURLEncoding.EncodeToString(pub.N.Bytes()),
+			E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes()),
+		})
+	}
+
+	return json.Marshal(set)
+}
+
+// writeJWKS replaces JWKS file atomically, so readers never see partial content.
+func (m *Manager) writeJWKS() error {
+	data, err := m.JWKS()
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(m.cfg.JWKSFile), ".jwks-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	if err := os.Chmod(tmp.Name(), 0o644); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), m.cfg.JWKSFile)
+}
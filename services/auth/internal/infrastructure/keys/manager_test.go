@@ -0,0 +1,153 @@
+package keys
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/dzhordano/ecom-thing/services/auth/internal/domain"
+	"github.com/dzhordano/ecom-thing/services/auth/pkg/logger"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type memKeyRepository struct {
+	keys []*domain.SigningKey
+}
+
+func (r *memKeyRepository) Save(_ context.Context, key *domain.SigningKey) error {
+	r.keys = append(r.keys, key)
+	return nil
+}
+
+func (r *memKeyRepository) ListActive(_ context.Context, at time.Time) ([]*domain.SigningKey, error) {
+	var active []*domain.SigningKey
+	for _, k := range r.keys {
+		if !k.IsExpired(at) {
+			active = append(active, k)
+		}
+	}
+	sort.Slice(active, func(i, j int) bool {
+		return active[i].CreatedAt.After(active[j].CreatedAt)
+	})
+	return active, nil
+}
+
+func (r *memKeyRepository) DeleteExpired(_ context.Context, at time.Time) error {
+	var kept []*domain.SigningKey
+	for _, k := range r.keys {
+		if !k.IsExpired(at) {
+			kept = append(kept, k)
+		}
+	}
+	r.keys = kept
+	return nil
+}
+
+func newTestManager(t *testing.T, cfg Config) (*Manager, *memKeyRepository, *time.Time) {
+	t.Helper()
+
+	repo := &memKeyRepository{}
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	m := NewManager(logger.MustInit(logger.LevelError, filepath.Join(t.TempDir(), "keys-test.log"), "json", false), repo, cfg)
+	m.now = func() time.Time { return now }
+
+	return m, repo, &now
+}
+
+func parse(t *testing.T, m *Manager, token string, at time.Time) (*Claims, error) {
+	t.Helper()
+
+	var claims Claims
+	_, err := jwt.ParseWithClaims(token, &claims, m.KeyFunc,
+		jwt.WithValidMethods([]string{"RS256"}),
+		jwt.WithTimeFunc(func() time.Time { return at }),
+	)
+	return &claims, err
+}
+
+func TestManager_Rotate(t *testing.T) {
+	cfg := Config{
+		AccessTTL:      15 * time.Minute,
+		RotationPeriod: time.Hour,
+	}
+	m, repo, now := newTestManager(t, cfg)
+	ctx := context.Background()
+
+	require.NoError(t, m.Init(ctx))
+	require.Len(t, repo.keys, 1)
+	first := repo.keys[0].ID
+
+	// Still within rotation period, key is kept.
+	*now = now.Add(50 * time.Minute)
+	require.NoError(t, m.Rotate(ctx))
+	assert.Len(t, repo.keys, 1)
+
+	p := domain.Principal{UserID: uuid.New(), Roles: []domain.Role{domain.RoleUser}}
+	oldToken, _, err := m.Issue(p)
+	require.NoError(t, err)
+
+	// Rotation period passed, new key is created and old one is still published.
+	*now = now.Add(10 * time.Minute)
+	require.NoError(t, m.Rotate(ctx))
+	require.Len(t, repo.keys, 2)
+	assert.Equal(t, first, m.keys[1].ID)
+	assert.NotEqual(t, first, m.keys[0].ID)
+
+	_, err = parse(t, m, oldToken, *now)
+	assert.NoError(t, err, "token signed with previous key must stay valid")
+
+	newToken, _, err := m.Issue(p)
+	require.NoError(t, err)
+
+	claims, err := parse(t, m, newToken, *now)
+	require.NoError(t, err)
+	assert.Equal(t, p.UserID.String(), claims.Subject)
+	assert.Equal(t, []string{"user"}, claims.Roles)
+	assert.Equal(t, Issuer, claims.Issuer)
+
+	// Old key outlived rotation period plus access ttl and gets removed.
+	*now = now.Add(cfg.AccessTTL)
+	require.NoError(t, m.Rotate(ctx))
+	require.Len(t, m.keys, 1)
+	assert.NotEqual(t, first, m.keys[0].ID)
+
+	_, err = parse(t, m, oldToken, *now)
+	assert.Error(t, err)
+}
+
+func TestManager_Issue_NoKey(t *testing.T) {
+	m, _, _ := newTestManager(t, Config{AccessTTL: time.Minute, RotationPeriod: time.Hour})
+
+	_, _, err := m.Issue(domain.Principal{UserID: uuid.New()})
+	assert.ErrorIs(t, err, domain.ErrNoSigningKey)
+}
+
+func TestManager_WritesJWKS(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "jwks.json")
+
+	m, _, _ := newTestManager(t, Config{
+		AccessTTL:      time.Minute,
+		RotationPeriod: time.Hour,
+		JWKSFile:       file,
+	})
+	require.NoError(t, m.Init(context.Background()))
+
+	data, err := os.ReadFile(file)
+	require.NoError(t, err)
+
+	var set struct {
+		Keys []jwk `json:"keys"`
+	}
+	require.NoError(t, json.Unmarshal(data, &set))
+	require.Len(t, set.Keys, 1)
+	assert.Equal(t, m.keys[0].ID, set.Keys[0].Kid)
+	assert.Equal(t, "RS256", set.Keys[0].Alg)
+}
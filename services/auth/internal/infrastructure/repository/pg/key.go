@@ -0,0 +1,112 @@
+package pg
+
+import (
+	"context"
+	"crypto/x509"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/dzhordano/ecom-thing/services/auth/internal/domain"
+	"github.com/dzhordano/ecom-thing/services/auth/internal/domain/repository"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const signingKeysTable = "signing_keys"
+
+type KeyRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewKeyRepository(db *pgxpool.Pool) repository.KeyRepository {
+	return &KeyRepository{
+		db: db,
+	}
+}
+
+// Save implements repository.KeyRepository.
+func (r *KeyRepository) Save(ctx context.Context, key *domain.SigningKey) error {
+	const op = "repository.KeyRepository.Save"
+
+	insQuery := sq.Insert(signingKeysTable).
+		Columns("id", "private_key", "created_at", "expires_at").
+		Values(key.ID, x509.MarshalPKCS1PrivateKey(key.PrivateKey), key.CreatedAt, key.ExpiresAt).
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := insQuery.ToSql()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if _, err := r.db.Exec(ctx, query, args...); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// ListActive implements repository.KeyRepository.
+func (r *KeyRepository) ListActive(ctx context.Context, at time.Time) ([]*domain.SigningKey, error) {
+	const op = "repository.KeyRepository.ListActive"
+
+	selQuery := sq.Select("id", "private_key", "created_at", "expires_at").
+		From(signingKeysTable).
+		Where(sq.Gt{"expires_at": at}).
+		OrderBy("created_at DESC").
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := selQuery.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var keys []*domain.SigningKey
+	for rows.Next() {
+		var (
+			key domain.SigningKey
+			der []byte
+		)
+		if err := rows.Scan(&key.ID, &der, &key.CreatedAt, &key.ExpiresAt); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		key.PrivateKey, err = x509.ParsePKCS1PrivateKey(der)
+		if err != nil {
+			return nil, fmt.Errorf("%s: key %s: %w", op, key.ID, err)
+		}
+
+		keys = append(keys, &key)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return keys, nil
+}
+
+// DeleteExpired implements repository.KeyRepository.
+func (r *KeyRepository) DeleteExpired(ctx context.Context, at time.Time) error {
+	const op = "repository.KeyRepository.DeleteExpired"
+
+	delQuery := sq.Delete(signingKeysTable).
+		Where(sq.LtOrEq{"expires_at": at}).
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := delQuery.ToSql()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if _, err := r.db.Exec(ctx, query, args...); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
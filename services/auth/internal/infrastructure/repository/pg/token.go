@@ -0,0 +1,179 @@
+package pg
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/dzhordano/ecom-thing/services/auth/internal/domain"
+	"github.com/dzhordano/ecom-thing/services/auth/internal/domain/repository"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const refreshTokensTable = "refresh_tokens"
+
+type TokenRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewTokenRepository(db *pgxpool.Pool) repository.TokenRepository {
+	return &TokenRepository{
+		db: db,
+	}
+}
+
+// Save implements repository.TokenRepository.
+func (r *TokenRepository) Save(ctx context.Context, token *domain.RefreshToken) error {
+	const op = "repository.TokenRepository.Save"
+
+	if err := insertToken(ctx, r.db, token); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// GetByHash implements repository.TokenRepository.
+func (r *TokenRepository) GetByHash(ctx context.Context, tokenHash string) (*domain.RefreshToken, error) {
+	const op = "repository.TokenRepository.GetByHash"
+
+	selQuery := sq.Select("id", "user_id", "family_id", "token_hash", "expires_at", "created_at", "revoked_at", "replaced_by").
+		From(refreshTokensTable).
+		Where(sq.Eq{"token_hash": tokenHash}).
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := selQuery.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var token domain.RefreshToken
+	if err := r.db.QueryRow(ctx, query, args...).Scan(
+		&token.ID,
+		&token.UserID,
+		&token.FamilyID,
+		&token.TokenHash,
+		&token.ExpiresAt,
+		&token.CreatedAt,
+		&token.RevokedAt,
+		&token.ReplacedBy,
+	); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%s: %w", op, domain.ErrTokenNotFound)
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &token, nil
+}
+
+// Rotate implements repository.TokenRepository.
+func (r *TokenRepository) Rotate(ctx context.Context, old, replacement *domain.RefreshToken) error {
+	const op = "repository.TokenRepository.Rotate"
+
+	return r.withTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		if err := insertToken(ctx, tx, replacement); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		// Condition on revoked_at guards against two concurrent refreshes with the same token.
+		updQuery := sq.Update(refreshTokensTable).
+			Set("revoked_at", replacement.CreatedAt).
+			Set("replaced_by", replacement.ID).
+			Where(sq.Eq{"id": old.ID, "revoked_at": nil}).
+			PlaceholderFormat(sq.Dollar)
+
+		query, args, err := updQuery.ToSql()
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		tag, err := tx.Exec(ctx, query, args...)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		if tag.RowsAffected() == 0 {
+			return fmt.Errorf("%s: %w", op, domain.ErrInvalidToken)
+		}
+
+		return nil
+	})
+}
+
+// RevokeFamily implements repository.TokenRepository.
+func (r *TokenRepository) RevokeFamily(ctx context.Context, familyId uuid.UUID, at time.Time) error {
+	const op = "repository.TokenRepository.RevokeFamily"
+
+	if err := r.revoke(ctx, sq.Eq{"family_id": familyId, "revoked_at": nil}, at); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// RevokeByUser implements repository.TokenRepository.
+func (r *TokenRepository) RevokeByUser(ctx context.Context, userId uuid.UUID, at time.Time) error {
+	const op = "repository.TokenRepository.RevokeByUser"
+
+	if err := r.revoke(ctx, sq.Eq{"user_id": userId, "revoked_at": nil}, at); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (r *TokenRepository) revoke(ctx context.Context, pred sq.Eq, at time.Time) error {
+	updQuery := sq.Update(refreshTokensTable).
+		Set("revoked_at", at).
+		Where(pred).
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := updQuery.ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.db.Exec(ctx, query, args...)
+	return err
+}
+
+type execer interface {
+	Exec(ctx context.Context, sql string, arguments ...any) (pgconn.CommandTag, error)
+}
+
+func insertToken(ctx context.Context, db execer, token *domain.RefreshToken) error {
+	insQuery := sq.Insert(refreshTokensTable).
+		Columns("id", "user_id", "family_id", "token_hash", "expires_at", "created_at").
+		Values(token.ID, token.UserID, token.FamilyID, token.TokenHash, token.ExpiresAt, token.CreatedAt).
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := insQuery.ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = db.Exec(ctx, query, args...)
+	return err
+}
+
+func (r *TokenRepository) withTx(ctx context.Context, fn func(ctx context.Context, tx pgx.Tx) error) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := fn(ctx, tx); err != nil {
+		if rbErr := tx.Rollback(ctx); rbErr != nil {
+			return rbErr
+		}
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
@@ -0,0 +1,123 @@
+package pg
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/dzhordano/ecom-thing/services/auth/internal/domain"
+	"github.com/dzhordano/ecom-thing/services/auth/internal/domain/repository"
+	"github.com/google/uuid"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const usersTable = "users"
+
+type UserRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewUserRepository(db *pgxpool.Pool) repository.UserRepository {
+	return &UserRepository{
+		db: db,
+	}
+}
+
+// Save implements repository.UserRepository.
+func (r *UserRepository) Save(ctx context.Context, user *domain.User) error {
+	const op = "repository.UserRepository.Save"
+
+	insQuery := sq.Insert(usersTable).
+		Columns("id", "email", "password_hash", "roles", "created_at", "updated_at").
+		Values(user.ID, user.Email, user.PasswordHash, rolesToStrings(user.Roles), user.CreatedAt, user.UpdatedAt).
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := insQuery.ToSql()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if _, err := r.db.Exec(ctx, query, args...); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			if pgErr.Code == pgerrcode.UniqueViolation {
+				return fmt.Errorf("%s: %w", op, domain.ErrUserAlreadyExists)
+			}
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// GetById implements repository.UserRepository.
+func (r *UserRepository) GetById(ctx context.Context, userId uuid.UUID) (*domain.User, error) {
+	const op = "repository.UserRepository.GetById"
+
+	user, err := r.getBy(ctx, sq.Eq{"id": userId})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return user, nil
+}
+
+// GetByEmail implements repository.UserRepository.
+func (r *UserRepository) GetByEmail(ctx context.Context, email string) (*domain.User, error) {
+	const op = "repository.UserRepository.GetByEmail"
+
+	user, err := r.getBy(ctx, sq.Eq{"email": email})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return user, nil
+}
+
+func (r *UserRepository) getBy(ctx context.Context, pred sq.Eq) (*domain.User, error) {
+	selQuery := sq.Select("id", "email", "password_hash", "roles", "created_at", "updated_at").
+		From(usersTable).
+		Where(pred).
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := selQuery.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		user  domain.User
+		roles []string
+	)
+	if err := r.db.QueryRow(ctx, query, args...).Scan(
+		&user.ID,
+		&user.Email,
+		&user.PasswordHash,
+		&roles,
+		&user.CreatedAt,
+		&user.UpdatedAt,
+	); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrUserNotFound
+		}
+		return nil, err
+	}
+
+	for _, role := range roles {
+		user.Roles = append(user.Roles, domain.Role(role))
+	}
+
+	return &user, nil
+}
+
+func rolesToStrings(roles []domain.Role) []string {
+	out := make([]string, 0, len(roles))
+	for _, r := range roles {
+		out = append(out, string(r))
+	}
+	return out
+}
@@ -0,0 +1,30 @@
+package converter
+
+import (
+	"github.com/dzhordano/ecom-thing/services/auth/internal/domain"
+	auth_v1 "github.com/dzhordano/ecom-thing/services/auth/pkg/api/auth/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func FromDomainToProto_User(user *domain.User) *auth_v1.User {
+	roles := make([]string, 0, len(user.Roles))
+	for _, r := range user.Roles {
+		roles = append(roles, string(r))
+	}
+
+	return &auth_v1.User{
+		Id:        user.ID.String(),
+		Email:     user.Email,
+		Roles:     roles,
+		CreatedAt: timestamppb.New(user.CreatedAt),
+	}
+}
+
+func FromDomainToProto_TokenPair(pair *domain.TokenPair) *auth_v1.TokenPair {
+	return &auth_v1.TokenPair{
+		AccessToken:      pair.AccessToken,
+		AccessExpiresAt:  timestamppb.New(pair.AccessExpiresAt),
+		RefreshToken:     pair.RefreshToken,
+		RefreshExpiresAt: timestamppb.New(pair.RefreshExpiresAt),
+	}
+}
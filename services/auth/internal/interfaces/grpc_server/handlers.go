@@ -0,0 +1,128 @@
+package grpc_server
+
+import (
+	"context"
+
+	"github.com/dzhordano/ecom-thing/services/auth/internal/application/dto"
+	"github.com/dzhordano/ecom-thing/services/auth/internal/application/interfaces"
+	"github.com/dzhordano/ecom-thing/services/auth/internal/interfaces/grpc_server/converter"
+	api "github.com/dzhordano/ecom-thing/services/auth/pkg/api/auth/v1"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type AuthHandler struct {
+	api.UnimplementedAuthServiceServer
+	service interfaces.AuthService
+}
+
+func NewAuthHandler(service interfaces.AuthService) *AuthHandler {
+	return &AuthHandler{
+		service: service,
+	}
+}
+
+func (h *AuthHandler) Register(ctx context.Context, req *api.RegisterRequest) (*api.RegisterResponse, error) {
+	span := trace.SpanFromContext(ctx)
+	defer span.End()
+
+	span.AddEvent("call service")
+
+	user, err := h.service.Register(ctx, dto.RegisterRequest{
+		Email:    req.GetEmail(),
+		Password: req.GetPassword(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	span.AddEvent("user registered",
+		trace.WithAttributes(
+			attribute.String("user_id", user.ID.String()),
+		),
+	)
+
+	return &api.RegisterResponse{
+		Id: user.ID.String(),
+	}, nil
+}
+
+func (h *AuthHandler) Login(ctx context.Context, req *api.LoginRequest) (*api.LoginResponse, error) {
+	span := trace.SpanFromContext(ctx)
+	defer span.End()
+
+	span.AddEvent("call service")
+
+	pair, err := h.service.Login(ctx, dto.LoginRequest{
+		Email:    req.GetEmail(),
+		Password: req.GetPassword(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	span.AddEvent("tokens issued")
+
+	return &api.LoginResponse{
+		Tokens: converter.FromDomainToProto_TokenPair(pair),
+	}, nil
+}
+
+func (h *AuthHandler) Refresh(ctx context.Context, req *api.RefreshRequest) (*api.RefreshResponse, error) {
+	span := trace.SpanFromContext(ctx)
+	defer span.End()
+
+	span.AddEvent("call service")
+
+	pair, err := h.service.Refresh(ctx, req.GetRefreshToken())
+	if err != nil {
+		return nil, err
+	}
+
+	span.AddEvent("tokens issued")
+
+	return &api.RefreshResponse{
+		Tokens: converter.FromDomainToProto_TokenPair(pair),
+	}, nil
+}
+
+func (h *AuthHandler) Logout(ctx context.Context, req *api.LogoutRequest) (*api.LogoutResponse, error) {
+	span := trace.SpanFromContext(ctx)
+	defer span.End()
+
+	span.AddEvent("call service",
+		trace.WithAttributes(
+			attribute.Bool("all_sessions", req.GetAllSessions()),
+		),
+	)
+
+	if err := h.service.Logout(ctx, req.GetRefreshToken(), req.GetAllSessions()); err != nil {
+		return nil, err
+	}
+
+	span.AddEvent("tokens revoked")
+
+	return &api.LogoutResponse{}, nil
+}
+
+func (h *AuthHandler) GetMe(ctx context.Context, req *api.GetMeRequest) (*api.GetMeResponse, error) {
+	span := trace.SpanFromContext(ctx)
+	defer span.End()
+
+	span.AddEvent("call service")
+
+	user, err := h.service.GetMe(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	span.AddEvent("user found",
+		trace.WithAttributes(
+			attribute.String("user_id", user.ID.String()),
+		),
+	)
+
+	return &api.GetMeResponse{
+		User: converter.FromDomainToProto_User(user),
+	}, nil
+}
@@ -0,0 +1,335 @@
+package grpc_server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/dzhordano/ecom-thing/services/auth/internal/application/dto"
+	"github.com/dzhordano/ecom-thing/services/auth/internal/domain"
+	mock_interfaces "github.com/dzhordano/ecom-thing/services/auth/internal/interfaces/grpc_server/mocks"
+	api "github.com/dzhordano/ecom-thing/services/auth/pkg/api/auth/v1"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var testPair = &domain.TokenPair{
+	AccessToken:      "access",
+	AccessExpiresAt:  time.Date(2025, 1, 1, 0, 15, 0, 0, time.UTC),
+	RefreshToken:     "refresh",
+	RefreshExpiresAt: time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC),
+}
+
+var testProtoPair = &api.TokenPair{
+	AccessToken:      "access",
+	AccessExpiresAt:  timestamppb.New(testPair.AccessExpiresAt),
+	RefreshToken:     "refresh",
+	RefreshExpiresAt: timestamppb.New(testPair.RefreshExpiresAt),
+}
+
+func TestAuthHandler_Register(t *testing.T) {
+	type mockBehaviour func(s *mock_interfaces.MockAuthService, req dto.RegisterRequest)
+
+	testUser := &domain.User{ID: uuid.New(), Email: "user@example.com"}
+
+	tests := []struct {
+		name          string
+		req           *api.RegisterRequest
+		mockBehaviour mockBehaviour
+		expectedResp  *api.RegisterResponse
+		expectedErr   error
+	}{
+		{
+			name: "OK",
+			req: &api.RegisterRequest{
+				Email:    "user@example.com",
+				Password: "password123",
+			},
+			mockBehaviour: func(s *mock_interfaces.MockAuthService, req dto.RegisterRequest) {
+				s.EXPECT().Register(gomock.Any(), gomock.Eq(req)).Return(testUser, nil).Times(1)
+			},
+			expectedResp: &api.RegisterResponse{
+				Id: testUser.ID.String(),
+			},
+		},
+		{
+			name: "ERROR",
+			req: &api.RegisterRequest{
+				Email:    "user@example.com",
+				Password: "password123",
+			},
+			mockBehaviour: func(s *mock_interfaces.MockAuthService, req dto.RegisterRequest) {
+				s.EXPECT().Register(gomock.Any(), gomock.Eq(req)).Return(nil, assert.AnError).Times(1)
+			},
+			expectedErr: assert.AnError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			authService := mock_interfaces.NewMockAuthService(ctrl)
+			test.mockBehaviour(authService, dto.RegisterRequest{
+				Email:    test.req.GetEmail(),
+				Password: test.req.GetPassword(),
+			})
+
+			h := NewAuthHandler(authService)
+			resp, err := h.Register(context.Background(), test.req)
+
+			if test.expectedErr != nil {
+				assert.Equal(t, test.expectedErr, err)
+				assert.Nil(t, resp)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, test.expectedResp, resp)
+			}
+		})
+	}
+}
+
+func TestAuthHandler_Login(t *testing.T) {
+	type mockBehaviour func(s *mock_interfaces.MockAuthService, req dto.LoginRequest)
+
+	tests := []struct {
+		name          string
+		req           *api.LoginRequest
+		mockBehaviour mockBehaviour
+		expectedResp  *api.LoginResponse
+		expectedErr   error
+	}{
+		{
+			name: "OK",
+			req: &api.LoginRequest{
+				Email:    "user@example.com",
+				Password: "password123",
+			},
+			mockBehaviour: func(s *mock_interfaces.MockAuthService, req dto.LoginRequest) {
+				s.EXPECT().Login(gomock.Any(), gomock.Eq(req)).Return(testPair, nil).Times(1)
+			},
+			expectedResp: &api.LoginResponse{
+				Tokens: testProtoPair,
+			},
+		},
+		{
+			name: "INVALID CREDENTIALS",
+			req: &api.LoginRequest{
+				Email:    "user@example.com",
+				Password: "wrong",
+			},
+			mockBehaviour: func(s *mock_interfaces.MockAuthService, req dto.LoginRequest) {
+				s.EXPECT().Login(gomock.Any(), gomock.Eq(req)).
+					Return(nil, domain.NewAppError(domain.ErrInvalidCredentials, "invalid email or password")).Times(1)
+			},
+			expectedErr: domain.NewAppError(domain.ErrInvalidCredentials, "invalid email or password"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			authService := mock_interfaces.NewMockAuthService(ctrl)
+			test.mockBehaviour(authService, dto.LoginRequest{
+				Email:    test.req.GetEmail(),
+				Password: test.req.GetPassword(),
+			})
+
+			h := NewAuthHandler(authService)
+			resp, err := h.Login(context.Background(), test.req)
+
+			if test.expectedErr != nil {
+				assert.Equal(t, test.expectedErr, err)
+				assert.Nil(t, resp)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, test.expectedResp, resp)
+			}
+		})
+	}
+}
+
+func TestAuthHandler_Refresh(t *testing.T) {
+	type mockBehaviour func(s *mock_interfaces.MockAuthService, token string)
+
+	tests := []struct {
+		name          string
+		req           *api.RefreshRequest
+		mockBehaviour mockBehaviour
+		expectedResp  *api.RefreshResponse
+		expectedErr   error
+	}{
+		{
+			name: "OK",
+			req: &api.RefreshRequest{
+				RefreshToken: "refresh",
+			},
+			mockBehaviour: func(s *mock_interfaces.MockAuthService, token string) {
+				s.EXPECT().Refresh(gomock.Any(), gomock.Eq(token)).Return(testPair, nil).Times(1)
+			},
+			expectedResp: &api.RefreshResponse{
+				Tokens: testProtoPair,
+			},
+		},
+		{
+			name: "ERROR",
+			req: &api.RefreshRequest{
+				RefreshToken: "revoked",
+			},
+			mockBehaviour: func(s *mock_interfaces.MockAuthService, token string) {
+				s.EXPECT().Refresh(gomock.Any(), gomock.Eq(token)).Return(nil, assert.AnError).Times(1)
+			},
+			expectedErr: assert.AnError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			authService := mock_interfaces.NewMockAuthService(ctrl)
+			test.mockBehaviour(authService, test.req.GetRefreshToken())
+
+			h := NewAuthHandler(authService)
+			resp, err := h.Refresh(context.Background(), test.req)
+
+			if test.expectedErr != nil {
+				assert.Equal(t, test.expectedErr, err)
+				assert.Nil(t, resp)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, test.expectedResp, resp)
+			}
+		})
+	}
+}
+
+func TestAuthHandler_Logout(t *testing.T) {
+	type mockBehaviour func(s *mock_interfaces.MockAuthService, token string, all bool)
+
+	tests := []struct {
+		name          string
+		req           *api.LogoutRequest
+		mockBehaviour mockBehaviour
+		expectedErr   error
+	}{
+		{
+			name: "OK",
+			req: &api.LogoutRequest{
+				RefreshToken: "refresh",
+			},
+			mockBehaviour: func(s *mock_interfaces.MockAuthService, token string, all bool) {
+				s.EXPECT().Logout(gomock.Any(), gomock.Eq(token), gomock.Eq(all)).Return(nil).Times(1)
+			},
+		},
+		{
+			name: "OK ALL SESSIONS",
+			req: &api.LogoutRequest{
+				RefreshToken: "refresh",
+				AllSessions:  true,
+			},
+			mockBehaviour: func(s *mock_interfaces.MockAuthService, token string, all bool) {
+				s.EXPECT().Logout(gomock.Any(), gomock.Eq(token), gomock.Eq(all)).Return(nil).Times(1)
+			},
+		},
+		{
+			name: "ERROR",
+			req: &api.LogoutRequest{
+				RefreshToken: "refresh",
+			},
+			mockBehaviour: func(s *mock_interfaces.MockAuthService, token string, all bool) {
+				s.EXPECT().Logout(gomock.Any(), gomock.Eq(token), gomock.Eq(all)).Return(assert.AnError).Times(1)
+			},
+			expectedErr: assert.AnError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			authService := mock_interfaces.NewMockAuthService(ctrl)
+			test.mockBehaviour(authService, test.req.GetRefreshToken(), test.req.GetAllSessions())
+
+			h := NewAuthHandler(authService)
+			resp, err := h.Logout(context.Background(), test.req)
+
+			if test.expectedErr != nil {
+				assert.Equal(t, test.expectedErr, err)
+				assert.Nil(t, resp)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, &api.LogoutResponse{}, resp)
+			}
+		})
+	}
+}
+
+func TestAuthHandler_GetMe(t *testing.T) {
+	type mockBehaviour func(s *mock_interfaces.MockAuthService)
+
+	testUser := &domain.User{
+		ID:        uuid.New(),
+		Email:     "user@example.com",
+		Roles:     []domain.Role{domain.RoleUser},
+		CreatedAt: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	tests := []struct {
+		name          string
+		mockBehaviour mockBehaviour
+		expectedResp  *api.GetMeResponse
+		expectedErr   error
+	}{
+		{
+			name: "OK",
+			mockBehaviour: func(s *mock_interfaces.MockAuthService) {
+				s.EXPECT().GetMe(gomock.Any()).Return(testUser, nil).Times(1)
+			},
+			expectedResp: &api.GetMeResponse{
+				User: &api.User{
+					Id:        testUser.ID.String(),
+					Email:     testUser.Email,
+					Roles:     []string{"user"},
+					CreatedAt: timestamppb.New(testUser.CreatedAt),
+				},
+			},
+		},
+		{
+			name: "UNAUTHENTICATED",
+			mockBehaviour: func(s *mock_interfaces.MockAuthService) {
+				s.EXPECT().GetMe(gomock.Any()).
+					Return(nil, domain.NewAppError(domain.ErrUnauthenticated, "unauthenticated")).Times(1)
+			},
+			expectedErr: domain.NewAppError(domain.ErrUnauthenticated, "unauthenticated"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			authService := mock_interfaces.NewMockAuthService(ctrl)
+			test.mockBehaviour(authService)
+
+			h := NewAuthHandler(authService)
+			resp, err := h.GetMe(context.Background(), &api.GetMeRequest{})
+
+			if test.expectedErr != nil {
+				assert.Equal(t, test.expectedErr, err)
+				assert.Nil(t, resp)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, test.expectedResp, resp)
+			}
+		})
+	}
+}
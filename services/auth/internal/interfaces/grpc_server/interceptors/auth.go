@@ -0,0 +1,116 @@
+package interceptors
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/dzhordano/ecom-thing/services/auth/internal/domain"
+	"github.com/dzhordano/ecom-thing/services/auth/internal/infrastructure/keys"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	authorizationHeader = "authorization"
+	bearerPrefix        = "bearer "
+)
+
+var (
+	errMissingToken = status.Error(codes.Unauthenticated, "missing bearer token")
+	errInvalidToken = status.Error(codes.Unauthenticated, "invalid token")
+
+	errNoToken = errors.New("no authorization header")
+)
+
+// Authenticator verifies bearer JWT and stores domain.Principal in request context.
+//
+// Requests without authorization header pass through anonymously, it is up to
+// authorization policy to decide whether method may be called without principal.
+type Authenticator struct {
+	keyFunc jwt.Keyfunc
+	methods []string
+}
+
+// NewAuthenticator creates Authenticator that verifies RS256 tokens with keys resolved by keyFunc.
+func NewAuthenticator(keyFunc jwt.Keyfunc) *Authenticator {
+	return &Authenticator{
+		keyFunc: keyFunc,
+		methods: []string{"RS256"},
+	}
+}
+
+// Authenticate parses token and returns principal it was issued for.
+func (a *Authenticator) Authenticate(token string) (domain.Principal, error) {
+	var claims keys.Claims
+	if _, err := jwt.ParseWithClaims(token, &claims, a.keyFunc, jwt.WithValidMethods(a.methods), jwt.WithExpirationRequired()); err != nil {
+		return domain.Principal{}, err
+	}
+
+	userId, err := uuid.Parse(claims.Subject)
+	if err != nil {
+		return domain.Principal{}, fmt.Errorf("invalid subject: %w", err)
+	}
+
+	p := domain.Principal{UserID: userId}
+	for _, r := range claims.Roles {
+		// System role is reserved for in-process callers.
+		if domain.Role(r) == domain.RoleSystem {
+			continue
+		}
+		p.Roles = append(p.Roles, domain.Role(r))
+	}
+
+	return p, nil
+}
+
+func (a *Authenticator) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx, err := a.authenticateContext(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+func (a *Authenticator) authenticateContext(ctx context.Context) (context.Context, error) {
+	token, err := bearerFromMD(ctx)
+	if errors.Is(err, errNoToken) {
+		return ctx, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := a.Authenticate(token)
+	if err != nil {
+		return nil, errInvalidToken
+	}
+
+	return domain.ContextWithPrincipal(ctx, p), nil
+}
+
+func bearerFromMD(ctx context.Context) (string, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", errNoToken
+	}
+
+	vals := md.Get(authorizationHeader)
+	if len(vals) == 0 {
+		return "", errNoToken
+	}
+
+	if len(vals[0]) <= len(bearerPrefix) || !strings.EqualFold(vals[0][:len(bearerPrefix)], bearerPrefix) {
+		return "", errMissingToken
+	}
+
+	return vals[0][len(bearerPrefix):], nil
+}
@@ -0,0 +1,17 @@
+package grpc_server
+
+import (
+	"github.com/dzhordano/ecom-thing/services/auth/internal/domain"
+	"github.com/dzhordano/ecom-thing/services/auth/internal/interfaces/grpc_server/interceptors"
+	api "github.com/dzhordano/ecom-thing/services/auth/pkg/api/auth/v1"
+)
+
+// methodPolicy mirrors security scopes declared in auth.proto.
+// Token endpoints are public, refresh token itself is the credential.
+var methodPolicy = interceptors.Policy{
+	api.AuthService_Register_FullMethodName: interceptors.Public(),
+	api.AuthService_Login_FullMethodName:    interceptors.Public(),
+	api.AuthService_Refresh_FullMethodName:  interceptors.Public(),
+	api.AuthService_Logout_FullMethodName:   interceptors.Public(),
+	api.AuthService_GetMe_FullMethodName:    {domain.RoleUser, domain.RoleAdmin},
+}
@@ -0,0 +1,78 @@
+package grpc_server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/dzhordano/ecom-thing/services/auth/internal/domain"
+	"github.com/dzhordano/ecom-thing/services/auth/internal/interfaces/grpc_server/interceptors"
+	api "github.com/dzhordano/ecom-thing/services/auth/pkg/api/auth/v1"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestMethodPolicy_CoversAllMethods(t *testing.T) {
+	for _, m := range api.AuthService_ServiceDesc.Methods {
+		method := "/" + api.AuthService_ServiceDesc.ServiceName + "/" + m.MethodName
+		_, ok := methodPolicy[method]
+		assert.True(t, ok, "no policy for %s", method)
+	}
+}
+
+func TestAuthorizationInterceptor(t *testing.T) {
+	anonymous := context.Background()
+	user := domain.ContextWithPrincipal(context.Background(), domain.Principal{
+		UserID: uuid.New(),
+		Roles:  []domain.Role{domain.RoleUser},
+	})
+	admin := domain.ContextWithPrincipal(context.Background(), domain.Principal{
+		UserID: uuid.New(),
+		Roles:  []domain.Role{domain.RoleAdmin},
+	})
+	noRoles := domain.ContextWithPrincipal(context.Background(), domain.Principal{
+		UserID: uuid.New(),
+	})
+
+	tests := []struct {
+		method    string
+		anonymous codes.Code
+		noRoles   codes.Code
+		user      codes.Code
+		admin     codes.Code
+	}{
+		{api.AuthService_Register_FullMethodName, codes.OK, codes.OK, codes.OK, codes.OK},
+		{api.AuthService_Login_FullMethodName, codes.OK, codes.OK, codes.OK, codes.OK},
+		{api.AuthService_Refresh_FullMethodName, codes.OK, codes.OK, codes.OK, codes.OK},
+		{api.AuthService_Logout_FullMethodName, codes.OK, codes.OK, codes.OK, codes.OK},
+		{api.AuthService_GetMe_FullMethodName, codes.Unauthenticated, codes.PermissionDenied, codes.OK, codes.OK},
+		{"/api.auth.v1.AuthService/Unknown", codes.PermissionDenied, codes.PermissionDenied, codes.PermissionDenied, codes.PermissionDenied},
+	}
+
+	interceptor := interceptors.AuthorizationInterceptor(methodPolicy)
+	handler := func(ctx context.Context, req any) (any, error) {
+		return nil, nil
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.method, func(t *testing.T) {
+			info := &grpc.UnaryServerInfo{FullMethod: tt.method}
+
+			for _, c := range []struct {
+				caller   string
+				ctx      context.Context
+				expected codes.Code
+			}{
+				{"anonymous", anonymous, tt.anonymous},
+				{"no roles", noRoles, tt.noRoles},
+				{"user", user, tt.user},
+				{"admin", admin, tt.admin},
+			} {
+				_, err := interceptor(c.ctx, nil, info, handler)
+				assert.Equal(t, c.expected, status.Code(err), c.caller)
+			}
+		})
+	}
+}
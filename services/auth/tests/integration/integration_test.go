@@ -0,0 +1,221 @@
+package integration
+
+import (
+	"context"
+	"log"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/dzhordano/ecom-thing/services/auth/internal/application/dto"
+	"github.com/dzhordano/ecom-thing/services/auth/internal/application/interfaces"
+	"github.com/dzhordano/ecom-thing/services/auth/internal/application/service"
+	"github.com/dzhordano/ecom-thing/services/auth/internal/domain"
+	"github.com/dzhordano/ecom-thing/services/auth/internal/infrastructure/hasher"
+	"github.com/dzhordano/ecom-thing/services/auth/internal/infrastructure/keys"
+	"github.com/dzhordano/ecom-thing/services/auth/internal/infrastructure/repository/pg"
+	"github.com/dzhordano/ecom-thing/services/auth/pkg/logger"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/golang-migrate/migrate/v4"
+	_ "github.com/golang-migrate/migrate/v4/database/postgres"
+	_ "github.com/golang-migrate/migrate/v4/source/file"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/joho/godotenv"
+	"github.com/stretchr/testify/suite"
+)
+
+const testPassword = "password123"
+
+type Suite struct {
+	suite.Suite
+
+	db  *pgxpool.Pool
+	km  *keys.Manager
+	svc interfaces.AuthService
+
+	testEmail string
+}
+
+func (s *Suite) SetupSuite() {
+	// Get current file location
+	_, currentFile, _, _ := runtime.Caller(0)
+
+	// Get current dir from the current file path
+	currDir := filepath.Dir(currentFile)
+
+	// Go two folders up
+	projectDir := filepath.Join(currDir, "..", "..")
+
+	// Specify .env file
+	envPath := filepath.Join(projectDir, ".env")
+	// Specify folder containing migrations
+	migrationsPath := filepath.Join(projectDir, "migrations")
+
+	if err := godotenv.Load(envPath); err != nil {
+		log.Fatalf("suite: error loading .env file: %v", err)
+	}
+
+	dsn := os.Getenv("PG_TEST_URL")
+
+	if dsn == "" {
+		log.Fatal("PG_TEST_URL not specified in .env")
+	}
+
+	pool, err := pgxpool.New(context.Background(), dsn)
+	if err != nil {
+		log.Fatalf("failed to connect to database: %v", err)
+	}
+
+	timeout, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := pool.Ping(timeout); err != nil {
+		log.Fatalf("failed to ping database: %v", err)
+	}
+
+	m, err := migrate.New("file://"+migrationsPath, dsn)
+	if err != nil {
+		log.Fatalf("failed to create migrate instance: %v", err)
+	}
+
+	if err = m.Up(); err != nil && err != migrate.ErrNoChange {
+		panic(err)
+	}
+
+	testLogger := logger.MustInit(logger.LevelDebug, "auth-test.log", "json", false)
+
+	s.db = pool
+	s.km = keys.NewManager(testLogger, pg.NewKeyRepository(s.db), keys.Config{
+		AccessTTL:      time.Minute,
+		RotationPeriod: time.Hour,
+	})
+
+	if err := s.km.Init(context.Background()); err != nil {
+		log.Fatalf("failed to init signing keys: %v", err)
+	}
+
+	s.svc = service.NewAuthService(
+		testLogger,
+		pg.NewUserRepository(s.db),
+		pg.NewTokenRepository(s.db),
+		hasher.NewBcryptHasher(0),
+		s.km,
+		time.Hour,
+	)
+}
+
+func (s *Suite) TearDownSuite() {
+	s.db.Close()
+}
+
+func TestIntegration(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+
+	suite.Run(t, new(Suite))
+}
+
+func (s *Suite) SetupTest() {
+	s.testEmail = uuid.NewString() + "@example.com"
+
+	_, err := s.svc.Register(context.Background(), dto.RegisterRequest{
+		Email:    s.testEmail,
+		Password: testPassword,
+	})
+
+	s.NoError(err)
+}
+
+func (s *Suite) TearDownTest() {
+	// Refresh tokens are removed by cascade.
+	_, err := s.db.Exec(context.Background(), "DELETE FROM users WHERE email = $1", s.testEmail)
+
+	s.NoError(err)
+}
+
+func (s *Suite) login() *domain.TokenPair {
+	pair, err := s.svc.Login(context.Background(), dto.LoginRequest{
+		Email:    s.testEmail,
+		Password: testPassword,
+	})
+	s.Require().NoError(err)
+
+	return pair
+}
+
+func (s *Suite) Test_Register_AlreadyExists() {
+	_, err := s.svc.Register(context.Background(), dto.RegisterRequest{
+		Email:    s.testEmail,
+		Password: testPassword,
+	})
+
+	s.ErrorIs(err, domain.ErrUserAlreadyExists)
+}
+
+func (s *Suite) Test_Login() {
+	pair := s.login()
+
+	var claims keys.Claims
+	_, err := jwt.ParseWithClaims(pair.AccessToken, &claims, s.km.KeyFunc)
+	s.NoError(err)
+	s.Equal([]string{"user"}, claims.Roles)
+
+	_, err = s.svc.Login(context.Background(), dto.LoginRequest{
+		Email:    s.testEmail,
+		Password: "wrong-password",
+	})
+	s.ErrorIs(err, domain.ErrInvalidCredentials)
+
+	_, err = s.svc.Login(context.Background(), dto.LoginRequest{
+		Email:    "missing-" + s.testEmail,
+		Password: testPassword,
+	})
+	s.ErrorIs(err, domain.ErrInvalidCredentials)
+}
+
+func (s *Suite) Test_Refresh() {
+	pair := s.login()
+
+	rotated, err := s.svc.Refresh(context.Background(), pair.RefreshToken)
+	s.Require().NoError(err)
+	s.NotEqual(pair.RefreshToken, rotated.RefreshToken)
+
+	// Reusing rotated token revokes whole family, including the fresh one.
+	_, err = s.svc.Refresh(context.Background(), pair.RefreshToken)
+	s.ErrorIs(err, domain.ErrInvalidToken)
+
+	_, err = s.svc.Refresh(context.Background(), rotated.RefreshToken)
+	s.ErrorIs(err, domain.ErrInvalidToken)
+}
+
+func (s *Suite) Test_Logout() {
+	first := s.login()
+	second := s.login()
+
+	s.NoError(s.svc.Logout(context.Background(), first.RefreshToken, false))
+
+	_, err := s.svc.Refresh(context.Background(), first.RefreshToken)
+	s.ErrorIs(err, domain.ErrInvalidToken)
+
+	// Other session is untouched until logout from all sessions.
+	second, err = s.svc.Refresh(context.Background(), second.RefreshToken)
+	s.Require().NoError(err)
+
+	s.NoError(s.svc.Logout(context.Background(), second.RefreshToken, true))
+
+	_, err = s.svc.Refresh(context.Background(), second.RefreshToken)
+	s.ErrorIs(err, domain.ErrInvalidToken)
+}
+
+func (s *Suite) Test_GetMe() {
+	user, err := s.svc.GetMe(domain.ContextWithPrincipal(context.Background(), domain.Principal{UserID: uuid.New()}))
+	s.Nil(user)
+	s.ErrorIs(err, domain.ErrUserNotFound)
+
+	_, err = s.svc.GetMe(context.Background())
+	s.ErrorIs(err, domain.ErrUnauthenticated)
+}
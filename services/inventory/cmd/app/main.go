@@ -15,6 +15,7 @@ import (
 	"github.com/dzhordano/ecom-thing/services/inventory/internal/infrastructure/kafka"
 	"github.com/dzhordano/ecom-thing/services/inventory/internal/infrastructure/repository/pg"
 	"github.com/dzhordano/ecom-thing/services/inventory/internal/interfaces/grpc_server"
+	"github.com/dzhordano/ecom-thing/services/inventory/internal/interfaces/grpc_server/interceptors"
 	"github.com/dzhordano/ecom-thing/services/inventory/pkg/logger"
 )
 
@@ -38,7 +39,7 @@ func main() {
 
 	repo := pg.NewInventoryRepository(pool)
 
-	svc := service.NewItemService(log, repo)
+	svc := service.NewItemService(log, repo, pg.NewReservationRepository(pool))
 
 	tp, err := tracer.NewTracerProvider(cfg.Tracing.URL, "inventory")
 	if err != nil {
@@ -53,15 +54,34 @@ func main() {
 		}
 	}()
 
+	var auth *interceptors.Authenticator
+	if cfg.Auth.JWKSFile != "" {
+		auth, err = interceptors.NewJWKSAuthenticator(cfg.Auth.JWKSFile)
+		if err != nil {
+			log.Error("error creating jwks authenticator", "error", err)
+			return
+		}
+	} else {
+		auth, err = interceptors.NewHMACAuthenticator([]byte(cfg.Auth.HMACSecret))
+		if err != nil {
+			log.Error("error creating hmac authenticator", "error", err)
+			return
+		}
+	}
+
 	srv := grpc_server.MustNew(
 		log,
 		grpc_server.NewItemHandler(svc),
 		grpc_server.WithAddr(cfg.GRPC.Addr()),
 		grpc_server.WithTracerProvider(tp),
+		grpc_server.WithAuthenticator(auth),
 	)
 
 	wg := sync.WaitGroup{}
 
+	kp := kafka.NewProducer(cfg.Kafka.Brokers, cfg.Kafka.TopicsToProduce, time.Second, 0)
+	defer kp.Close()
+
 	// TODO хардкод
 	go func() {
 		cg, err := kafka.NewConsumerGroup(
@@ -69,6 +89,7 @@ func main() {
 			cfg.Kafka.Brokers,
 			cfg.Kafka.TopicsToConsume,
 			svc,
+			kp,
 			time.Second,
 			uint(100),
 		)
@@ -12,4 +12,14 @@ type ItemService interface {
 	IsReservable(ctx context.Context, items map[string]uint64) (bool, error)
 	SetItemWithOp(ctx context.Context, id uuid.UUID, quantity uint64, op string) error
 	SetItemsWithOp(ctx context.Context, items map[string]uint64, op string) error
+
+	// Reservation lifecycle driven by order checkout. Calls are idempotent per order.
+	ReserveItems(ctx context.Context, orderId uuid.UUID, items map[string]uint64) error
+	ReleaseItems(ctx context.Context, orderId uuid.UUID) error
+	CommitItems(ctx context.Context, orderId uuid.UUID) error
+	ReturnItems(ctx context.Context, orderId uuid.UUID) error
+	// AdjustItems gives back part of order's stock, it is idempotent per adjustment.
+	AdjustItems(ctx context.Context, orderId, adjustmentId uuid.UUID, items map[string]uint64) error
+	// ExtendItems takes more stock for order, it is idempotent per adjustment.
+	ExtendItems(ctx context.Context, orderId, adjustmentId uuid.UUID, items map[string]uint64) error
 }
@@ -12,14 +12,16 @@ import (
 )
 
 type ItemService struct {
-	log  logger.Logger
-	repo repository.ItemRepository
+	log          logger.Logger
+	repo         repository.ItemRepository
+	reservations repository.ReservationRepository
 }
 
-func NewItemService(log logger.Logger, itemRepository repository.ItemRepository) interfaces.ItemService {
+func NewItemService(log logger.Logger, itemRepository repository.ItemRepository, reservationRepository repository.ReservationRepository) interfaces.ItemService {
 	return &ItemService{
-		log:  log,
-		repo: itemRepository,
+		log:          log,
+		repo:         itemRepository,
+		reservations: reservationRepository,
 	}
 }
 
@@ -140,6 +142,78 @@ func (s *ItemService) SetItemsWithOp(ctx context.Context, items map[string]uint6
 	return nil
 }
 
+// ReserveItems implements interfaces.ItemService.
+func (s *ItemService) ReserveItems(ctx context.Context, orderId uuid.UUID, items map[string]uint64) error {
+	if err := s.reservations.Reserve(ctx, orderId.String(), items); err != nil {
+		s.log.Error("error reserving items", "error", err, "order_id", orderId.String())
+		return domain.NewAppError(err, "failed to reserve items")
+	}
+
+	s.log.Debug("items reserved", "order_id", orderId.String(), "count", len(items))
+
+	return nil
+}
+
+// ReleaseItems implements interfaces.ItemService.
+func (s *ItemService) ReleaseItems(ctx context.Context, orderId uuid.UUID) error {
+	if err := s.reservations.Release(ctx, orderId.String()); err != nil {
+		s.log.Error("error releasing items", "error", err, "order_id", orderId.String())
+		return domain.NewAppError(err, "failed to release items")
+	}
+
+	s.log.Debug("items released", "order_id", orderId.String())
+
+	return nil
+}
+
+// CommitItems implements interfaces.ItemService.
+func (s *ItemService) CommitItems(ctx context.Context, orderId uuid.UUID) error {
+	if err := s.reservations.Commit(ctx, orderId.String()); err != nil {
+		s.log.Error("error committing items", "error", err, "order_id", orderId.String())
+		return domain.NewAppError(err, "failed to commit items")
+	}
+
+	s.log.Debug("items committed", "order_id", orderId.String())
+
+	return nil
+}
+
+// ReturnItems implements interfaces.ItemService.
+func (s *ItemService) ReturnItems(ctx context.Context, orderId uuid.UUID) error {
+	if err := s.reservations.Return(ctx, orderId.String()); err != nil {
+		s.log.Error("error returning items", "error", err, "order_id", orderId.String())
+		return domain.NewAppError(err, "failed to return items")
+	}
+
+	s.log.Debug("items returned", "order_id", orderId.String())
+
+	return nil
+}
+
+// AdjustItems implements interfaces.ItemService.
+func (s *ItemService) AdjustItems(ctx context.Context, orderId, adjustmentId uuid.UUID, items map[string]uint64) error {
+	if err := s.reservations.Adjust(ctx, orderId.String(), adjustmentId.String(), items); err != nil {
+		s.log.Error("error adjusting items", "error", err, "order_id", orderId.String(), "adjustment_id", adjustmentId.String())
+		return domain.NewAppError(err, "failed to adjust items")
+	}
+
+	s.log.Debug("items adjusted", "order_id", orderId.String(), "adjustment_id", adjustmentId.String(), "count", len(items))
+
+	return nil
+}
+
+// ExtendItems implements interfaces.ItemService.
+func (s *ItemService) ExtendItems(ctx context.Context, orderId, adjustmentId uuid.UUID, items map[string]uint64) error {
+	if err := s.reservations.Extend(ctx, orderId.String(), adjustmentId.String(), items); err != nil {
+		s.log.Error("error extending items", "error", err, "order_id", orderId.String(), "adjustment_id", adjustmentId.String())
+		return domain.NewAppError(err, "failed to extend items")
+	}
+
+	s.log.Debug("items extended", "order_id", orderId.String(), "adjustment_id", adjustmentId.String(), "count", len(items))
+
+	return nil
+}
+
 // performOp performs operation on item (i.e. add, sub, lock, unlock, sub_locked)
 func performOp(item *domain.Item, quantity uint64, op string) error {
 	switch op {
@@ -1,6 +1,7 @@
 package config
 
 import (
+	"errors"
 	"log"
 	"time"
 
@@ -16,6 +17,7 @@ type Config struct {
 	RateLimiter      RateLimiterConfig
 	CircuitBreaker   CircuitBreakerConfig
 	Tracing          TracingConfig
+	Auth             AuthConfig
 	Kafka            KafkaConfig
 	ProfilingEnabled bool `env:"PROFILING_ENABLED" env-default:"false"`
 }
@@ -79,6 +81,8 @@ type KafkaConfig struct {
 	GroupID string `env:"KAFKA_GROUP_ID" env-default:"inventory-service"`
 	// Topics to consume messages from.
 	TopicsToConsume []string `env:"KAFKA_TOPICS_CONSUME" env-default:"order-events"`
+	// Topics to produce replies to.
+	TopicsToProduce []string `env:"KAFKA_TOPICS_PRODUCE" env-default:"inventory-events"`
 }
 
 // MustNew Reads .env file and returns Config.
@@ -93,5 +97,30 @@ func MustNew() *Config {
 		log.Fatalf("error reading config: %v", err)
 	}
 
+	if err := cfg.Auth.Validate(); err != nil {
+		log.Fatalf("invalid auth config: %v", err)
+	}
+
 	return &cfg
 }
+
+// AuthConfig describes how bearer tokens are verified.
+//
+// Exactly one of them has to be set: if JWKSFile is set, tokens are verified against its public keys.
+// Otherwise, HMACSecret is used.
+type AuthConfig struct {
+	HMACSecret string `env:"AUTH_HMAC_SECRET"`
+	JWKSFile   string `env:"AUTH_JWKS_FILE"`
+}
+
+// Validate checks that tokens are verified with either JWKS file or secret, not both or none.
+func (a AuthConfig) Validate() error {
+	switch {
+	case a.JWKSFile != "" && a.HMACSecret != "":
+		return errors.New("only one of AUTH_JWKS_FILE and AUTH_HMAC_SECRET may be set")
+	case a.JWKSFile == "" && a.HMACSecret == "":
+		return errors.New("either AUTH_JWKS_FILE or AUTH_HMAC_SECRET must be set")
+	}
+
+	return nil
+}
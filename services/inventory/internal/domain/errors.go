@@ -9,6 +9,10 @@ var (
 	ErrOperationUnknown  = errors.New("operation unknown")
 	ErrProductNotFound   = errors.New("product not found")
 	ErrNotEnoughQuantity = errors.New("not enough quantity")
+
+	ErrReservationNotFound = errors.New("reservation not found")
+	ErrReservationReleased = errors.New("reservation already released")
+	ErrExtensionRejected   = errors.New("reservation extension rejected")
 )
 
 var CriticalErrors = map[error]struct{}{}
@@ -46,8 +50,11 @@ func (e *AppError) GRPCCode() codes.Code {
 	switch {
 	case errors.Is(e.Code, ErrOperationUnknown):
 		return codes.InvalidArgument
-	case errors.Is(e.Code, ErrProductNotFound):
+	case errors.Is(e.Code, ErrProductNotFound), errors.Is(e.Code, ErrReservationNotFound):
 		return codes.NotFound
+	case errors.Is(e.Code, ErrNotEnoughQuantity), errors.Is(e.Code, ErrReservationReleased),
+		errors.Is(e.Code, ErrExtensionRejected):
+		return codes.FailedPrecondition
 	case errors.Is(e.Code, ErrOperationUnknown):
 		return codes.InvalidArgument
 	default:
@@ -0,0 +1,27 @@
+package repository
+
+import "context"
+
+// ReservationRepository changes stock on behalf of orders.
+//
+// Every method runs in a single transaction holding a lock on order's reservation,
+// so concurrent and repeated calls for the same order are applied once.
+type ReservationRepository interface {
+	// Reserve locks quantities of all items or none of them.
+	Reserve(ctx context.Context, orderId string, items map[string]uint64) error
+	// Release unlocks reserved quantities. If nothing is reserved yet, later Reserve for the order is rejected.
+	Release(ctx context.Context, orderId string) error
+	// Commit removes reserved quantities from stock.
+	Commit(ctx context.Context, orderId string) error
+	// Return puts committed (or still reserved) quantities back to available stock.
+	Return(ctx context.Context, orderId string) error
+	// Adjust puts part of reserved or committed quantities back to available stock and removes them from reservation,
+	// so later Release or Return doesn't give them back twice. It is applied once per adjustment id.
+	Adjust(ctx context.Context, orderId, adjustmentId string, items map[string]uint64) error
+	// Extend adds quantities to reservation, all of them or none. Reserved ones are locked, committed ones are removed
+	// from stock right away. Released or returned reservation is left as is. It is applied once per adjustment id.
+	//
+	// Extension that can't be applied (not enough stock, unknown product or no reservation yet) is recorded
+	// as rejected, ErrExtensionRejected is returned for it every time, even if stock is replenished since.
+	Extend(ctx context.Context, orderId, adjustmentId string, items map[string]uint64) error
+}
@@ -0,0 +1,27 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ReservationStatus string
+
+const (
+	ReservationReserved  ReservationStatus = "reserved"  // Quantity is moved from available to reserved.
+	ReservationReleased  ReservationStatus = "released"  // Reserved quantity is made available again.
+	ReservationCommitted ReservationStatus = "committed" // Reserved quantity is sold and removed from stock.
+	ReservationReturned  ReservationStatus = "returned"  // Committed quantity is put back to stock.
+)
+
+// Reservation is stock held for a single order. It is keyed by order id, so repeated commands are applied once.
+//
+// Released reservation without items is a tombstone left when release arrives before reservation.
+type Reservation struct {
+	OrderID   uuid.UUID
+	Items     map[string]uint64
+	Status    ReservationStatus
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
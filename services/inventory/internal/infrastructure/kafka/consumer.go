@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"github.com/google/uuid"
 	"github.com/segmentio/kafka-go"
 	"io"
 	"log"
@@ -25,9 +26,23 @@ var (
 		"quantity-requested":  true,
 		"quantity-released":   true,
 		"quantity-subtracted": true,
+		"quantity-returned":   true,
+		"items-released":      true,
+		"items-returned":      true,
+		"items-locked":        true,
 	}
 )
 
+// Replies to quantity requests, consumed by order checkout.
+const (
+	eventQuantityReserved = "quantity-reserved"
+	eventQuantityRejected = "quantity-rejected"
+
+	// Replies to stock lock requests, order takes rejected units off its lines.
+	eventItemsLockReserved = "items-lock-reserved"
+	eventItemsLockRejected = "items-lock-rejected"
+)
+
 const (
 	serviceGroupID = "inventory-consumer-group"
 )
@@ -38,6 +53,7 @@ type Consumer struct {
 	brokers      []string
 	topics       []string
 	is           interfaces.ItemService
+	prod         Producer
 	retryBackoff time.Duration
 	retries      uint
 }
@@ -45,7 +61,7 @@ type Consumer struct {
 // NewConsumerGroup returns new consumer group.
 //
 // If retries amount provided as 0, infinite (max uint) number of retries will be set
-func NewConsumerGroup(ctx context.Context, brokers, topics []string, is interfaces.ItemService, retryBackoff time.Duration, retries uint) (*Consumer, error) {
+func NewConsumerGroup(ctx context.Context, brokers, topics []string, is interfaces.ItemService, prod Producer, retryBackoff time.Duration, retries uint) (*Consumer, error) {
 	if retries == 0 {
 		retries = math.MaxUint
 	}
@@ -81,6 +97,7 @@ func NewConsumerGroup(ctx context.Context, brokers, topics []string, is interfac
 		brokers:      brokers,
 		topics:       topics,
 		is:           is,
+		prod:         prod,
 		retryBackoff: retryBackoff,
 		retries:      retries,
 	}, nil
@@ -198,9 +215,21 @@ func (c *Consumer) CreateTopics(_ context.Context, partitions, replicationFactor
 }
 
 func (c *Consumer) executeEvent(ctx context.Context, m kafka.Message) error {
+	var eventType string
+	for _, h := range m.Headers {
+		if string(h.Key) == "event_type" {
+			eventType = string(h.Value)
+			break
+		}
+	}
+	if !events[eventType] {
+		return ErrInvalidEventType
+	}
+
 	var invEvent struct {
-		OrderID string `json:"order_id"`
-		Items   []struct {
+		OrderID      string `json:"order_id"`
+		AdjustmentID string `json:"adjustment_id"`
+		Items        []struct {
 			ProductID string
 			Quantity  uint64
 		} `json:"items"`
@@ -210,37 +239,76 @@ func (c *Consumer) executeEvent(ctx context.Context, m kafka.Message) error {
 		return err
 	}
 
-	items := map[string]uint64{}
-	for i := range invEvent.Items {
-		items[invEvent.Items[i].ProductID] = invEvent.Items[i].Quantity
+	orderID, err := uuid.Parse(invEvent.OrderID)
+	if err != nil {
+		return err
 	}
 
-	var eventType string
-	for _, h := range m.Headers {
-		if string(h.Key) == "event_type" {
-			eventType = string(h.Value)
-			break
-		}
-	}
-	if !events[eventType] {
-		return ErrInvalidEventType
+	items := map[string]uint64{}
+	for i := range invEvent.Items {
+		items[invEvent.Items[i].ProductID] += invEvent.Items[i].Quantity
 	}
 
 	// FIXME тут тоже в константы наверно. подумать об аггрегации таких событий??? (но куда :*)
 	switch eventType {
 	case "quantity-requested":
-		if err := c.is.SetItemsWithOp(ctx, items, domain.OperationLock); err != nil {
-			return err
-		}
+		return c.reserve(ctx, orderID, items)
 	case "quantity-released":
-		if err := c.is.SetItemsWithOp(ctx, items, domain.OperationUnlock); err != nil {
+		return c.is.ReleaseItems(ctx, orderID)
+	case "quantity-subtracted":
+		return c.is.CommitItems(ctx, orderID)
+	case "quantity-returned":
+		return c.is.ReturnItems(ctx, orderID)
+	case "items-released", "items-returned":
+		// Partial cancellation and return are both applied as adjustment of order's reservation.
+		adjustmentID, err := uuid.Parse(invEvent.AdjustmentID)
+		if err != nil {
 			return err
 		}
-	case "quantity-subtracted":
-		if err := c.is.SetItemsWithOp(ctx, items, domain.OperationSubLocked); err != nil {
+
+		return c.is.AdjustItems(ctx, orderID, adjustmentID, items)
+	case "items-locked":
+		// Lines added to order after its stock was requested.
+		adjustmentID, err := uuid.Parse(invEvent.AdjustmentID)
+		if err != nil {
 			return err
 		}
+
+		return c.extend(ctx, orderID, adjustmentID, items)
 	}
 
 	return nil
 }
+
+// reserve reserves items and replies with the result.
+// Reply is sent again if request is redelivered, reservation itself is applied once.
+func (c *Consumer) reserve(ctx context.Context, orderID uuid.UUID, items map[string]uint64) error {
+	reply := eventQuantityReserved
+
+	if err := c.is.ReserveItems(ctx, orderID, items); err != nil {
+		if !errors.Is(err, domain.ErrNotEnoughQuantity) &&
+			!errors.Is(err, domain.ErrProductNotFound) &&
+			!errors.Is(err, domain.ErrReservationReleased) {
+			return err
+		}
+		reply = eventQuantityRejected
+	}
+
+	return c.prod.Produce(ctx, reply, orderID.String(), []byte(orderID.String()))
+}
+
+// extend takes more stock for order and replies with the result, like reserve does.
+// Rejected extension is rejected again if request is redelivered.
+func (c *Consumer) extend(ctx context.Context, orderID, adjustmentID uuid.UUID, items map[string]uint64) error {
+	reply := eventItemsLockReserved
+
+	if err := c.is.ExtendItems(ctx, orderID, adjustmentID, items); err != nil {
+		if !errors.Is(err, domain.ErrExtensionRejected) {
+			return err
+		}
+		reply = eventItemsLockRejected
+	}
+
+	return c.prod.Produce(ctx, reply, orderID.String(), []byte(orderID.String()),
+		Header{Key: AdjustmentIDHeaderKey, Value: adjustmentID.String()})
+}
@@ -0,0 +1,462 @@
+package pg
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/dzhordano/ecom-thing/services/inventory/internal/domain"
+	"github.com/dzhordano/ecom-thing/services/inventory/internal/domain/repository"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const (
+	reservationsTable = "reservations"
+	adjustmentsTable  = "reservation_adjustments"
+)
+
+type ReservationRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewReservationRepository(db *pgxpool.Pool) repository.ReservationRepository {
+	return &ReservationRepository{db: db}
+}
+
+// Reserve implements repository.ReservationRepository.
+func (r *ReservationRepository) Reserve(ctx context.Context, orderId string, items map[string]uint64) error {
+	const op = "repository.ReservationRepository.Reserve"
+
+	err := r.withReservation(ctx, orderId, func(ctx context.Context, tx pgx.Tx, res *domain.Reservation) error {
+		if res != nil {
+			if res.Status == domain.ReservationReleased {
+				return domain.ErrReservationReleased
+			}
+			return nil // Already reserved, command was delivered again.
+		}
+
+		if err := applyToItems(ctx, tx, items, (*domain.Item).LockQuantity); err != nil {
+			return err
+		}
+
+		return insertReservation(ctx, tx, orderId, items, domain.ReservationReserved)
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// Release implements repository.ReservationRepository.
+func (r *ReservationRepository) Release(ctx context.Context, orderId string) error {
+	const op = "repository.ReservationRepository.Release"
+
+	err := r.withReservation(ctx, orderId, func(ctx context.Context, tx pgx.Tx, res *domain.Reservation) error {
+		if res == nil {
+			return insertReservation(ctx, tx, orderId, map[string]uint64{}, domain.ReservationReleased)
+		}
+
+		if res.Status != domain.ReservationReserved {
+			return nil
+		}
+
+		if err := applyToItems(ctx, tx, res.Items, (*domain.Item).UnlockQuantity); err != nil {
+			return err
+		}
+
+		return setReservationStatus(ctx, tx, orderId, domain.ReservationReleased)
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// Commit implements repository.ReservationRepository.
+func (r *ReservationRepository) Commit(ctx context.Context, orderId string) error {
+	const op = "repository.ReservationRepository.Commit"
+
+	err := r.withReservation(ctx, orderId, func(ctx context.Context, tx pgx.Tx, res *domain.Reservation) error {
+		if res == nil {
+			return domain.ErrReservationNotFound
+		}
+
+		switch res.Status {
+		case domain.ReservationReleased:
+			return domain.ErrReservationReleased
+		case domain.ReservationCommitted, domain.ReservationReturned:
+			return nil
+		}
+
+		if err := applyToItems(ctx, tx, res.Items, (*domain.Item).SubLockedQuantity); err != nil {
+			return err
+		}
+
+		return setReservationStatus(ctx, tx, orderId, domain.ReservationCommitted)
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// Return implements repository.ReservationRepository.
+func (r *ReservationRepository) Return(ctx context.Context, orderId string) error {
+	const op = "repository.ReservationRepository.Return"
+
+	err := r.withReservation(ctx, orderId, func(ctx context.Context, tx pgx.Tx, res *domain.Reservation) error {
+		if res == nil {
+			return nil
+		}
+
+		var apply func(*domain.Item, uint64) error
+		switch res.Status {
+		case domain.ReservationCommitted:
+			apply = func(i *domain.Item, q uint64) error {
+				i.AddQuantity(q)
+				return nil
+			}
+		case domain.ReservationReserved:
+			// Commit has not arrived yet, so quantity is still reserved.
+			apply = (*domain.Item).UnlockQuantity
+		default:
+			return nil
+		}
+
+		if err := applyToItems(ctx, tx, res.Items, apply); err != nil {
+			return err
+		}
+
+		return setReservationStatus(ctx, tx, orderId, domain.ReservationReturned)
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// Adjust implements repository.ReservationRepository.
+func (r *ReservationRepository) Adjust(ctx context.Context, orderId, adjustmentId string, items map[string]uint64) error {
+	const op = "repository.ReservationRepository.Adjust"
+
+	err := r.withReservation(ctx, orderId, func(ctx context.Context, tx pgx.Tx, res *domain.Reservation) error {
+		if res == nil {
+			return domain.ErrReservationNotFound
+		}
+
+		applied, err := adjustmentExists(ctx, tx, adjustmentId)
+		if err != nil || applied {
+			return err
+		}
+
+		// Only what is still held for the order can be given back.
+		adjusted := make(map[string]uint64, len(items))
+		for id, q := range items {
+			if q = min(q, res.Items[id]); q > 0 {
+				adjusted[id] = q
+			}
+		}
+
+		var apply func(*domain.Item, uint64) error
+		switch res.Status {
+		case domain.ReservationCommitted:
+			apply = func(i *domain.Item, q uint64) error {
+				i.AddQuantity(q)
+				return nil
+			}
+		case domain.ReservationReserved:
+			apply = (*domain.Item).UnlockQuantity
+		default:
+			// Released or returned reservation gave everything back already.
+			clear(adjusted)
+		}
+
+		if len(adjusted) > 0 {
+			if err := applyToItems(ctx, tx, adjusted, apply); err != nil {
+				return err
+			}
+
+			for id, q := range adjusted {
+				if res.Items[id] -= q; res.Items[id] == 0 {
+					delete(res.Items, id)
+				}
+			}
+
+			if err := setReservationItems(ctx, tx, orderId, res.Items); err != nil {
+				return err
+			}
+		}
+
+		return insertAdjustment(ctx, tx, adjustmentId, orderId, adjusted)
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// Extend implements repository.ReservationRepository.
+func (r *ReservationRepository) Extend(ctx context.Context, orderId, adjustmentId string, items map[string]uint64) error {
+	const op = "repository.ReservationRepository.Extend"
+
+	// Rejection is recorded, so it is returned once transaction is committed.
+	var rejected error
+	err := r.withReservation(ctx, orderId, func(ctx context.Context, tx pgx.Tx, res *domain.Reservation) error {
+		applied, wasRejected, err := getAdjustment(ctx, tx, adjustmentId)
+		if err != nil {
+			return err
+		}
+		if applied {
+			if wasRejected {
+				rejected = domain.ErrExtensionRejected
+			}
+			return nil
+		}
+
+		reject := func(cause error) error {
+			rejected = fmt.Errorf("%w: %w", domain.ErrExtensionRejected, cause)
+			return insertRejectedAdjustment(ctx, tx, adjustmentId, orderId)
+		}
+
+		if res == nil {
+			return reject(domain.ErrReservationNotFound)
+		}
+
+		extended := make(map[string]uint64, len(items))
+		for id, q := range items {
+			if q > 0 {
+				extended[id] = q
+			}
+		}
+
+		var apply func(*domain.Item, uint64) error
+		switch res.Status {
+		case domain.ReservationReserved:
+			apply = (*domain.Item).LockQuantity
+		case domain.ReservationCommitted:
+			apply = (*domain.Item).SubQuantity
+		default:
+			// Nothing is held for released or returned reservation, so there is nothing to add to.
+			clear(extended)
+		}
+
+		if len(extended) > 0 {
+			// Quantities of items changed before failing one are rolled back to savepoint, rejection is kept.
+			sp, err := tx.Begin(ctx)
+			if err != nil {
+				return err
+			}
+
+			if err := applyToItems(ctx, sp, extended, apply); err != nil {
+				if rbErr := sp.Rollback(ctx); rbErr != nil {
+					return rbErr
+				}
+				if !errors.Is(err, domain.ErrNotEnoughQuantity) && !errors.Is(err, domain.ErrProductNotFound) {
+					return err
+				}
+				return reject(err)
+			}
+
+			if err := sp.Commit(ctx); err != nil {
+				return err
+			}
+
+			for id, q := range extended {
+				res.Items[id] += q
+			}
+
+			if err := setReservationItems(ctx, tx, orderId, res.Items); err != nil {
+				return err
+			}
+		}
+
+		return insertAdjustment(ctx, tx, adjustmentId, orderId, extended)
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if rejected != nil {
+		return fmt.Errorf("%s: %w", op, rejected)
+	}
+
+	return nil
+}
+
+// withReservation runs fn in transaction holding advisory lock on order id.
+// Lock is taken even if reservation doesn't exist yet, so concurrent inserts are serialized too.
+func (r *ReservationRepository) withReservation(ctx context.Context, orderId string, fn func(ctx context.Context, tx pgx.Tx, res *domain.Reservation) error) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = func() error {
+		if _, err := tx.Exec(ctx, `SELECT pg_advisory_xact_lock(hashtext($1))`, orderId); err != nil {
+			return err
+		}
+
+		res, err := getReservation(ctx, tx, orderId)
+		if err != nil {
+			return err
+		}
+
+		return fn(ctx, tx, res)
+	}()
+	if err != nil {
+		if rbErr := tx.Rollback(ctx); rbErr != nil {
+			return rbErr
+		}
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// getReservation returns nil if order has no reservation.
+func getReservation(ctx context.Context, tx pgx.Tx, orderId string) (*domain.Reservation, error) {
+	query := fmt.Sprintf(
+		`SELECT order_id, items, status, created_at, updated_at FROM %s WHERE order_id = $1`,
+		reservationsTable)
+
+	var res domain.Reservation
+	if err := tx.QueryRow(ctx, query, orderId).Scan(&res.OrderID, &res.Items, &res.Status, &res.CreatedAt, &res.UpdatedAt); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func insertReservation(ctx context.Context, tx pgx.Tx, orderId string, items map[string]uint64, status domain.ReservationStatus) error {
+	query := fmt.Sprintf(
+		`INSERT INTO %s (order_id, items, status, created_at, updated_at) VALUES ($1, $2, $3, $4, $4)`,
+		reservationsTable)
+
+	_, err := tx.Exec(ctx, query, orderId, items, status, time.Now().UTC())
+	return err
+}
+
+func setReservationStatus(ctx context.Context, tx pgx.Tx, orderId string, status domain.ReservationStatus) error {
+	query := fmt.Sprintf(
+		`UPDATE %s SET status = $2, updated_at = $3 WHERE order_id = $1`,
+		reservationsTable)
+
+	_, err := tx.Exec(ctx, query, orderId, status, time.Now().UTC())
+	return err
+}
+
+// applyToItems locks rows of given items, applies fn with item's quantity and saves result.
+// Rows are locked in product id order to avoid deadlocks between orders sharing products.
+func applyToItems(ctx context.Context, tx pgx.Tx, quantities map[string]uint64, fn func(*domain.Item, uint64) error) error {
+	ids := make([]string, 0, len(quantities))
+	for id := range quantities {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	query := fmt.Sprintf(
+		`SELECT product_id, available_quantity, reserved_quantity FROM %s
+		WHERE product_id = ANY($1) ORDER BY product_id FOR UPDATE`,
+		itemsTable)
+
+	rows, err := tx.Query(ctx, query, ids)
+	if err != nil {
+		return err
+	}
+
+	var items []*domain.Item
+	for rows.Next() {
+		var item domain.Item
+		if err := rows.Scan(&item.ProductID, &item.AvailableQuantity, &item.ReservedQuantity); err != nil {
+			rows.Close()
+			return err
+		}
+		items = append(items, &item)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	if len(items) != len(ids) {
+		return domain.ErrProductNotFound
+	}
+
+	update := fmt.Sprintf(
+		`UPDATE %s SET available_quantity = $2, reserved_quantity = $3 WHERE product_id = $1`,
+		itemsTable)
+
+	for _, item := range items {
+		if err := fn(item, quantities[item.ProductID.String()]); err != nil {
+			return err
+		}
+
+		if _, err := tx.Exec(ctx, update, item.ProductID.String(), item.AvailableQuantity, item.ReservedQuantity); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func setReservationItems(ctx context.Context, tx pgx.Tx, orderId string, items map[string]uint64) error {
+	query := fmt.Sprintf(
+		`UPDATE %s SET items = $2, updated_at = $3 WHERE order_id = $1`,
+		reservationsTable)
+
+	_, err := tx.Exec(ctx, query, orderId, items, time.Now().UTC())
+	return err
+}
+
+func adjustmentExists(ctx context.Context, tx pgx.Tx, adjustmentId string) (bool, error) {
+	applied, _, err := getAdjustment(ctx, tx, adjustmentId)
+	return applied, err
+}
+
+// getAdjustment tells if adjustment was applied already and if it was rejected rather than applied.
+func getAdjustment(ctx context.Context, tx pgx.Tx, adjustmentId string) (applied, rejected bool, err error) {
+	query := fmt.Sprintf(
+		`SELECT rejected FROM %s WHERE adjustment_id = $1`,
+		adjustmentsTable)
+
+	err = tx.QueryRow(ctx, query, adjustmentId).Scan(&rejected)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return false, false, nil
+	}
+	if err != nil {
+		return false, false, err
+	}
+
+	return true, rejected, nil
+}
+
+// insertAdjustment records quantities actually given back or taken, so repeated adjustment is skipped.
+func insertAdjustment(ctx context.Context, tx pgx.Tx, adjustmentId, orderId string, items map[string]uint64) error {
+	query := fmt.Sprintf(
+		`INSERT INTO %s (adjustment_id, order_id, items, created_at) VALUES ($1, $2, $3, $4)`,
+		adjustmentsTable)
+
+	_, err := tx.Exec(ctx, query, adjustmentId, orderId, items, time.Now().UTC())
+	return err
+}
+
+// insertRejectedAdjustment records adjustment that took nothing, so it is never applied later.
+func insertRejectedAdjustment(ctx context.Context, tx pgx.Tx, adjustmentId, orderId string) error {
+	query := fmt.Sprintf(
+		`INSERT INTO %s (adjustment_id, order_id, items, rejected, created_at) VALUES ($1, $2, $3, true, $4)`,
+		adjustmentsTable)
+
+	_, err := tx.Exec(ctx, query, adjustmentId, orderId, map[string]uint64{}, time.Now().UTC())
+	return err
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/dzhordano/ecom-thing/services/inventory/internal/application/interfaces"
+	"github.com/dzhordano/ecom-thing/services/inventory/internal/domain"
 	"github.com/dzhordano/ecom-thing/services/inventory/internal/interfaces/grpc_server/converter"
 	api "github.com/dzhordano/ecom-thing/services/inventory/pkg/api/inventory/v1"
 	"github.com/google/uuid"
@@ -83,7 +84,7 @@ func (h *ItemHandler) SetItem(ctx context.Context, req *api.SetItemRequest) (*ap
 
 	span.AddEvent("call service")
 
-	err = h.service.SetItemWithOp(ctx, itemId, req.Item.GetQuantity(), req.OperationType.String())
+	err = h.service.SetItemWithOp(ctx, itemId, req.Item.GetQuantity(), protoOpToString(req.OperationType))
 	if err != nil {
 		return nil, err
 	}
@@ -122,7 +123,7 @@ func (h *ItemHandler) SetItems(ctx context.Context, req *api.SetItemsRequest) (_
 
 	span.AddEvent("call service")
 
-	if err := h.service.SetItemsWithOp(ctx, pItems, req.OperationType.String()); err != nil {
+	if err := h.service.SetItemsWithOp(ctx, pItems, protoOpToString(req.OperationType)); err != nil {
 		return nil, err
 	}
 
@@ -174,6 +175,23 @@ func (h *ItemHandler) IsReservable(ctx context.Context, req *api.IsReservableReq
 	}, nil
 }
 
+func protoOpToString(op api.OperationType) string {
+	switch op {
+	case api.OperationType_OPERATION_TYPE_ADD:
+		return domain.OperationAdd
+	case api.OperationType_OPERATION_TYPE_SUB:
+		return domain.OperationSub
+	case api.OperationType_OPERATION_TYPE_LOCK:
+		return domain.OperationLock
+	case api.OperationType_OPERATION_TYPE_UNLOCK:
+		return domain.OperationUnlock
+	case api.OperationType_OPERATION_TYPE_SUB_LOCKED:
+		return domain.OperationSubLocked
+	default:
+		return domain.OperationUnknown
+	}
+}
+
 func parseUUID(id string) (uuid.UUID, error) {
 	out, err := uuid.Parse(id)
 	if err != nil {
@@ -0,0 +1,254 @@
+package interceptors
+
+import (
+	"context"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/dzhordano/ecom-thing/services/inventory/internal/domain"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	authorizationHeader = "authorization"
+	bearerPrefix        = "bearer "
+)
+
+var (
+	errMissingToken = status.Error(codes.Unauthenticated, "missing bearer token")
+	errInvalidToken = status.Error(codes.Unauthenticated, "invalid token")
+
+	errNoToken = errors.New("no authorization header")
+)
+
+// Claims is a set of JWT claims expected from identity provider.
+//
+// Subject holds user id (UUID).
+type Claims struct {
+	Roles []string `json:"roles"`
+	jwt.RegisteredClaims
+}
+
+// Authenticator verifies bearer JWT and stores domain.Principal in request context.
+//
+// Requests without authorization header pass through anonymously, it is up to
+// authorization policy to decide whether method may be called without principal.
+type Authenticator struct {
+	keyFunc jwt.Keyfunc
+	methods []string
+}
+
+// ErrEmptySecret is returned for empty HMAC secret, anyone could sign tokens with it.
+var ErrEmptySecret = errors.New("hmac secret is empty")
+
+// NewHMACAuthenticator creates Authenticator that verifies tokens signed with shared secret (HS256/384/512).
+func NewHMACAuthenticator(secret []byte) (*Authenticator, error) {
+	if len(secret) == 0 {
+		return nil, ErrEmptySecret
+	}
+
+	return &Authenticator{
+		keyFunc: func(_ *jwt.Token) (any, error) {
+			return secret, nil
+		},
+		methods: []string{"HS256", "HS384", "HS512"},
+	}, nil
+}
+
+// NewJWKSAuthenticator creates Authenticator that verifies RS256 tokens against keys from local JWKS file.
+//
+// File is re-read when token has unknown kid, so rotated keys are picked up without restart.
+func NewJWKSAuthenticator(path string) (*Authenticator, error) {
+	ks := &jwksKeySet{path: path}
+	if err := ks.load(); err != nil {
+		return nil, err
+	}
+
+	return &Authenticator{
+		keyFunc: ks.keyFunc,
+		methods: []string{"RS256"},
+	}, nil
+}
+
+// Authenticate parses token and returns principal it was issued for.
+func (a *Authenticator) Authenticate(token string) (domain.Principal, error) {
+	var claims Claims
+	if _, err := jwt.ParseWithClaims(token, &claims, a.keyFunc, jwt.WithValidMethods(a.methods), jwt.WithExpirationRequired()); err != nil {
+		return domain.Principal{}, err
+	}
+
+	userId, err := uuid.Parse(claims.Subject)
+	if err != nil {
+		return domain.Principal{}, fmt.Errorf("invalid subject: %w", err)
+	}
+
+	p := domain.Principal{UserID: userId}
+	for _, r := range claims.Roles {
+		// System role is reserved for in-process callers.
+		if domain.Role(r) == domain.RoleSystem {
+			continue
+		}
+		p.Roles = append(p.Roles, domain.Role(r))
+	}
+
+	return p, nil
+}
+
+func (a *Authenticator) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx, err := a.authenticateContext(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+func (a *Authenticator) authenticateContext(ctx context.Context) (context.Context, error) {
+	token, err := bearerFromMD(ctx)
+	if errors.Is(err, errNoToken) {
+		return ctx, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := a.Authenticate(token)
+	if err != nil {
+		return nil, errInvalidToken
+	}
+
+	return domain.ContextWithPrincipal(ctx, p), nil
+}
+
+func bearerFromMD(ctx context.Context) (string, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", errNoToken
+	}
+
+	vals := md.Get(authorizationHeader)
+	if len(vals) == 0 {
+		return "", errNoToken
+	}
+
+	if len(vals[0]) <= len(bearerPrefix) || !strings.EqualFold(vals[0][:len(bearerPrefix)], bearerPrefix) {
+		return "", errMissingToken
+	}
+
+	return vals[0][len(bearerPrefix):], nil
+}
+
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+}
+
+type jwksKeySet struct {
+	path string
+
+	mu   sync.RWMutex
+	keys map[string]*rsa.PublicKey
+}
+
+func (ks *jwksKeySet) load() error {
+	data, err := os.ReadFile(ks.path)
+	if err != nil {
+		return fmt.Errorf("read jwks: %w", err)
+	}
+
+	var set struct {
+		Keys []jwk `json:"keys"`
+	}
+	if err := json.Unmarshal(data, &set); err != nil {
+		return fmt.Errorf("parse jwks: %w", err)
+	}
+
+	keys := make(map[string]*rsa.PublicKey, len(set.Keys))
+	for _, k := range set.Keys {
+		if k.Kty != "RSA" {
+			continue
+		}
+
+		pub, err := k.rsaPublicKey()
+		if err != nil {
+			return fmt.Errorf("parse jwk %q: %w", k.Kid, err)
+		}
+		keys[k.Kid] = pub
+	}
+
+	if len(keys) == 0 {
+		return errors.New("jwks contains no RSA keys")
+	}
+
+	ks.mu.Lock()
+	ks.keys = keys
+	ks.mu.Unlock()
+
+	return nil
+}
+
+func (ks *jwksKeySet) lookup(kid string) (*rsa.PublicKey, bool) {
+	ks.mu.RLock()
+	defer ks.mu.RUnlock()
+
+	if kid == "" && len(ks.keys) == 1 {
+		for _, k := range ks.keys {
+			return k, true
+		}
+	}
+
+	k, ok := ks.keys[kid]
+	return k, ok
+}
+
+func (ks *jwksKeySet) keyFunc(t *jwt.Token) (any, error) {
+	kid, _ := t.Header["kid"].(string)
+
+	if k, ok := ks.lookup(kid); ok {
+		return k, nil
+	}
+
+	// Key might have been rotated since last load.
+	if err := ks.load(); err != nil {
+		return nil, err
+	}
+
+	if k, ok := ks.lookup(kid); ok {
+		return k, nil
+	}
+
+	return nil, fmt.Errorf("unknown key id %q", kid)
+}
+
+func (k jwk) rsaPublicKey() (*rsa.PublicKey, error) {
+	n, err := base64.RawURLEncoding.DecodeString(k.N)
+	if err != nil {
+		return nil, err
+	}
+
+	e, err := base64.RawURLEncoding.DecodeString(k.E)
+	if err != nil {
+		return nil, err
+	}
+
+	return &rsa.PublicKey{
+		N: new(big.Int).SetBytes(n),
+		E: int(new(big.Int).SetBytes(e).Int64()),
+	}, nil
+}
@@ -36,6 +36,48 @@ func (m *MockItemService) EXPECT() *MockItemServiceMockRecorder {
 	return m.recorder
 }
 
+// AdjustItems mocks base method.
+func (m *MockItemService) AdjustItems(ctx context.Context, orderId, adjustmentId uuid.UUID, items map[string]uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AdjustItems", ctx, orderId, adjustmentId, items)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AdjustItems indicates an expected call of AdjustItems.
+func (mr *MockItemServiceMockRecorder) AdjustItems(ctx, orderId, adjustmentId, items interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AdjustItems", reflect.TypeOf((*MockItemService)(nil).AdjustItems), ctx, orderId, adjustmentId, items)
+}
+
+// CommitItems mocks base method.
+func (m *MockItemService) CommitItems(ctx context.Context, orderId uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CommitItems", ctx, orderId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CommitItems indicates an expected call of CommitItems.
+func (mr *MockItemServiceMockRecorder) CommitItems(ctx, orderId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CommitItems", reflect.TypeOf((*MockItemService)(nil).CommitItems), ctx, orderId)
+}
+
+// ExtendItems mocks base method.
+func (m *MockItemService) ExtendItems(ctx context.Context, orderId, adjustmentId uuid.UUID, items map[string]uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExtendItems", ctx, orderId, adjustmentId, items)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExtendItems indicates an expected call of ExtendItems.
+func (mr *MockItemServiceMockRecorder) ExtendItems(ctx, orderId, adjustmentId, items interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExtendItems", reflect.TypeOf((*MockItemService)(nil).ExtendItems), ctx, orderId, adjustmentId, items)
+}
+
 // GetItem mocks base method.
 func (m *MockItemService) GetItem(ctx context.Context, id uuid.UUID) (*domain.Item, error) {
 	m.ctrl.T.Helper()
@@ -66,6 +108,48 @@ func (mr *MockItemServiceMockRecorder) IsReservable(ctx, items interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsReservable", reflect.TypeOf((*MockItemService)(nil).IsReservable), ctx, items)
 }
 
+// ReleaseItems mocks base method.
+func (m *MockItemService) ReleaseItems(ctx context.Context, orderId uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseItems", ctx, orderId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseItems indicates an expected call of ReleaseItems.
+func (mr *MockItemServiceMockRecorder) ReleaseItems(ctx, orderId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseItems", reflect.TypeOf((*MockItemService)(nil).ReleaseItems), ctx, orderId)
+}
+
+// ReserveItems mocks base method.
+func (m *MockItemService) ReserveItems(ctx context.Context, orderId uuid.UUID, items map[string]uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReserveItems", ctx, orderId, items)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReserveItems indicates an expected call of ReserveItems.
+func (mr *MockItemServiceMockRecorder) ReserveItems(ctx, orderId, items interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReserveItems", reflect.TypeOf((*MockItemService)(nil).ReserveItems), ctx, orderId, items)
+}
+
+// ReturnItems mocks base method.
+func (m *MockItemService) ReturnItems(ctx context.Context, orderId uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReturnItems", ctx, orderId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReturnItems indicates an expected call of ReturnItems.
+func (mr *MockItemServiceMockRecorder) ReturnItems(ctx, orderId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReturnItems", reflect.TypeOf((*MockItemService)(nil).ReturnItems), ctx, orderId)
+}
+
 // SetItemWithOp mocks base method.
 func (m *MockItemService) SetItemWithOp(ctx context.Context, id uuid.UUID, quantity uint64, op string) error {
 	m.ctrl.T.Helper()
@@ -0,0 +1,16 @@
+package grpc_server
+
+import (
+	"github.com/dzhordano/ecom-thing/services/inventory/internal/domain"
+	"github.com/dzhordano/ecom-thing/services/inventory/internal/interfaces/grpc_server/interceptors"
+	api "github.com/dzhordano/ecom-thing/services/inventory/pkg/api/inventory/v1"
+)
+
+// methodPolicy mirrors security scopes declared in inventory.proto.
+// Stock reads are public, order service calls them without user token.
+var methodPolicy = interceptors.Policy{
+	api.InventoryService_GetItem_FullMethodName:      interceptors.Public(),
+	api.InventoryService_SetItem_FullMethodName:      {domain.RoleAdmin},
+	api.InventoryService_SetItems_FullMethodName:     {domain.RoleAdmin},
+	api.InventoryService_IsReservable_FullMethodName: interceptors.Public(),
+}
@@ -47,6 +47,8 @@ type Server struct {
 
 	cb *gobreaker.Settings
 	tp *tracesdk.TracerProvider
+
+	auth *interceptors.Authenticator
 }
 
 func WithAddr(addr string) Option {
@@ -69,6 +71,13 @@ func WithTracerProvider(tp *tracesdk.TracerProvider) Option {
 	}
 }
 
+// WithAuthenticator sets authenticator used to resolve caller identity from bearer token.
+func WithAuthenticator(a *interceptors.Authenticator) Option {
+	return func(s *Server) {
+		s.auth = a
+	}
+}
+
 func WithRateLimiter(limit, burst int) Option {
 	return func(s *Server) {
 		s.ratelimiterLimit = limit
@@ -113,6 +122,10 @@ func MustNew(log logger.Logger, handler api.InventoryServiceServer, opts ...Opti
 		panic("addr is required")
 	}
 
+	if s.auth == nil {
+		panic("authenticator is required")
+	}
+
 	recoveryOpts := []recovery.Option{
 		recovery.WithRecoveryHandler(func(p interface{}) (err error) {
 			log.Error("recovered from panic", "panic", p)
@@ -153,6 +166,8 @@ func MustNew(log logger.Logger, handler api.InventoryServiceServer, opts ...Opti
 			ratelimiter.RateLimiterInterceptor(),
 			recovery.UnaryServerInterceptor(recoveryOpts...),
 			logging.UnaryServerInterceptor(interceptors.InterceptorLogger(log), loggingOpts...),
+			s.auth.UnaryServerInterceptor(),
+			interceptors.AuthorizationInterceptor(methodPolicy),
 			interceptors.ErrorMapperInterceptor(),
 			interceptors.MetricsInterceptor(),
 		),
@@ -0,0 +1,7 @@
+CREATE TABLE IF NOT EXISTS reservations(
+  order_id UUID PRIMARY KEY,
+  items JSONB NOT NULL,
+  status VARCHAR(50) NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL,
+  updated_at TIMESTAMPTZ NOT NULL
+);
@@ -0,0 +1,6 @@
+CREATE TABLE IF NOT EXISTS reservation_adjustments(
+  adjustment_id UUID PRIMARY KEY,
+  order_id UUID NOT NULL,
+  items JSONB NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL
+);
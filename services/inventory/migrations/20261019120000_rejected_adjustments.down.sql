@@ -0,0 +1 @@
+ALTER TABLE reservation_adjustments DROP COLUMN IF EXISTS rejected;
@@ -0,0 +1,2 @@
+-- Extensions that couldn't be applied are kept as rejected, so redelivered ones are not applied later.
+ALTER TABLE reservation_adjustments ADD COLUMN IF NOT EXISTS rejected BOOLEAN NOT NULL DEFAULT false;
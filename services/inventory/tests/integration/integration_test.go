@@ -83,7 +83,7 @@ func (s *Suite) SetupSuite() {
 
 	s.db = pool
 	s.repo = pg.NewInventoryRepository(s.db)
-	s.svc = service.NewItemService(testLogger, s.repo)
+	s.svc = service.NewItemService(testLogger, s.repo, pg.NewReservationRepository(s.db))
 
 }
 
@@ -292,3 +292,152 @@ func (s *Suite) Test_IsReservable_NOTFOUND() {
 
 	s.False(isReservable)
 }
+
+func (s *Suite) Test_ReserveItems() {
+	orderID := uuid.New()
+	items := map[string]uint64{s.testItem1.ProductID.String(): 4}
+
+	s.NoError(s.svc.ReserveItems(context.Background(), orderID, items))
+	// Redelivered request must not reserve twice.
+	s.NoError(s.svc.ReserveItems(context.Background(), orderID, items))
+
+	item, err := s.repo.GetItem(context.Background(), s.testItem1.ProductID.String())
+	s.NoError(err)
+	s.Equal(uint64(6), item.AvailableQuantity)
+	s.Equal(uint64(14), item.ReservedQuantity)
+
+	s.NoError(s.svc.CommitItems(context.Background(), orderID))
+	s.NoError(s.svc.CommitItems(context.Background(), orderID))
+
+	item, err = s.repo.GetItem(context.Background(), s.testItem1.ProductID.String())
+	s.NoError(err)
+	s.Equal(uint64(6), item.AvailableQuantity)
+	s.Equal(uint64(10), item.ReservedQuantity)
+
+	s.NoError(s.svc.ReturnItems(context.Background(), orderID))
+
+	item, err = s.repo.GetItem(context.Background(), s.testItem1.ProductID.String())
+	s.NoError(err)
+	s.Equal(uint64(10), item.AvailableQuantity)
+	s.Equal(uint64(10), item.ReservedQuantity)
+}
+
+func (s *Suite) Test_ReserveItems_NotEnough() {
+	err := s.svc.ReserveItems(context.Background(), uuid.New(), map[string]uint64{
+		s.testItem1.ProductID.String(): 11,
+	})
+	s.ErrorIs(err, domain.ErrNotEnoughQuantity)
+
+	item, err := s.repo.GetItem(context.Background(), s.testItem1.ProductID.String())
+	s.NoError(err)
+	s.Equal(s.testItem1.AvailableQuantity, item.AvailableQuantity)
+	s.Equal(s.testItem1.ReservedQuantity, item.ReservedQuantity)
+}
+
+func (s *Suite) Test_ReleaseItems() {
+	orderID := uuid.New()
+	items := map[string]uint64{s.testItem1.ProductID.String(): 10}
+
+	s.NoError(s.svc.ReserveItems(context.Background(), orderID, items))
+	s.NoError(s.svc.ReleaseItems(context.Background(), orderID))
+	s.NoError(s.svc.ReleaseItems(context.Background(), orderID))
+
+	item, err := s.repo.GetItem(context.Background(), s.testItem1.ProductID.String())
+	s.NoError(err)
+	s.Equal(s.testItem1.AvailableQuantity, item.AvailableQuantity)
+	s.Equal(s.testItem1.ReservedQuantity, item.ReservedQuantity)
+
+	// Release that overtook reservation blocks it.
+	lateOrderID := uuid.New()
+	s.NoError(s.svc.ReleaseItems(context.Background(), lateOrderID))
+	s.ErrorIs(s.svc.ReserveItems(context.Background(), lateOrderID, items), domain.ErrReservationReleased)
+}
+
+func (s *Suite) Test_AdjustItems() {
+	orderID := uuid.New()
+	productID := s.testItem1.ProductID.String()
+
+	s.NoError(s.svc.ReserveItems(context.Background(), orderID, map[string]uint64{productID: 5}))
+
+	// Cancellation before commit unlocks reserved quantity.
+	cancellationID := uuid.New()
+	s.NoError(s.svc.AdjustItems(context.Background(), orderID, cancellationID, map[string]uint64{productID: 1}))
+	s.NoError(s.svc.AdjustItems(context.Background(), orderID, cancellationID, map[string]uint64{productID: 1}))
+
+	item, err := s.repo.GetItem(context.Background(), productID)
+	s.NoError(err)
+	s.Equal(uint64(6), item.AvailableQuantity)
+	s.Equal(uint64(14), item.ReservedQuantity)
+
+	s.NoError(s.svc.CommitItems(context.Background(), orderID))
+
+	item, err = s.repo.GetItem(context.Background(), productID)
+	s.NoError(err)
+	s.Equal(uint64(6), item.AvailableQuantity)
+	s.Equal(uint64(10), item.ReservedQuantity)
+
+	// Return of committed items puts them back, quantity above what is left is ignored.
+	s.NoError(s.svc.AdjustItems(context.Background(), orderID, uuid.New(), map[string]uint64{productID: 3}))
+	s.NoError(s.svc.AdjustItems(context.Background(), orderID, uuid.New(), map[string]uint64{productID: 5}))
+
+	item, err = s.repo.GetItem(context.Background(), productID)
+	s.NoError(err)
+	s.Equal(uint64(10), item.AvailableQuantity)
+	s.Equal(uint64(10), item.ReservedQuantity)
+
+	// Nothing is left to return for the order.
+	s.NoError(s.svc.ReturnItems(context.Background(), orderID))
+
+	item, err = s.repo.GetItem(context.Background(), productID)
+	s.NoError(err)
+	s.Equal(uint64(10), item.AvailableQuantity)
+
+	err = s.svc.AdjustItems(context.Background(), uuid.New(), uuid.New(), map[string]uint64{productID: 1})
+	s.ErrorIs(err, domain.ErrReservationNotFound)
+}
+
+func (s *Suite) Test_ExtendItems() {
+	orderID := uuid.New()
+	productID := s.testItem1.ProductID.String()
+
+	s.NoError(s.svc.ReserveItems(context.Background(), orderID, map[string]uint64{productID: 2}))
+
+	// Lines added to reserved order are locked, once per adjustment.
+	lockID := uuid.New()
+	s.NoError(s.svc.ExtendItems(context.Background(), orderID, lockID, map[string]uint64{productID: 3}))
+	s.NoError(s.svc.ExtendItems(context.Background(), orderID, lockID, map[string]uint64{productID: 3}))
+
+	item, err := s.repo.GetItem(context.Background(), productID)
+	s.NoError(err)
+	s.Equal(uint64(5), item.AvailableQuantity)
+	s.Equal(uint64(15), item.ReservedQuantity)
+
+	rejectedID := uuid.New()
+	err = s.svc.ExtendItems(context.Background(), orderID, rejectedID, map[string]uint64{productID: 100})
+	s.ErrorIs(err, domain.ErrExtensionRejected)
+	s.ErrorIs(err, domain.ErrNotEnoughQuantity)
+
+	// Rejected extension stays rejected once there is enough stock.
+	s.NoError(s.repo.SetItem(context.Background(), productID, 200, 15))
+	err = s.svc.ExtendItems(context.Background(), orderID, rejectedID, map[string]uint64{productID: 100})
+	s.ErrorIs(err, domain.ErrExtensionRejected)
+	s.NoError(s.repo.SetItem(context.Background(), productID, 5, 15))
+
+	// Release gives back extended quantity too.
+	s.NoError(s.svc.ReleaseItems(context.Background(), orderID))
+
+	item, err = s.repo.GetItem(context.Background(), productID)
+	s.NoError(err)
+	s.Equal(uint64(10), item.AvailableQuantity)
+	s.Equal(uint64(10), item.ReservedQuantity)
+
+	s.NoError(s.svc.ExtendItems(context.Background(), orderID, uuid.New(), map[string]uint64{productID: 1}))
+
+	item, err = s.repo.GetItem(context.Background(), productID)
+	s.NoError(err)
+	s.Equal(uint64(10), item.AvailableQuantity)
+
+	err = s.svc.ExtendItems(context.Background(), uuid.New(), uuid.New(), map[string]uint64{productID: 1})
+	s.ErrorIs(err, domain.ErrExtensionRejected)
+	s.ErrorIs(err, domain.ErrReservationNotFound)
+}
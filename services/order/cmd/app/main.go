@@ -12,11 +12,19 @@ import (
 
 	"github.com/dzhordano/ecom-thing/services/order/internal/application/service"
 	"github.com/dzhordano/ecom-thing/services/order/internal/config"
+	"github.com/dzhordano/ecom-thing/services/order/internal/infrastructure/broadcast"
+	"github.com/dzhordano/ecom-thing/services/order/internal/infrastructure/cache"
+	"github.com/dzhordano/ecom-thing/services/order/internal/infrastructure/checkout"
+	"github.com/dzhordano/ecom-thing/services/order/internal/infrastructure/expiry"
 	"github.com/dzhordano/ecom-thing/services/order/internal/infrastructure/grpc/inventory"
 	"github.com/dzhordano/ecom-thing/services/order/internal/infrastructure/grpc/product"
+	"github.com/dzhordano/ecom-thing/services/order/internal/infrastructure/idempotency"
 	"github.com/dzhordano/ecom-thing/services/order/internal/infrastructure/kafka"
+	"github.com/dzhordano/ecom-thing/services/order/internal/infrastructure/pricing"
 	"github.com/dzhordano/ecom-thing/services/order/internal/infrastructure/repository/pg"
+	"github.com/dzhordano/ecom-thing/services/order/internal/infrastructure/retention"
 	"github.com/dzhordano/ecom-thing/services/order/internal/interfaces/grpc_server"
+	"github.com/dzhordano/ecom-thing/services/order/internal/interfaces/grpc_server/interceptors"
 	"github.com/dzhordano/ecom-thing/services/order/pkg/logger"
 )
 
@@ -55,9 +63,21 @@ func main() {
 		}
 	}()
 
-	repo := pg.NewOrderRepository(db)
+	// Orders saved through repo are pushed to watchers of this replica.
+	broadcaster := broadcast.NewBroadcaster()
+	repo := broadcast.NewOrderRepository(pg.NewOrderRepository(db), broadcaster)
 
 	ps := product.NewProductClient(cfg.GRPCProduct.Addr(), product.WithTracing(tp))
+	if cfg.ProductCache.Size > 0 {
+		pc := cache.NewProductCache(log, ps, cfg.ProductCache.Size, cfg.ProductCache.TTL, cfg.ProductCache.MaxStale)
+		ps = pc
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			kafka.NewProductEventsConsumer(cfg.Kafka.Brokers, cfg.ProductCache.Topic, pc, time.Second).Run(ctx)
+		}()
+	}
 
 	is := inventory.NewInventoryClient(cfg.GRPCInventory.Addr(), inventory.WithTracing(tp))
 
@@ -72,13 +92,64 @@ func main() {
 
 	// TODO тута хардкод
 
-	svc := service.NewOrderService(log, ps, is, repo)
+	co := service.NewCheckoutService(
+		log,
+		service.CheckoutConfig{
+			StockTimeout:   cfg.Checkout.StockTimeout,
+			PaymentTimeout: cfg.Checkout.PaymentTimeout,
+			RetryInterval:  cfg.Checkout.RetryInterval,
+		},
+		pg.NewSagaRepository(db),
+		repo,
+		pg.NewTransactor(db),
+		outbox.NewInventoryCommands(),
+		outbox.NewPaymentCommands(),
+	)
+	checkoutWorker := checkout.NewWorker(log, co, cfg.Checkout.PollInterval)
+	go checkoutWorker.Start(ctx)
+
+	expiryWorker := expiry.NewWorker(log, service.NewExpiryService(log, repo, cfg.Expiry.PaymentWindow), cfg.Expiry.Interval)
+	go expiryWorker.Start(ctx)
+
+	retentionWorker := retention.NewWorker(log, service.NewRetentionService(log, repo, cfg.Retention.Period, cfg.Retention.Purge),
+		cfg.Retention.Interval)
+	go retentionWorker.Start(ctx)
+
+	keys := pg.NewIdempotencyRepository(db)
+	keysCleaner := idempotency.NewCleaner(log, keys, cfg.Idempotency.CleanupInterval)
+	go keysCleaner.Start(ctx)
+
+	coupons := pg.NewCouponRepository(db)
+	returns := pg.NewReturnRepository(db)
+
+	shipping := pricing.NewRuleShipping(pg.NewShippingRuleRepository(db))
+
+	svc := service.NewOrderService(log, ps, is, shipping, pricing.NewTableTaxes(pg.NewTaxRepository(db)), repo, coupons, co, keys, cfg.Idempotency.KeyTTL, cfg.Idempotency.KeyLease)
+
+	var auth *interceptors.Authenticator
+	if cfg.Auth.JWKSFile != "" {
+		auth, err = interceptors.NewJWKSAuthenticator(cfg.Auth.JWKSFile)
+		if err != nil {
+			log.Error("error creating jwks authenticator", "error", err)
+			return
+		}
+	} else {
+		auth, err = interceptors.NewHMACAuthenticator([]byte(cfg.Auth.HMACSecret))
+		if err != nil {
+			log.Error("error creating hmac authenticator", "error", err)
+			return
+		}
+	}
 
 	srv := grpc_server.MustNew(
 		log,
 		grpc_server.NewOrderHandler(svc),
 		grpc_server.WithAddr(cfg.GRPC.Addr()),
 		grpc_server.WithTracerProvider(tp),
+		grpc_server.WithAuthenticator(auth),
+		grpc_server.WithCouponHandler(grpc_server.NewCouponHandler(service.NewCouponService(log, coupons))),
+		grpc_server.WithReturnHandler(grpc_server.NewReturnHandler(service.NewReturnService(log, repo, returns))),
+		grpc_server.WithOrderWatcher(service.NewWatchService(log, repo, broadcaster, cfg.Watch.Resync), cfg.Watch.Heartbeat),
 		// FIXME ещо
 	)
 
@@ -88,7 +159,7 @@ func main() {
 			ctx,
 			cfg.Kafka.Brokers,
 			cfg.Kafka.TopicsToConsume,
-			svc,
+			co,
 			time.Second,
 			uint(100),
 		)
@@ -116,6 +187,8 @@ func main() {
 	// TODO вроде можно объединить?
 	<-q
 	cancel()
+	// Ends order watch streams, graceful stop waits for them otherwise.
+	broadcaster.Close()
 
 	shutdownWG := &sync.WaitGroup{}
 	shutdownWG.Add(1)
@@ -0,0 +1,39 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CreateCouponRequest describes new coupon. Amounts are in minor units of Currency.
+type CreateCouponRequest struct {
+	Code                  string
+	Type                  string
+	Discount              float64
+	Amount                int64
+	Currency              string
+	MinOrderAmount        int64
+	MaxRedemptions        uint64
+	MaxRedemptionsPerUser uint64
+	ProductIDs            []uuid.UUID
+	Categories            []string
+	ValidFrom             time.Time
+	ValidTo               time.Time
+}
+
+// UpdateCouponRequest changes coupon fields that are set. Empty slices remove restrictions.
+type UpdateCouponRequest struct {
+	Code                  string
+	Type                  *string
+	Discount              *float64
+	Amount                *int64
+	Currency              *string
+	MinOrderAmount        *int64
+	MaxRedemptions        *uint64
+	MaxRedemptionsPerUser *uint64
+	ProductIDs            *[]uuid.UUID
+	Categories            *[]string
+	ValidFrom             *time.Time
+	ValidTo               *time.Time
+}
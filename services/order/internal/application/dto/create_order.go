@@ -12,7 +12,9 @@ type CreateOrderRequest struct {
 	Coupon          string
 	PaymentMethod   string
 	DeliveryMethod  string
-	DeliveryAddress string
+	DeliveryAddress domain.Address
 	DeliveryDate    time.Time
 	Items           []domain.Item
+	// IdempotencyKey is optional, request repeated with the same key creates no new order.
+	IdempotencyKey string
 }
@@ -0,0 +1,12 @@
+package dto
+
+import "github.com/dzhordano/ecom-thing/services/order/pkg/money"
+
+// ProductInfo is what order needs to know about product to price a line.
+type ProductInfo struct {
+	Name string
+	// In catalog currency, amount is taken as is in order currency.
+	Price    money.Money
+	Category string
+	IsActive bool
+}
@@ -0,0 +1,13 @@
+package dto
+
+import (
+	"github.com/dzhordano/ecom-thing/services/order/internal/domain"
+	"github.com/google/uuid"
+)
+
+type RequestReturnRequest struct {
+	OrderID uuid.UUID
+	// Only ProductID and Quantity are used.
+	Items  []domain.Item
+	Reason string
+}
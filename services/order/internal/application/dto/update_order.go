@@ -10,11 +10,12 @@ import (
 type UpdateOrderRequest struct {
 	OrderID         uuid.UUID
 	Description     *string
-	Status          *string
-	TotalPrice      *float64
 	PaymentMethod   *string
 	DeliveryMethod  *string
-	DeliveryAddress *string
+	DeliveryAddress *domain.Address
 	DeliveryDate    time.Time
 	Items           []domain.Item
+	// Version is version of order update is based on. Update is refused if order was changed since.
+	// Nil skips the check.
+	Version *int64
 }
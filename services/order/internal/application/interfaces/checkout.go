@@ -0,0 +1,55 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/dzhordano/ecom-thing/services/order/internal/domain"
+	"github.com/google/uuid"
+)
+
+// Checkout orchestrates order checkout saga: reserve stock -> create payment -> await payment -> commit or release stock.
+// Payment captured by aborted checkout is refunded, checkout is over once refund is confirmed.
+//
+// Event handlers ignore events that don't fit current saga state, so redelivered and late events are harmless.
+type Checkout interface {
+	// Start begins checkout of just created order.
+	Start(ctx context.Context, order *domain.Order) error
+	// Abort compensates checkout of order cancelled by its owner or staff.
+	Abort(ctx context.Context, orderId uuid.UUID, reason string) error
+	// CancelPaid cancels paid order and compensates its checkout: stock is returned and payment is refunded.
+	CancelPaid(ctx context.Context, orderId uuid.UUID) error
+
+	StockReserved(ctx context.Context, orderId uuid.UUID) error
+	StockRejected(ctx context.Context, orderId uuid.UUID) error
+	// StockLockReserved confirms units added to order after checkout started, see repository.OrderRepository.UpdateItems.
+	StockLockReserved(ctx context.Context, orderId, lockId uuid.UUID) error
+	// StockLockRejected takes units inventory failed to lock off order, paid order is refunded for them.
+	// Order left without units is cancelled and its checkout is compensated.
+	StockLockRejected(ctx context.Context, orderId, lockId uuid.UUID) error
+	PaymentCompleted(ctx context.Context, orderId uuid.UUID) error
+	PaymentFailed(ctx context.Context, orderId uuid.UUID) error
+	// PaymentRefunded confirms refund of order payment. Refunds other than one of checkout itself are ignored.
+	PaymentRefunded(ctx context.Context, orderId, refundId uuid.UUID) error
+
+	// ProcessDue aborts timed out sagas and resends commands of sagas that made no progress.
+	ProcessDue(ctx context.Context) error
+}
+
+// InventoryCommands are sent to inventory service. Replies for Reserve arrive as checkout events.
+// Commands are sent within transaction of saga step, see repository.Transactor.
+type InventoryCommands interface {
+	Reserve(ctx context.Context, order *domain.Order) error
+	Release(ctx context.Context, order *domain.Order) error
+	Commit(ctx context.Context, order *domain.Order) error
+	Return(ctx context.Context, order *domain.Order) error
+}
+
+// PaymentCommands are sent to payment service. Payment result and refund confirmation arrive as checkout events.
+type PaymentCommands interface {
+	Create(ctx context.Context, order *domain.Order) error
+	Cancel(ctx context.Context, order *domain.Order) error
+	// Refund requests refund of captured payment, see domain.Order.RefundEvent.
+	Refund(ctx context.Context, order *domain.Order) error
+	// RefundItems requests refund of part of captured payment, see domain.Cancellation.RefundEvent.
+	RefundItems(ctx context.Context, cancellation *domain.Cancellation) error
+}
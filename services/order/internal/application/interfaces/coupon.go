@@ -0,0 +1,18 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/dzhordano/ecom-thing/services/order/internal/application/dto"
+	"github.com/dzhordano/ecom-thing/services/order/internal/domain"
+)
+
+// CouponService manages coupons. Coupons are redeemed by OrderService.CreateOrder.
+type CouponService interface {
+	CreateCoupon(ctx context.Context, req dto.CreateCouponRequest) (*domain.Coupon, error)
+	UpdateCoupon(ctx context.Context, req dto.UpdateCouponRequest) (*domain.Coupon, error)
+	DeactivateCoupon(ctx context.Context, code string) (*domain.Coupon, error)
+	ListCoupons(ctx context.Context, activeOnly bool, limit, offset uint64) ([]*domain.Coupon, error)
+	// GetCouponUsage returns coupon usage with up to limit most recent redemptions.
+	GetCouponUsage(ctx context.Context, code string, limit uint64) (*domain.CouponUsage, error)
+}
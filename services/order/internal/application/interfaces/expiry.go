@@ -0,0 +1,9 @@
+package interfaces
+
+import "context"
+
+// OrderExpiry cancels orders that were not paid within payment window, so their reserved stock is released.
+type OrderExpiry interface {
+	// ExpirePending cancels orders pending for longer than payment window and returns how many were cancelled.
+	ExpirePending(ctx context.Context) (int, error)
+}
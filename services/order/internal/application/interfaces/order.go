@@ -8,20 +8,35 @@ import (
 	"github.com/google/uuid"
 )
 
-// FIXME Тута id пользователя везде, т.к. его надо извлекать на interfaces а не application.
+// OrderService operates on behalf of domain.Principal stored in context.
 type OrderService interface {
 	CreateOrder(ctx context.Context, info dto.CreateOrderRequest) (*domain.Order, error)
+	// QuoteOrder prices order the way CreateOrder would, but places nothing. Problems that would make
+	// CreateOrder fail are returned as quote warnings.
+	QuoteOrder(ctx context.Context, info dto.CreateOrderRequest) (*domain.Quote, error)
 
 	GetById(ctx context.Context, orderId uuid.UUID) (*domain.Order, error)
-	ListByUser(ctx context.Context, limit, offset uint64) ([]*domain.Order, error)
+	// ListByUser pages orders of principal, filters carry paging keys only, see domain.NewPageParams.
+	ListByUser(ctx context.Context, filters map[string]any) (*domain.OrderPage, error)
 
 	UpdateOrder(ctx context.Context, info dto.UpdateOrderRequest) (*domain.Order, error)
+	// DeleteOrder archives order, its owner doesn't see it anymore. RestoreOrder brings it back.
 	DeleteOrder(ctx context.Context, orderId uuid.UUID) error
+	RestoreOrder(ctx context.Context, orderId uuid.UUID) (*domain.Order, error)
 
-	SearchOrders(ctx context.Context, filters map[string]any) ([]*domain.Order, error) // TODO Своя структура вместо any
+	SearchOrders(ctx context.Context, filters map[string]any) (*domain.OrderPage, error) // TODO Своя структура вместо any
 
+	// Status changes follow domain transition rules, see domain.Status.CanTransitionTo.
+	PayOrder(ctx context.Context, orderId uuid.UUID) error
+	ShipOrder(ctx context.Context, orderId uuid.UUID) error
+	DeliverOrder(ctx context.Context, orderId uuid.UUID) error
 	CompleteOrder(ctx context.Context, orderId uuid.UUID) error
 	CancelOrder(ctx context.Context, orderId uuid.UUID) error
-	// TODO better to implement this one...
-	// SetOrderStatus(ctx context.Context, orderId uuid.UUID, status string) error
+	RefundOrder(ctx context.Context, orderId uuid.UUID) error
+
+	// CancelOrderItems cancels quantities of pending or paid order lines, see domain.Order.CancelItems.
+	// Cancelled stock is released and their price refunded asynchronously.
+	CancelOrderItems(ctx context.Context, orderId uuid.UUID, items []domain.Item) (*domain.Order, error)
+
+	GetOrderHistory(ctx context.Context, orderId uuid.UUID) ([]*domain.HistoryEntry, error)
 }
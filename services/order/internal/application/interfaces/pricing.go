@@ -0,0 +1,26 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"github.com/dzhordano/ecom-thing/services/order/internal/domain"
+	"github.com/dzhordano/ecom-thing/services/order/pkg/money"
+)
+
+// ShippingCalculator prices and schedules delivery of order.
+//
+// Method that can't be used returns domain.ErrShippingRuleNotFound.
+type ShippingCalculator interface {
+	// ShippingCost returns price of delivering items with method, in order currency.
+	ShippingCost(ctx context.Context, method domain.DeliveryMethod, items domain.Items, currency domain.Currency) (money.Money, error)
+	// CheckDeliveryDate returns domain.ErrDeliveryDateUnavailable if order placed at now can't be delivered
+	// with method on date. Free slots are checked when order is saved.
+	CheckDeliveryDate(ctx context.Context, method domain.DeliveryMethod, date, now time.Time) error
+}
+
+// TaxCalculator looks up taxes of regions orders are delivered to.
+type TaxCalculator interface {
+	// Taxes returns tax table of region. Region without taxes gets a table with zero rates.
+	Taxes(ctx context.Context, region domain.TaxRegion) (*domain.TaxTable, error)
+}
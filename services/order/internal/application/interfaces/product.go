@@ -2,9 +2,21 @@ package interfaces
 
 import (
 	"context"
+
+	"github.com/dzhordano/ecom-thing/services/order/internal/application/dto"
 	"github.com/google/uuid"
 )
 
 type ProductService interface {
-	GetProductInfo(ctx context.Context, orderId uuid.UUID) (float64, bool, error)
+	GetProductInfo(ctx context.Context, productId uuid.UUID) (*dto.ProductInfo, error)
+	// GetProductsInfo looks up many products at once. Products that don't exist are missing from result,
+	// inactive ones are there with IsActive unset.
+	GetProductsInfo(ctx context.Context, productIds []uuid.UUID) (map[uuid.UUID]*dto.ProductInfo, error)
+}
+
+// ProductCache is ProductService that keeps products it looked up for a while.
+type ProductCache interface {
+	ProductService
+	// Invalidate drops cached product, so it's looked up again next time it's needed.
+	Invalidate(productId uuid.UUID)
 }
@@ -0,0 +1,9 @@
+package interfaces
+
+import "context"
+
+// OrderRetention erases orders archived for longer than retention period.
+type OrderRetention interface {
+	// EraseArchived anonymizes or purges orders archived past retention period and returns how many were erased.
+	EraseArchived(ctx context.Context) (int, error)
+}
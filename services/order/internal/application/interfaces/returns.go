@@ -0,0 +1,18 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/dzhordano/ecom-thing/services/order/internal/application/dto"
+	"github.com/dzhordano/ecom-thing/services/order/internal/domain"
+	"github.com/google/uuid"
+)
+
+// ReturnService handles returns of delivered orders. Owners request returns, staff moves them through
+// their lifecycle, see domain.ReturnStatus.
+type ReturnService interface {
+	RequestReturn(ctx context.Context, info dto.RequestReturnRequest) (*domain.Return, error)
+	GetReturn(ctx context.Context, returnId uuid.UUID) (*domain.Return, error)
+	ListOrderReturns(ctx context.Context, orderId uuid.UUID) ([]*domain.Return, error)
+	UpdateReturnStatus(ctx context.Context, returnId uuid.UUID, status string) (*domain.Return, error)
+}
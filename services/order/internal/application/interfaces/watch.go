@@ -0,0 +1,24 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/dzhordano/ecom-thing/services/order/internal/domain"
+	"github.com/google/uuid"
+)
+
+// OrderWatcher streams state of order as it changes.
+type OrderWatcher interface {
+	// WatchOrder sends current order first, then every change of it. Channel is closed when ctx is done,
+	// order is deleted or watching is shut down.
+	WatchOrder(ctx context.Context, orderId uuid.UUID) (<-chan *domain.Order, error)
+}
+
+// OrderBroadcaster fans saved orders out to watchers in this process. Published orders are shared
+// between subscribers and must not be modified.
+type OrderBroadcaster interface {
+	Publish(order *domain.Order)
+	// Subscribe returns updates of order until unsubscribe is called or broadcaster is closed.
+	// Slow subscriber only gets the latest update it missed.
+	Subscribe(orderId uuid.UUID) (updates <-chan *domain.Order, unsubscribe func())
+}
@@ -0,0 +1,457 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/dzhordano/ecom-thing/services/order/internal/application/interfaces"
+	"github.com/dzhordano/ecom-thing/services/order/internal/domain"
+	"github.com/dzhordano/ecom-thing/services/order/internal/domain/repository"
+	"github.com/dzhordano/ecom-thing/services/order/pkg/logger"
+	"github.com/google/uuid"
+)
+
+const (
+	// How many times event is applied again if saga or its order was changed concurrently.
+	conflictRetries = 3
+	// How many due sagas are processed per ProcessDue call.
+	sagaBatchSize = 100
+
+	reasonOutOfStock     = "out of stock"
+	reasonPaymentFailed  = "payment failed"
+	reasonStockTimeout   = "stock reservation timed out"
+	reasonPaymentTimeout = "payment timed out"
+	reasonOrderCancelled = "order cancelled"
+)
+
+type CheckoutConfig struct {
+	// How long to wait for inventory to reserve stock.
+	StockTimeout time.Duration
+	// How long to wait for payment result.
+	PaymentTimeout time.Duration
+	// How often commands of a step that made no progress are sent again.
+	RetryInterval time.Duration
+}
+
+type CheckoutService struct {
+	log       logger.Logger
+	cfg       CheckoutConfig
+	sagas     repository.SagaRepository
+	orders    repository.OrderRepository
+	tx        repository.Transactor
+	inventory interfaces.InventoryCommands
+	payments  interfaces.PaymentCommands
+	now       func() time.Time
+}
+
+// NewCheckoutService returns orchestrator that writes every saga step, order changes and commands of the step
+// in one transaction of tx.
+func NewCheckoutService(l logger.Logger, cfg CheckoutConfig, sagas repository.SagaRepository, orders repository.OrderRepository,
+	tx repository.Transactor, inventory interfaces.InventoryCommands, payments interfaces.PaymentCommands) interfaces.Checkout {
+	return &CheckoutService{
+		log:       l,
+		cfg:       cfg,
+		sagas:     sagas,
+		orders:    orders,
+		tx:        tx,
+		inventory: inventory,
+		payments:  payments,
+		now:       func() time.Time { return time.Now().UTC() },
+	}
+}
+
+// Start implements interfaces.Checkout.
+func (c *CheckoutService) Start(ctx context.Context, order *domain.Order) error {
+	now := c.now()
+
+	saga := domain.NewCheckoutSaga(order.ID, now)
+	saga.Deadline = now.Add(c.cfg.StockTimeout)
+	saga.RetryAt = now // Due right away until reserve command is sent.
+
+	if err := c.sagas.Save(ctx, saga); err != nil {
+		c.log.Error("failed to start checkout", "error", err, "order_id", order.ID.String())
+		return domain.NewAppError(err, "failed to start checkout")
+	}
+
+	// Saga is persisted, so if reserve command fails it'll be sent again by ProcessDue.
+	if err := c.tx.WithinTx(ctx, func(ctx context.Context) error {
+		return c.run(ctx, saga, order)
+	}); err != nil {
+		c.log.Error("failed to run checkout", "error", err, "order_id", order.ID.String())
+	}
+
+	c.log.Debug("checkout started", "order_id", order.ID.String())
+
+	return nil
+}
+
+// Abort implements interfaces.Checkout.
+func (c *CheckoutService) Abort(ctx context.Context, orderId uuid.UUID, reason string) error {
+	err := c.handle(ctx, orderId, "abort", func(ctx context.Context, saga *domain.CheckoutSaga, order *domain.Order) error {
+		if err := saga.Abort(reason); err != nil {
+			c.log.Debug("checkout already aborted", "order_id", orderId.String(), "state", string(saga.State))
+			return nil
+		}
+
+		return c.run(ctx, saga, order)
+	})
+	// Orders created before checkout saga existed have nothing to compensate.
+	if errors.Is(err, domain.ErrSagaNotFound) {
+		c.log.Debug("no checkout to abort", "order_id", orderId.String())
+		return nil
+	}
+
+	return err
+}
+
+// CancelPaid implements interfaces.Checkout.
+//
+// Order is cancelled together with aborting saga, so paid order is never cancelled without refund.
+func (c *CheckoutService) CancelPaid(ctx context.Context, orderId uuid.UUID) error {
+	err := c.handle(ctx, orderId, "cancel paid", func(ctx context.Context, saga *domain.CheckoutSaga, order *domain.Order) error {
+		if order.Status != domain.OrderPaid {
+			return fmt.Errorf("%w: order is %s", domain.ErrInvalidTransition, order.Status)
+		}
+
+		return c.abort(ctx, saga, order, reasonOrderCancelled)
+	})
+	// Orders paid before checkout saga existed have nothing to refund them with.
+	if errors.Is(err, domain.ErrSagaNotFound) {
+		return domain.NewAppError(domain.ErrInvalidTransition, "paid order without checkout can't be cancelled")
+	}
+
+	return err
+}
+
+// StockReserved implements interfaces.Checkout.
+func (c *CheckoutService) StockReserved(ctx context.Context, orderId uuid.UUID) error {
+	return c.handle(ctx, orderId, "stock reserved", func(ctx context.Context, saga *domain.CheckoutSaga, order *domain.Order) error {
+		if err := saga.StockReserved(); err != nil {
+			c.log.Warn("ignoring checkout event", "error", err, "order_id", orderId.String())
+			return nil
+		}
+
+		saga.Deadline = c.now().Add(c.cfg.PaymentTimeout)
+
+		return c.run(ctx, saga, order)
+	})
+}
+
+// StockRejected implements interfaces.Checkout.
+func (c *CheckoutService) StockRejected(ctx context.Context, orderId uuid.UUID) error {
+	return c.handle(ctx, orderId, "stock rejected", func(ctx context.Context, saga *domain.CheckoutSaga, order *domain.Order) error {
+		if saga.State != domain.SagaReservingStock {
+			c.log.Warn("ignoring checkout event", "error", domain.ErrUnexpectedSagaEvent, "order_id", orderId.String(), "state", string(saga.State))
+			return nil
+		}
+
+		return c.abort(ctx, saga, order, reasonOutOfStock)
+	})
+}
+
+// StockLockReserved implements interfaces.Checkout.
+func (c *CheckoutService) StockLockReserved(ctx context.Context, orderId, lockId uuid.UUID) error {
+	if _, err := c.orders.TakeStockLock(ctx, orderId.String(), lockId.String()); err != nil {
+		if errors.Is(err, domain.ErrStockLockNotFound) {
+			c.log.Debug("stock lock already resolved", "order_id", orderId.String(), "lock_id", lockId.String())
+			return nil
+		}
+
+		c.log.Error("failed to handle checkout event", "error", err, "order_id", orderId.String(), "event", "stock lock reserved")
+		return domain.NewAppError(err, "failed to handle checkout event")
+	}
+
+	return nil
+}
+
+// StockLockRejected implements interfaces.Checkout.
+//
+// Lock is taken in the same transaction units are taken off order, so rejection is applied once.
+func (c *CheckoutService) StockLockRejected(ctx context.Context, orderId, lockId uuid.UUID) error {
+	return c.handle(ctx, orderId, "stock lock rejected", func(ctx context.Context, saga *domain.CheckoutSaga, order *domain.Order) error {
+		items, err := c.orders.TakeStockLock(ctx, orderId.String(), lockId.String())
+		if errors.Is(err, domain.ErrStockLockNotFound) {
+			c.log.Debug("stock lock already resolved", "order_id", orderId.String(), "lock_id", lockId.String())
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if order.Status != domain.OrderPending && order.Status != domain.OrderPaid {
+			// Cancelled order gave back its stock already, shipped one can't be changed.
+			c.log.Warn("ignoring rejected stock lock", "order_id", orderId.String(), "lock_id", lockId.String(),
+				"status", string(order.Status))
+			return nil
+		}
+
+		before := order.Clone()
+
+		rejection, err := order.RejectItems(lockId, items)
+		if errors.Is(err, domain.ErrAllItemsRejected) {
+			return c.abort(ctx, saga, order, reasonOutOfStock)
+		}
+		if err != nil {
+			return err
+		}
+
+		if len(rejection.Items) == 0 {
+			return nil
+		}
+
+		entry := domain.NewHistoryEntry(ctx, before, order)
+		if rejection.Paid {
+			entry.Diff = append(entry.Diff, domain.FieldChange{Field: "refund", To: rejection.Total().String()})
+		}
+
+		if err := c.orders.Update(ctx, order, entry); err != nil {
+			return err
+		}
+
+		c.log.Info("rejected order items taken off", "order_id", orderId.String(), "lock_id", lockId.String(),
+			"total", rejection.Total().String(), "refunded", rejection.Paid)
+
+		if rejection.Paid {
+			return c.payments.RefundItems(ctx, rejection)
+		}
+
+		return nil
+	})
+}
+
+// PaymentCompleted implements interfaces.Checkout.
+func (c *CheckoutService) PaymentCompleted(ctx context.Context, orderId uuid.UUID) error {
+	return c.handle(ctx, orderId, "payment completed", func(ctx context.Context, saga *domain.CheckoutSaga, order *domain.Order) error {
+		if saga.State == domain.SagaCompensating || saga.State == domain.SagaRefunding || saga.State == domain.SagaCompensated {
+			// Payment outran compensation, so it's refunded.
+			if err := saga.LatePayment(); err != nil {
+				c.log.Warn("ignoring checkout event", "error", err, "order_id", orderId.String())
+				return nil
+			}
+
+			c.log.Warn("payment completed for aborted checkout, refunding", "order_id", orderId.String(), "reason", saga.Reason)
+			return c.run(ctx, saga, order)
+		}
+
+		if order.Status == domain.OrderCancelled {
+			// Order was cancelled while payment was in flight. Same as above, but saga is yet to be aborted.
+			if err := saga.Abort(reasonOrderCancelled); err != nil {
+				return err
+			}
+			if err := saga.LatePayment(); err != nil {
+				return err
+			}
+
+			c.log.Warn("payment completed for cancelled order, refunding", "order_id", orderId.String())
+			return c.run(ctx, saga, order)
+		}
+
+		if err := saga.PaymentCompleted(); err != nil {
+			c.log.Warn("ignoring checkout event", "error", err, "order_id", orderId.String())
+			return nil
+		}
+
+		// Order may be already paid if previous attempt failed to save saga.
+		if order.Status == domain.OrderPending {
+			before := order.Clone()
+			if err := order.MarkPaid(); err != nil {
+				return err
+			}
+			if err := c.orders.Update(ctx, order, domain.NewHistoryEntry(ctx, before, order)); err != nil {
+				return err
+			}
+		}
+
+		return c.run(ctx, saga, order)
+	})
+}
+
+// PaymentFailed implements interfaces.Checkout.
+func (c *CheckoutService) PaymentFailed(ctx context.Context, orderId uuid.UUID) error {
+	return c.handle(ctx, orderId, "payment failed", func(ctx context.Context, saga *domain.CheckoutSaga, order *domain.Order) error {
+		if saga.State != domain.SagaAwaitingPayment {
+			c.log.Warn("ignoring checkout event", "error", domain.ErrUnexpectedSagaEvent, "order_id", orderId.String(), "state", string(saga.State))
+			return nil
+		}
+
+		return c.abort(ctx, saga, order, reasonPaymentFailed)
+	})
+}
+
+// PaymentRefunded implements interfaces.Checkout.
+func (c *CheckoutService) PaymentRefunded(ctx context.Context, orderId, refundId uuid.UUID) error {
+	// Refunds of cancelled items and returns are confirmed the same way, only checkout waits for its refund.
+	if refundId != orderId {
+		c.log.Debug("ignoring refund of order adjustment", "order_id", orderId.String(), "refund_id", refundId.String())
+		return nil
+	}
+
+	return c.handle(ctx, orderId, "payment refunded", func(ctx context.Context, saga *domain.CheckoutSaga, order *domain.Order) error {
+		if err := saga.Refunded(); err != nil {
+			c.log.Warn("ignoring checkout event", "error", err, "order_id", orderId.String())
+			return nil
+		}
+
+		saga.UpdatedAt = c.now()
+
+		c.log.Info("checkout payment refunded", "order_id", orderId.String())
+
+		return c.sagas.Update(ctx, saga)
+	})
+}
+
+// ProcessDue implements interfaces.Checkout.
+func (c *CheckoutService) ProcessDue(ctx context.Context) error {
+	due, err := c.sagas.ListDue(ctx, c.now(), sagaBatchSize)
+	if err != nil {
+		c.log.Error("failed to list due checkouts", "error", err)
+		return domain.NewAppError(err, "failed to list due checkouts")
+	}
+
+	for _, s := range due {
+		// Errors are logged by handle, other sagas are still processed.
+		_ = c.handle(ctx, s.OrderID, "due", func(ctx context.Context, saga *domain.CheckoutSaga, order *domain.Order) error {
+			// Saga could progress after it was listed.
+			if saga.IsFinished() || saga.RetryAt.After(c.now()) {
+				return nil
+			}
+
+			if saga.IsTimedOut(c.now()) {
+				reason := reasonStockTimeout
+				if saga.State == domain.SagaAwaitingPayment {
+					reason = reasonPaymentTimeout
+				}
+				return c.abort(ctx, saga, order, reason)
+			}
+
+			return c.run(ctx, saga, order)
+		})
+	}
+
+	return nil
+}
+
+// handle loads saga with its order and applies fn on behalf of the service.
+// fn is applied again with fresh saga and order if either was changed concurrently.
+func (c *CheckoutService) handle(ctx context.Context, orderId uuid.UUID, event string,
+	fn func(ctx context.Context, saga *domain.CheckoutSaga, order *domain.Order) error) error {
+	// Order changes made by saga are attributed to the service itself unless it acts for caller, event id (if any) is kept.
+	if _, ok := domain.PrincipalFromContext(ctx); !ok {
+		ctx = domain.ContextWithPrincipal(ctx, domain.SystemPrincipal())
+	}
+
+	var err error
+	for range conflictRetries {
+		err = c.apply(ctx, orderId, fn)
+		if !errors.Is(err, domain.ErrSagaConflict) && !errors.Is(err, domain.ErrOrderConflict) {
+			break
+		}
+	}
+
+	if err != nil {
+		c.log.Error("failed to handle checkout event", "error", err, "order_id", orderId.String(), "event", event)
+		return domain.NewAppError(err, "failed to handle checkout event")
+	}
+
+	return nil
+}
+
+// apply runs fn in transaction, so saga, order and commands fn writes are saved together or not at all.
+func (c *CheckoutService) apply(ctx context.Context, orderId uuid.UUID,
+	fn func(ctx context.Context, saga *domain.CheckoutSaga, order *domain.Order) error) error {
+	return c.tx.WithinTx(ctx, func(ctx context.Context) error {
+		saga, err := c.sagas.Get(ctx, orderId.String())
+		if err != nil {
+			return err
+		}
+
+		order, err := c.orders.GetById(ctx, orderId.String())
+		if err != nil {
+			return err
+		}
+
+		return fn(ctx, saga, order)
+	})
+}
+
+// abort cancels pending or paid order and starts compensation.
+func (c *CheckoutService) abort(ctx context.Context, saga *domain.CheckoutSaga, order *domain.Order, reason string) error {
+	if err := saga.Abort(reason); err != nil {
+		return err
+	}
+
+	if order.Status == domain.OrderPending || order.Status == domain.OrderPaid {
+		before := order.Clone()
+		if err := order.Cancel(); err != nil {
+			return err
+		}
+
+		entry := domain.NewHistoryEntry(ctx, before, order)
+		entry.Diff = append(entry.Diff, domain.FieldChange{Field: "cancel_reason", To: reason})
+
+		if err := c.orders.Update(ctx, order, entry); err != nil {
+			return err
+		}
+	}
+
+	c.log.Info("checkout aborted", "order_id", order.ID.String(), "reason", reason)
+
+	return c.run(ctx, saga, order)
+}
+
+// run sends commands of current saga state and saves saga, ctx must be within transaction.
+// If sending fails, nothing of the step is saved and it's applied again when event is redelivered or saga is due.
+func (c *CheckoutService) run(ctx context.Context, saga *domain.CheckoutSaga, order *domain.Order) error {
+	now := c.now()
+
+	if err := c.send(ctx, saga, order); err != nil {
+		c.log.Error("failed to send checkout commands", "error", err, "order_id", order.ID.String(), "state", string(saga.State))
+		return err
+	}
+	saga.CommandsSent()
+
+	if !saga.IsFinished() {
+		saga.RetryAt = now.Add(c.cfg.RetryInterval)
+		if saga.IsWaiting() && saga.Deadline.Before(saga.RetryAt) {
+			saga.RetryAt = saga.Deadline
+		}
+	}
+
+	saga.UpdatedAt = now
+
+	return c.sagas.Update(ctx, saga)
+}
+
+func (c *CheckoutService) send(ctx context.Context, saga *domain.CheckoutSaga, order *domain.Order) error {
+	switch saga.State {
+	case domain.SagaReservingStock:
+		return c.inventory.Reserve(ctx, order)
+	case domain.SagaAwaitingPayment:
+		return c.payments.Create(ctx, order)
+	case domain.SagaCommitting:
+		return c.inventory.Commit(ctx, order)
+	case domain.SagaCompensating:
+		release := c.inventory.Release
+		if saga.StockCommitted {
+			release = c.inventory.Return
+		}
+		if err := release(ctx, order); err != nil {
+			return err
+		}
+
+		// Cancelling captured payment does nothing, it has to be refunded.
+		if saga.PaymentCaptured {
+			return c.payments.Refund(ctx, order)
+		}
+		if saga.PaymentRequested {
+			return c.payments.Cancel(ctx, order)
+		}
+	case domain.SagaRefunding:
+		// Refund is requested until it's confirmed, payment service refunds it once.
+		return c.payments.Refund(ctx, order)
+	}
+
+	return nil
+}
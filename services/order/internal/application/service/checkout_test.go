@@ -0,0 +1,678 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/dzhordano/ecom-thing/services/order/internal/domain"
+	"github.com/dzhordano/ecom-thing/services/order/internal/domain/repository"
+	"github.com/dzhordano/ecom-thing/services/order/pkg/logger"
+	"github.com/dzhordano/ecom-thing/services/order/pkg/money"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// memSagaRepository keeps copies of sagas, so orchestrator sees only what it saved.
+type memSagaRepository struct {
+	sagas map[uuid.UUID]domain.CheckoutSaga
+}
+
+func (r *memSagaRepository) Save(_ context.Context, saga *domain.CheckoutSaga) error {
+	r.sagas[saga.OrderID] = *saga
+	return nil
+}
+
+func (r *memSagaRepository) Get(_ context.Context, orderId string) (*domain.CheckoutSaga, error) {
+	s, ok := r.sagas[uuid.MustParse(orderId)]
+	if !ok {
+		return nil, domain.ErrSagaNotFound
+	}
+	return &s, nil
+}
+
+func (r *memSagaRepository) Update(_ context.Context, saga *domain.CheckoutSaga) error {
+	if r.sagas[saga.OrderID].Version != saga.Version {
+		return domain.ErrSagaConflict
+	}
+
+	saga.Version++
+	r.sagas[saga.OrderID] = *saga
+	return nil
+}
+
+func (r *memSagaRepository) ListDue(_ context.Context, now time.Time, limit uint64) ([]*domain.CheckoutSaga, error) {
+	var due []*domain.CheckoutSaga
+	for _, s := range r.sagas {
+		if !s.RetryAt.IsZero() && !s.RetryAt.After(now) && uint64(len(due)) < limit {
+			due = append(due, &s)
+		}
+	}
+	return due, nil
+}
+
+// memOrderRepository implements only what checkout uses.
+type memOrderRepository struct {
+	memOrderRepositoryUnused
+
+	orders map[uuid.UUID]domain.Order
+	// Stock changes saved with orders, see savingOrderRepository.
+	changes []*domain.StockChange
+	// Units of stock locks inventory hasn't replied about, by lock id.
+	locks map[uuid.UUID]domain.Items
+}
+
+func (r *memOrderRepository) GetById(_ context.Context, orderId string) (*domain.Order, error) {
+	o, ok := r.orders[uuid.MustParse(orderId)]
+	if !ok {
+		return nil, domain.ErrOrderNotFound
+	}
+	return o.Clone(), nil
+}
+
+func (r *memOrderRepository) TakeStockLock(_ context.Context, _, lockId string) (domain.Items, error) {
+	items, ok := r.locks[uuid.MustParse(lockId)]
+	if !ok {
+		return nil, domain.ErrStockLockNotFound
+	}
+
+	delete(r.locks, uuid.MustParse(lockId))
+	return items, nil
+}
+
+func (r *memOrderRepository) Update(_ context.Context, order *domain.Order, _ *domain.HistoryEntry) error {
+	if r.orders[order.ID].Version != order.Version {
+		return domain.ErrOrderConflict
+	}
+
+	order.Version++
+	r.orders[order.ID] = *order.Clone()
+	return nil
+}
+
+type memOrderRepositoryUnused struct{}
+
+func (memOrderRepositoryUnused) Save(context.Context, *domain.Order, *domain.HistoryEntry) error {
+	panic("not implemented")
+}
+
+func (memOrderRepositoryUnused) SaveWithKey(context.Context, *domain.Order, *domain.HistoryEntry, *domain.IdempotencyKey) error {
+	panic("not implemented")
+}
+
+func (memOrderRepositoryUnused) Search(context.Context, domain.SearchParams) ([]domain.SearchHit, error) {
+	panic("not implemented")
+}
+
+func (memOrderRepositoryUnused) Count(context.Context, domain.SearchParams) (uint64, error) {
+	panic("not implemented")
+}
+
+func (memOrderRepositoryUnused) Delete(context.Context, *domain.Order, *domain.HistoryEntry) error {
+	panic("not implemented")
+}
+
+func (memOrderRepositoryUnused) GetHistory(context.Context, string) ([]*domain.HistoryEntry, error) {
+	panic("not implemented")
+}
+
+func (memOrderRepositoryUnused) ExpirePending(context.Context, time.Time, uint64, []uuid.UUID, repository.ExpireFunc) ([]*domain.Order, map[uuid.UUID]error, error) {
+	panic("not implemented")
+}
+
+func (memOrderRepositoryUnused) UpdateItems(context.Context, *domain.Order, *domain.StockChange, *domain.HistoryEntry) error {
+	panic("not implemented")
+}
+
+func (memOrderRepositoryUnused) CancelItems(context.Context, string, repository.CancelItemsFunc) (*domain.Order, error) {
+	panic("not implemented")
+}
+
+func (memOrderRepositoryUnused) AnonymizeArchived(context.Context, time.Time, uint64) (int64, error) {
+	panic("not implemented")
+}
+
+func (memOrderRepositoryUnused) PurgeArchived(context.Context, time.Time, uint64) (int64, error) {
+	panic("not implemented")
+}
+
+// memTransactor restores sagas, orders, stock locks and sent commands if fn fails, like rolled back transaction would.
+type memTransactor struct {
+	sagas  *memSagaRepository
+	orders *memOrderRepository
+	cmds   *commandLog
+}
+
+func (t memTransactor) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	sagas, orders, locks, sent := maps.Clone(t.sagas.sagas), maps.Clone(t.orders.orders), maps.Clone(t.orders.locks), len(t.cmds.sent)
+
+	if err := fn(ctx); err != nil {
+		t.sagas.sagas, t.orders.orders, t.orders.locks, t.cmds.sent = sagas, orders, locks, t.cmds.sent[:sent]
+		return err
+	}
+
+	return nil
+}
+
+// nopTransactor runs fn as is, nothing is rolled back.
+type nopTransactor struct{}
+
+func (nopTransactor) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+// commandLog records commands sent to inventory and payment. Sending fails while err is set.
+type commandLog struct {
+	sent []string
+	err  error
+}
+
+func (l *commandLog) send(cmd string) error {
+	if l.err != nil {
+		return l.err
+	}
+	l.sent = append(l.sent, cmd)
+	return nil
+}
+
+type fakeInventory struct{ *commandLog }
+
+func (i fakeInventory) Reserve(context.Context, *domain.Order) error { return i.send("reserve") }
+func (i fakeInventory) Release(context.Context, *domain.Order) error { return i.send("release") }
+func (i fakeInventory) Commit(context.Context, *domain.Order) error  { return i.send("commit") }
+func (i fakeInventory) Return(context.Context, *domain.Order) error  { return i.send("return") }
+
+type fakePayments struct{ *commandLog }
+
+func (p fakePayments) Create(context.Context, *domain.Order) error { return p.send("create payment") }
+func (p fakePayments) Cancel(context.Context, *domain.Order) error { return p.send("cancel payment") }
+func (p fakePayments) Refund(context.Context, *domain.Order) error { return p.send("refund payment") }
+func (p fakePayments) RefundItems(_ context.Context, c *domain.Cancellation) error {
+	return p.send("refund items " + c.Total().String())
+}
+
+type checkoutEnv struct {
+	sagas  *memSagaRepository
+	orders *memOrderRepository
+	cmds   *commandLog
+	now    time.Time
+	order  *domain.Order
+}
+
+var testCheckoutConfig = CheckoutConfig{
+	StockTimeout:   time.Minute,
+	PaymentTimeout: 10 * time.Minute,
+	RetryInterval:  30 * time.Second,
+}
+
+func newCheckoutEnv(t *testing.T) *checkoutEnv {
+	t.Helper()
+
+	order := &domain.Order{
+		ID:     uuid.New(),
+		UserID: uuid.New(),
+		Status: domain.OrderPending,
+		Items:  domain.Items{{ProductID: uuid.New(), Quantity: 2}},
+	}
+
+	return &checkoutEnv{
+		sagas:  &memSagaRepository{sagas: map[uuid.UUID]domain.CheckoutSaga{}},
+		orders: &memOrderRepository{orders: map[uuid.UUID]domain.Order{order.ID: *order}},
+		cmds:   &commandLog{},
+		now:    time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		order:  order,
+	}
+}
+
+// service returns new orchestrator over env stores, as if service was restarted.
+func (e *checkoutEnv) service(t *testing.T) *CheckoutService {
+	t.Helper()
+
+	c := NewCheckoutService(
+		logger.MustInit(logger.LevelError, filepath.Join(t.TempDir(), "checkout-test.log"), "json", false),
+		testCheckoutConfig,
+		e.sagas,
+		e.orders,
+		memTransactor{e.sagas, e.orders, e.cmds},
+		fakeInventory{e.cmds},
+		fakePayments{e.cmds},
+	).(*CheckoutService)
+	c.now = func() time.Time { return e.now }
+
+	return c
+}
+
+func (e *checkoutEnv) saga() domain.CheckoutSaga {
+	return e.sagas.sagas[e.order.ID]
+}
+
+func (e *checkoutEnv) status() domain.Status {
+	return e.orders.orders[e.order.ID].Status
+}
+
+func TestCheckoutService(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("happy path", func(t *testing.T) {
+		env := newCheckoutEnv(t)
+		c := env.service(t)
+
+		require.NoError(t, c.Start(ctx, env.order))
+		require.NoError(t, c.StockReserved(ctx, env.order.ID))
+		require.NoError(t, c.PaymentCompleted(ctx, env.order.ID))
+
+		assert.Equal(t, []string{"reserve", "create payment", "commit"}, env.cmds.sent)
+		assert.Equal(t, domain.SagaCompleted, env.saga().State)
+		assert.True(t, env.saga().RetryAt.IsZero())
+		assert.Equal(t, domain.OrderPaid, env.status())
+
+		// Redelivered events change nothing.
+		require.NoError(t, c.StockReserved(ctx, env.order.ID))
+		require.NoError(t, c.PaymentCompleted(ctx, env.order.ID))
+		assert.Len(t, env.cmds.sent, 3)
+	})
+
+	t.Run("stock rejected", func(t *testing.T) {
+		env := newCheckoutEnv(t)
+		c := env.service(t)
+
+		require.NoError(t, c.Start(ctx, env.order))
+		require.NoError(t, c.StockRejected(ctx, env.order.ID))
+
+		assert.Equal(t, []string{"reserve", "release"}, env.cmds.sent)
+		assert.Equal(t, domain.SagaCompensated, env.saga().State)
+		assert.Equal(t, reasonOutOfStock, env.saga().Reason)
+		assert.Equal(t, domain.OrderCancelled, env.status())
+	})
+
+	t.Run("payment failed", func(t *testing.T) {
+		env := newCheckoutEnv(t)
+		c := env.service(t)
+
+		require.NoError(t, c.Start(ctx, env.order))
+		require.NoError(t, c.StockReserved(ctx, env.order.ID))
+		require.NoError(t, c.PaymentFailed(ctx, env.order.ID))
+
+		assert.Equal(t, []string{"reserve", "create payment", "release", "cancel payment"}, env.cmds.sent)
+		assert.Equal(t, domain.SagaCompensated, env.saga().State)
+		assert.Equal(t, reasonPaymentFailed, env.saga().Reason)
+		assert.Equal(t, domain.OrderCancelled, env.status())
+
+		// Late payment result is not applied to cancelled order, payment is refunded instead.
+		require.NoError(t, c.PaymentCompleted(ctx, env.order.ID))
+		assert.Equal(t, domain.OrderCancelled, env.status())
+		assert.Equal(t, domain.SagaRefunding, env.saga().State)
+		assert.Equal(t, "refund payment", env.cmds.sent[len(env.cmds.sent)-1])
+
+		// Redelivered payment result is not refunded twice.
+		require.NoError(t, c.PaymentCompleted(ctx, env.order.ID))
+		assert.Len(t, env.cmds.sent, 5)
+
+		require.NoError(t, c.PaymentRefunded(ctx, env.order.ID, env.order.ID))
+		assert.Equal(t, domain.SagaCompensated, env.saga().State)
+		assert.True(t, env.saga().RetryAt.IsZero())
+	})
+
+	t.Run("payment completed for cancelled order", func(t *testing.T) {
+		env := newCheckoutEnv(t)
+		c := env.service(t)
+
+		require.NoError(t, c.Start(ctx, env.order))
+		require.NoError(t, c.StockReserved(ctx, env.order.ID))
+
+		// Order was cancelled, but checkout failed to be aborted.
+		o := env.orders.orders[env.order.ID]
+		require.NoError(t, o.Cancel())
+		env.orders.orders[env.order.ID] = o
+
+		require.NoError(t, c.PaymentCompleted(ctx, env.order.ID))
+
+		assert.Equal(t, []string{"reserve", "create payment", "release", "refund payment"}, env.cmds.sent)
+		assert.Equal(t, domain.SagaRefunding, env.saga().State)
+		assert.Equal(t, reasonOrderCancelled, env.saga().Reason)
+		assert.Equal(t, domain.OrderCancelled, env.status())
+	})
+
+	t.Run("stock timeout", func(t *testing.T) {
+		env := newCheckoutEnv(t)
+		c := env.service(t)
+
+		require.NoError(t, c.Start(ctx, env.order))
+
+		// Reserve is resent while inventory stays silent.
+		env.now = env.now.Add(testCheckoutConfig.RetryInterval)
+		require.NoError(t, c.ProcessDue(ctx))
+		assert.Equal(t, []string{"reserve", "reserve"}, env.cmds.sent)
+		assert.Equal(t, domain.SagaReservingStock, env.saga().State)
+
+		env.now = env.now.Add(testCheckoutConfig.StockTimeout)
+		require.NoError(t, c.ProcessDue(ctx))
+
+		assert.Equal(t, []string{"reserve", "reserve", "release"}, env.cmds.sent)
+		assert.Equal(t, domain.SagaCompensated, env.saga().State)
+		assert.Equal(t, reasonStockTimeout, env.saga().Reason)
+		assert.Equal(t, domain.OrderCancelled, env.status())
+	})
+
+	t.Run("payment timeout", func(t *testing.T) {
+		env := newCheckoutEnv(t)
+		c := env.service(t)
+
+		require.NoError(t, c.Start(ctx, env.order))
+		require.NoError(t, c.StockReserved(ctx, env.order.ID))
+
+		// Stock timeout doesn't apply once stock is reserved.
+		env.now = env.now.Add(testCheckoutConfig.StockTimeout)
+		require.NoError(t, c.ProcessDue(ctx))
+		assert.Equal(t, domain.SagaAwaitingPayment, env.saga().State)
+
+		env.now = env.now.Add(testCheckoutConfig.PaymentTimeout)
+		require.NoError(t, c.ProcessDue(ctx))
+
+		assert.Equal(t, domain.SagaCompensated, env.saga().State)
+		assert.Equal(t, reasonPaymentTimeout, env.saga().Reason)
+		assert.Equal(t, domain.OrderCancelled, env.status())
+		assert.Equal(t, []string{"release", "cancel payment"}, env.cmds.sent[len(env.cmds.sent)-2:])
+	})
+
+	t.Run("send failure is retried", func(t *testing.T) {
+		env := newCheckoutEnv(t)
+		c := env.service(t)
+
+		require.NoError(t, c.Start(ctx, env.order))
+		require.NoError(t, c.StockReserved(ctx, env.order.ID))
+
+		// Nothing of the step is saved, so event is left for redelivery.
+		env.cmds.err = errors.New("outbox unavailable")
+		require.Error(t, c.PaymentCompleted(ctx, env.order.ID))
+		assert.Equal(t, domain.SagaAwaitingPayment, env.saga().State)
+		assert.Equal(t, domain.OrderPending, env.status())
+
+		env.cmds.err = nil
+		require.NoError(t, c.PaymentCompleted(ctx, env.order.ID))
+
+		assert.Equal(t, []string{"reserve", "create payment", "commit"}, env.cmds.sent)
+		assert.Equal(t, domain.SagaCompleted, env.saga().State)
+		assert.Equal(t, domain.OrderPaid, env.status())
+	})
+
+	t.Run("start send failure is retried when due", func(t *testing.T) {
+		env := newCheckoutEnv(t)
+		c := env.service(t)
+
+		env.cmds.err = errors.New("outbox unavailable")
+		require.NoError(t, c.Start(ctx, env.order))
+		assert.Equal(t, domain.SagaReservingStock, env.saga().State)
+		assert.Empty(t, env.cmds.sent)
+
+		env.cmds.err = nil
+		require.NoError(t, c.ProcessDue(ctx))
+
+		assert.Equal(t, []string{"reserve"}, env.cmds.sent)
+		assert.Equal(t, env.now.Add(testCheckoutConfig.RetryInterval), env.saga().RetryAt)
+	})
+
+	t.Run("abort while awaiting payment", func(t *testing.T) {
+		env := newCheckoutEnv(t)
+		c := env.service(t)
+
+		require.NoError(t, c.Start(ctx, env.order))
+		require.NoError(t, c.StockReserved(ctx, env.order.ID))
+
+		// Order service cancels order itself before aborting checkout.
+		o := env.orders.orders[env.order.ID]
+		require.NoError(t, o.Cancel())
+		env.orders.orders[env.order.ID] = o
+
+		require.NoError(t, c.Abort(ctx, env.order.ID, reasonOrderCancelled))
+		require.NoError(t, c.Abort(ctx, env.order.ID, reasonOrderCancelled))
+
+		assert.Equal(t, []string{"reserve", "create payment", "release", "cancel payment"}, env.cmds.sent)
+		assert.Equal(t, domain.SagaCompensated, env.saga().State)
+		assert.Equal(t, reasonOrderCancelled, env.saga().Reason)
+	})
+
+	t.Run("abort after completed returns stock and refunds payment", func(t *testing.T) {
+		env := newCheckoutEnv(t)
+		c := env.service(t)
+
+		require.NoError(t, c.Start(ctx, env.order))
+		require.NoError(t, c.StockReserved(ctx, env.order.ID))
+		require.NoError(t, c.PaymentCompleted(ctx, env.order.ID))
+		require.NoError(t, c.Abort(ctx, env.order.ID, reasonOrderCancelled))
+
+		assert.Equal(t, []string{"reserve", "create payment", "commit", "return", "refund payment"}, env.cmds.sent)
+		assert.Equal(t, domain.SagaRefunding, env.saga().State)
+
+		// Refund is requested again until it's confirmed.
+		env.now = env.now.Add(testCheckoutConfig.RetryInterval)
+		require.NoError(t, c.ProcessDue(ctx))
+		assert.Equal(t, "refund payment", env.cmds.sent[len(env.cmds.sent)-1])
+		assert.Len(t, env.cmds.sent, 6)
+
+		// Refund of cancelled items is not the one checkout waits for.
+		require.NoError(t, c.PaymentRefunded(ctx, env.order.ID, uuid.New()))
+		assert.Equal(t, domain.SagaRefunding, env.saga().State)
+
+		require.NoError(t, c.PaymentRefunded(ctx, env.order.ID, env.order.ID))
+		assert.Equal(t, domain.SagaCompensated, env.saga().State)
+
+		// Nothing is due once refund is confirmed.
+		env.now = env.now.Add(testCheckoutConfig.RetryInterval)
+		require.NoError(t, c.ProcessDue(ctx))
+		assert.Len(t, env.cmds.sent, 6)
+	})
+
+	t.Run("cancel paid order", func(t *testing.T) {
+		env := newCheckoutEnv(t)
+		c := env.service(t)
+
+		require.NoError(t, c.Start(ctx, env.order))
+		require.NoError(t, c.StockReserved(ctx, env.order.ID))
+		require.NoError(t, c.PaymentCompleted(ctx, env.order.ID))
+		require.NoError(t, c.CancelPaid(ctx, env.order.ID))
+
+		assert.Equal(t, domain.OrderCancelled, env.status())
+		assert.Equal(t, []string{"reserve", "create payment", "commit", "return", "refund payment"}, env.cmds.sent)
+		assert.Equal(t, domain.SagaRefunding, env.saga().State)
+		assert.Equal(t, reasonOrderCancelled, env.saga().Reason)
+
+		// Cancelled order is not cancelled again.
+		assert.ErrorIs(t, c.CancelPaid(ctx, env.order.ID), domain.ErrInvalidTransition)
+		assert.Len(t, env.cmds.sent, 5)
+	})
+
+	t.Run("cancel paid order without saga", func(t *testing.T) {
+		env := newCheckoutEnv(t)
+		o := env.orders.orders[env.order.ID]
+		o.Status = domain.OrderPaid
+		env.orders.orders[env.order.ID] = o
+
+		assert.ErrorIs(t, env.service(t).CancelPaid(ctx, env.order.ID), domain.ErrInvalidTransition)
+		assert.Equal(t, domain.OrderPaid, env.status())
+		assert.Empty(t, env.cmds.sent)
+	})
+
+	t.Run("abort without saga", func(t *testing.T) {
+		env := newCheckoutEnv(t)
+
+		require.NoError(t, env.service(t).Abort(ctx, env.order.ID, reasonOrderCancelled))
+		assert.Empty(t, env.cmds.sent)
+	})
+
+	t.Run("resumes after restart", func(t *testing.T) {
+		env := newCheckoutEnv(t)
+
+		require.NoError(t, env.service(t).Start(ctx, env.order))
+		require.NoError(t, env.service(t).StockReserved(ctx, env.order.ID))
+
+		// Payment command was lost along with the instance that sent it.
+		env.now = env.now.Add(testCheckoutConfig.RetryInterval)
+		require.NoError(t, env.service(t).ProcessDue(ctx))
+		require.NoError(t, env.service(t).PaymentCompleted(ctx, env.order.ID))
+
+		assert.Equal(t, []string{"reserve", "create payment", "create payment", "commit"}, env.cmds.sent)
+		assert.Equal(t, domain.SagaCompleted, env.saga().State)
+		assert.Equal(t, domain.OrderPaid, env.status())
+	})
+
+	t.Run("concurrent change is retried", func(t *testing.T) {
+		env := newCheckoutEnv(t)
+		c := env.service(t)
+
+		require.NoError(t, c.Start(ctx, env.order))
+
+		// Another instance bumped version after saga was read for the first time.
+		calls := 0
+		err := c.handle(ctx, env.order.ID, "test", func(ctx context.Context, saga *domain.CheckoutSaga, order *domain.Order) error {
+			calls++
+			if calls == 1 {
+				s := env.sagas.sagas[env.order.ID]
+				s.Version++
+				env.sagas.sagas[env.order.ID] = s
+			}
+			return c.sagas.Update(ctx, saga)
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("concurrent order change is retried", func(t *testing.T) {
+		env := newCheckoutEnv(t)
+		c := env.service(t)
+
+		require.NoError(t, c.Start(ctx, env.order))
+		require.NoError(t, c.StockReserved(ctx, env.order.ID))
+
+		// Change of user is made outside of transaction, so it must survive its rollback.
+		c.tx = nopTransactor{}
+
+		// User updated order between its read by payment event handler and save.
+		calls := 0
+		err := c.handle(ctx, env.order.ID, "test", func(ctx context.Context, saga *domain.CheckoutSaga, order *domain.Order) error {
+			calls++
+			if calls == 1 {
+				o := env.orders.orders[env.order.ID]
+				o.Description = "changed by user"
+				o.Version++
+				env.orders.orders[env.order.ID] = o
+			}
+			return c.orders.Update(ctx, order, nil)
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, 2, calls)
+		assert.Equal(t, "changed by user", env.orders.orders[env.order.ID].Description)
+	})
+}
+
+func TestCheckoutService_StockLocks(t *testing.T) {
+	ctx := context.Background()
+
+	var (
+		book = uuid.New()
+		pen  = uuid.New()
+	)
+
+	newEnv := func(t *testing.T) (*checkoutEnv, *CheckoutService) {
+		env := newCheckoutEnv(t)
+		env.order.Currency = domain.USD
+		env.order.Items = domain.Items{
+			{ProductID: book, Quantity: 3, UnitPrice: money.New(1000, "USD"), Discount: money.New(0, "USD")},
+			{ProductID: pen, Quantity: 1, UnitPrice: money.New(200, "USD"), Discount: money.New(0, "USD")},
+		}
+		env.orders.orders[env.order.ID] = *env.order
+		env.orders.locks = map[uuid.UUID]domain.Items{}
+
+		c := env.service(t)
+		require.NoError(t, c.Start(ctx, env.order))
+		require.NoError(t, c.StockReserved(ctx, env.order.ID))
+
+		return env, c
+	}
+
+	t.Run("reserved lock is dropped", func(t *testing.T) {
+		env, c := newEnv(t)
+		lockId := uuid.New()
+		env.orders.locks[lockId] = domain.Items{{ProductID: book, Quantity: 2}}
+
+		require.NoError(t, c.StockLockReserved(ctx, env.order.ID, lockId))
+		assert.Empty(t, env.orders.locks)
+		assert.Equal(t, env.order.Items, env.orders.orders[env.order.ID].Items)
+
+		// Rejection can't arrive after reply is handled, lock is gone.
+		require.NoError(t, c.StockLockRejected(ctx, env.order.ID, lockId))
+		assert.Equal(t, env.order.Items, env.orders.orders[env.order.ID].Items)
+	})
+
+	t.Run("rejected units are taken off pending order", func(t *testing.T) {
+		env, c := newEnv(t)
+		lockId := uuid.New()
+		env.orders.locks[lockId] = domain.Items{{ProductID: book, Quantity: 2}}
+
+		require.NoError(t, c.StockLockRejected(ctx, env.order.ID, lockId))
+
+		items := env.orders.orders[env.order.ID].Items
+		require.Len(t, items, 2)
+		assert.Equal(t, uint64(1), items[0].Quantity)
+		assert.Equal(t, domain.OrderPending, env.status())
+		assert.Empty(t, env.orders.locks)
+		assert.Equal(t, []string{"reserve", "create payment"}, env.cmds.sent)
+
+		// Redelivered rejection takes nothing more.
+		require.NoError(t, c.StockLockRejected(ctx, env.order.ID, lockId))
+		assert.Equal(t, uint64(1), env.orders.orders[env.order.ID].Items[0].Quantity)
+	})
+
+	t.Run("paid order is refunded for rejected units", func(t *testing.T) {
+		env, c := newEnv(t)
+		require.NoError(t, c.PaymentCompleted(ctx, env.order.ID))
+
+		lockId := uuid.New()
+		env.orders.locks[lockId] = domain.Items{{ProductID: book, Quantity: 1}}
+
+		require.NoError(t, c.StockLockRejected(ctx, env.order.ID, lockId))
+
+		assert.Equal(t, uint64(2), env.orders.orders[env.order.ID].Items[0].Quantity)
+		assert.Equal(t, domain.OrderPaid, env.status())
+		assert.Equal(t, "refund items "+money.New(1000, "USD").String(), env.cmds.sent[len(env.cmds.sent)-1])
+	})
+
+	t.Run("order left without units is cancelled", func(t *testing.T) {
+		env, c := newEnv(t)
+		lockId := uuid.New()
+		env.orders.locks[lockId] = domain.Items{{ProductID: book, Quantity: 3}, {ProductID: pen, Quantity: 1}}
+
+		require.NoError(t, c.StockLockRejected(ctx, env.order.ID, lockId))
+
+		assert.Equal(t, domain.OrderCancelled, env.status())
+		assert.Equal(t, domain.SagaCompensated, env.saga().State)
+		assert.Equal(t, reasonOutOfStock, env.saga().Reason)
+		assert.Equal(t, "release", env.cmds.sent[len(env.cmds.sent)-2])
+		assert.Empty(t, env.orders.locks)
+	})
+
+	t.Run("cancelled order only drops lock", func(t *testing.T) {
+		env, c := newEnv(t)
+
+		// Owner cancels order, then checkout is aborted.
+		o := env.orders.orders[env.order.ID]
+		o.Status = domain.OrderCancelled
+		env.orders.orders[env.order.ID] = o
+		require.NoError(t, c.Abort(ctx, env.order.ID, reasonOrderCancelled))
+		sent := len(env.cmds.sent)
+
+		lockId := uuid.New()
+		env.orders.locks[lockId] = domain.Items{{ProductID: book, Quantity: 1}}
+
+		require.NoError(t, c.StockLockRejected(ctx, env.order.ID, lockId))
+		assert.Equal(t, uint64(3), env.orders.orders[env.order.ID].Items[0].Quantity)
+		assert.Len(t, env.cmds.sent, sent)
+		assert.Empty(t, env.orders.locks)
+	})
+}
@@ -0,0 +1,181 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/dzhordano/ecom-thing/services/order/internal/application/dto"
+	"github.com/dzhordano/ecom-thing/services/order/internal/application/interfaces"
+	"github.com/dzhordano/ecom-thing/services/order/internal/domain"
+	"github.com/dzhordano/ecom-thing/services/order/internal/domain/repository"
+	"github.com/dzhordano/ecom-thing/services/order/pkg/logger"
+	"github.com/dzhordano/ecom-thing/services/order/pkg/money"
+)
+
+type CouponService struct {
+	log     logger.Logger
+	coupons repository.CouponRepository
+	now     func() time.Time
+}
+
+func NewCouponService(l logger.Logger, coupons repository.CouponRepository) interfaces.CouponService {
+	return &CouponService{
+		log:     l,
+		coupons: coupons,
+		now:     func() time.Time { return time.Now().UTC() },
+	}
+}
+
+// CreateCoupon implements interfaces.CouponService. New coupon is active.
+func (c *CouponService) CreateCoupon(ctx context.Context, req dto.CreateCouponRequest) (*domain.Coupon, error) {
+	now := c.now()
+
+	coupon := &domain.Coupon{
+		Code:                  req.Code,
+		Type:                  domain.DiscountType(req.Type),
+		Discount:              req.Discount,
+		Amount:                money.New(req.Amount, req.Currency),
+		Currency:              domain.Currency(req.Currency),
+		MinOrderAmount:        money.New(req.MinOrderAmount, req.Currency),
+		MaxRedemptions:        req.MaxRedemptions,
+		MaxRedemptionsPerUser: req.MaxRedemptionsPerUser,
+		ProductIDs:            req.ProductIDs,
+		Categories:            req.Categories,
+		Active:                true,
+		ValidFrom:             req.ValidFrom,
+		ValidTo:               req.ValidTo,
+		CreatedAt:             now,
+		UpdatedAt:             now,
+	}
+
+	if err := coupon.Validate(); err != nil {
+		c.log.Error("failed to create coupon", "error", err, "coupon", req.Code)
+		return nil, domain.NewAppError(err, err.Error())
+	}
+
+	if err := c.coupons.Save(ctx, coupon); err != nil {
+		c.log.Error("failed to create coupon", "error", err, "coupon", req.Code)
+		return nil, domain.NewAppError(err, "failed to save coupon")
+	}
+
+	c.log.Debug("coupon created", "coupon", coupon.Code)
+
+	return coupon, nil
+}
+
+// UpdateCoupon implements interfaces.CouponService.
+func (c *CouponService) UpdateCoupon(ctx context.Context, req dto.UpdateCouponRequest) (*domain.Coupon, error) {
+	coupon, err := c.coupons.GetByCode(ctx, req.Code)
+	if err != nil {
+		c.log.Error("failed to update coupon", "error", err, "coupon", req.Code)
+		return nil, domain.NewAppError(err, "failed to get coupon")
+	}
+
+	if req.Type != nil {
+		coupon.Type = domain.DiscountType(*req.Type)
+	}
+
+	if req.Discount != nil {
+		coupon.Discount = *req.Discount
+	}
+
+	if req.Amount != nil {
+		coupon.Amount.Amount = *req.Amount
+	}
+
+	if req.Currency != nil {
+		coupon.Currency = domain.Currency(*req.Currency)
+	}
+
+	if req.MinOrderAmount != nil {
+		coupon.MinOrderAmount.Amount = *req.MinOrderAmount
+	}
+
+	if req.MaxRedemptions != nil {
+		coupon.MaxRedemptions = *req.MaxRedemptions
+	}
+
+	if req.MaxRedemptionsPerUser != nil {
+		coupon.MaxRedemptionsPerUser = *req.MaxRedemptionsPerUser
+	}
+
+	if req.ProductIDs != nil {
+		coupon.ProductIDs = *req.ProductIDs
+	}
+
+	if req.Categories != nil {
+		coupon.Categories = *req.Categories
+	}
+
+	if req.ValidFrom != nil {
+		coupon.ValidFrom = *req.ValidFrom
+	}
+
+	if req.ValidTo != nil {
+		coupon.ValidTo = *req.ValidTo
+	}
+
+	coupon.Amount.Currency = coupon.Currency.String()
+	coupon.MinOrderAmount.Currency = coupon.Currency.String()
+	coupon.UpdatedAt = c.now()
+
+	if err := coupon.Validate(); err != nil {
+		c.log.Error("failed to update coupon", "error", err, "coupon", req.Code)
+		return nil, domain.NewAppError(err, err.Error())
+	}
+
+	if err := c.coupons.Update(ctx, coupon); err != nil {
+		c.log.Error("failed to update coupon", "error", err, "coupon", req.Code)
+		return nil, domain.NewAppError(err, "failed to update coupon")
+	}
+
+	c.log.Debug("coupon updated", "coupon", coupon.Code)
+
+	return coupon, nil
+}
+
+// DeactivateCoupon implements interfaces.CouponService.
+func (c *CouponService) DeactivateCoupon(ctx context.Context, code string) (*domain.Coupon, error) {
+	coupon, err := c.coupons.GetByCode(ctx, code)
+	if err != nil {
+		c.log.Error("failed to deactivate coupon", "error", err, "coupon", code)
+		return nil, domain.NewAppError(err, "failed to get coupon")
+	}
+
+	coupon.Deactivate(c.now())
+
+	if err := c.coupons.Update(ctx, coupon); err != nil {
+		c.log.Error("failed to deactivate coupon", "error", err, "coupon", code)
+		return nil, domain.NewAppError(err, "failed to update coupon")
+	}
+
+	c.log.Debug("coupon deactivated", "coupon", code)
+
+	return coupon, nil
+}
+
+// ListCoupons implements interfaces.CouponService.
+func (c *CouponService) ListCoupons(ctx context.Context, activeOnly bool, limit, offset uint64) ([]*domain.Coupon, error) {
+	coupons, err := c.coupons.List(ctx, activeOnly, limit, offset)
+	if err != nil {
+		c.log.Error("failed to list coupons", "error", err)
+		return nil, domain.NewAppError(err, "failed to list coupons")
+	}
+
+	c.log.Debug("coupons retrieved", "count", len(coupons))
+
+	return coupons, nil
+}
+
+// GetCouponUsage implements interfaces.CouponService.
+func (c *CouponService) GetCouponUsage(ctx context.Context, code string, limit uint64) (*domain.CouponUsage, error) {
+	usage, err := c.coupons.GetUsage(ctx, code, limit)
+	if err != nil {
+		c.log.Error("failed to get coupon usage", "error", err, "coupon", code)
+		return nil, domain.NewAppError(err, "failed to get coupon usage")
+	}
+
+	c.log.Debug("coupon usage retrieved", "coupon", code, "redemptions", usage.Coupon.Redemptions)
+
+	return usage, nil
+}
@@ -0,0 +1,95 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/dzhordano/ecom-thing/services/order/internal/application/interfaces"
+	"github.com/dzhordano/ecom-thing/services/order/internal/domain"
+	"github.com/dzhordano/ecom-thing/services/order/internal/domain/repository"
+	"github.com/dzhordano/ecom-thing/services/order/pkg/logger"
+	"github.com/google/uuid"
+)
+
+const (
+	// How many orders are expired in one transaction, each of them in its own savepoint.
+	expiryBatchSize = 100
+
+	reasonPaymentWindowExpired = "payment window expired"
+)
+
+type ExpiryService struct {
+	log    logger.Logger
+	orders repository.OrderRepository
+	// How long order may stay pending since creation.
+	window time.Duration
+	now    func() time.Time
+}
+
+// NewExpiryService returns service expiring orders pending for longer than window.
+// Window is meant to be longer than checkout timeouts, so only orders checkout failed to finish are expired.
+func NewExpiryService(l logger.Logger, orders repository.OrderRepository, window time.Duration) interfaces.OrderExpiry {
+	return &ExpiryService{
+		log:    l,
+		orders: orders,
+		window: window,
+		now:    func() time.Time { return time.Now().UTC() },
+	}
+}
+
+// ExpirePending implements interfaces.OrderExpiry.
+func (e *ExpiryService) ExpirePending(ctx context.Context) (int, error) {
+	// Cancellations are attributed to the service itself.
+	ctx = domain.ContextWithPrincipal(ctx, domain.SystemPrincipal())
+
+	now := e.now()
+	total := 0
+
+	// Orders that failed in this run are left out of next batches, so they can't keep younger ones from expiring.
+	var skip []uuid.UUID
+
+	for {
+		expired, failed, err := e.orders.ExpirePending(ctx, now.Add(-e.window), expiryBatchSize, skip,
+			func(order *domain.Order, saga *domain.CheckoutSaga) (*domain.HistoryEntry, error) {
+				before := order.Clone()
+				if err := order.Cancel(); err != nil {
+					return nil, err
+				}
+
+				entry := domain.NewHistoryEntry(ctx, before, order)
+				entry.Diff = append(entry.Diff, domain.FieldChange{Field: "cancel_reason", To: reasonPaymentWindowExpired})
+
+				if saga != nil {
+					// Compensations are written with the order, so saga has nothing left to send.
+					if err := saga.Abort(reasonPaymentWindowExpired); err != nil {
+						return nil, err
+					}
+					saga.CommandsSent()
+					saga.UpdatedAt = now
+				}
+
+				return entry, nil
+			})
+		if err != nil {
+			e.log.Error("failed to expire pending orders", "error", err)
+			return total, domain.NewAppError(err, "failed to expire pending orders")
+		}
+
+		for _, order := range expired {
+			e.log.Info("pending order expired", "order_id", order.ID.String(), "created_at", order.CreatedAt)
+		}
+
+		// Failed orders are left pending and tried again on the next run.
+		for orderId, err := range failed {
+			e.log.Error("failed to expire pending order", "error", err, "order_id", orderId.String())
+			skip = append(skip, orderId)
+		}
+
+		total += len(expired)
+
+		// Short batch means no more due orders, except ones other replicas are busy with.
+		if len(expired)+len(failed) < expiryBatchSize {
+			return total, nil
+		}
+	}
+}
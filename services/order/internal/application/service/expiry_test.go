@@ -0,0 +1,235 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"slices"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/dzhordano/ecom-thing/services/order/internal/domain"
+	"github.com/dzhordano/ecom-thing/services/order/internal/domain/repository"
+	"github.com/dzhordano/ecom-thing/services/order/pkg/logger"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// expiringOrderRepository applies ExpireFunc to copies and keeps results of orders it succeeded for,
+// like savepoint per order would.
+type expiringOrderRepository struct {
+	memOrderRepository
+
+	sagas   map[uuid.UUID]domain.CheckoutSaga
+	history map[uuid.UUID][]*domain.HistoryEntry
+	err     error
+}
+
+func (r *expiringOrderRepository) ExpirePending(_ context.Context, createdBefore time.Time, limit uint64, skip []uuid.UUID,
+	expire repository.ExpireFunc) ([]*domain.Order, map[uuid.UUID]error, error) {
+	if r.err != nil {
+		return nil, nil, r.err
+	}
+
+	var due []*domain.Order
+	for _, o := range r.orders {
+		if o.Status == domain.OrderPending && o.CreatedAt.Before(createdBefore) && !slices.Contains(skip, o.ID) {
+			due = append(due, o.Clone())
+		}
+	}
+	sort.Slice(due, func(i, j int) bool { return due[i].CreatedAt.Before(due[j].CreatedAt) })
+	if uint64(len(due)) > limit {
+		due = due[:limit]
+	}
+
+	var expired []*domain.Order
+	failed := make(map[uuid.UUID]error)
+	for _, o := range due {
+		var saga *domain.CheckoutSaga
+		if s, ok := r.sagas[o.ID]; ok && !s.IsFinished() {
+			saga = &s
+		}
+
+		entry, err := expire(o, saga)
+		if err != nil {
+			failed[o.ID] = err
+			continue
+		}
+
+		r.orders[o.ID] = *o.Clone()
+		r.history[o.ID] = append(r.history[o.ID], entry)
+		if saga != nil {
+			saga.Version++
+			r.sagas[o.ID] = *saga
+		}
+		expired = append(expired, o)
+	}
+
+	return expired, failed, nil
+}
+
+type expiryEnv struct {
+	repo *expiringOrderRepository
+	svc  *ExpiryService
+	now  time.Time
+}
+
+func newExpiryEnv(t *testing.T) *expiryEnv {
+	t.Helper()
+
+	e := &expiryEnv{
+		repo: &expiringOrderRepository{
+			memOrderRepository: memOrderRepository{orders: map[uuid.UUID]domain.Order{}},
+			sagas:              map[uuid.UUID]domain.CheckoutSaga{},
+			history:            map[uuid.UUID][]*domain.HistoryEntry{},
+		},
+		now: time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC),
+	}
+
+	e.svc = NewExpiryService(
+		logger.MustInit(logger.LevelError, filepath.Join(t.TempDir(), "expiry-test.log"), "json", false),
+		e.repo,
+		30*time.Minute,
+	).(*ExpiryService)
+	e.svc.now = func() time.Time { return e.now }
+
+	return e
+}
+
+// addOrder stores order created age ago.
+func (e *expiryEnv) addOrder(status domain.Status, age time.Duration) uuid.UUID {
+	o := domain.Order{
+		ID:        uuid.New(),
+		UserID:    uuid.New(),
+		Status:    status,
+		Items:     domain.Items{{ProductID: uuid.New(), Quantity: 1}},
+		CreatedAt: e.now.Add(-age),
+	}
+	e.repo.orders[o.ID] = o
+	return o.ID
+}
+
+func TestExpiryService_ExpirePending(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("cancels only pending orders older than window", func(t *testing.T) {
+		env := newExpiryEnv(t)
+
+		stale := env.addOrder(domain.OrderPending, time.Hour)
+		fresh := env.addOrder(domain.OrderPending, 10*time.Minute)
+		paid := env.addOrder(domain.OrderPaid, time.Hour)
+
+		n, err := env.svc.ExpirePending(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 1, n)
+
+		assert.Equal(t, domain.OrderCancelled, env.repo.orders[stale].Status)
+		assert.Equal(t, domain.OrderPending, env.repo.orders[fresh].Status)
+		assert.Equal(t, domain.OrderPaid, env.repo.orders[paid].Status)
+
+		require.Len(t, env.repo.history[stale], 1)
+		entry := env.repo.history[stale][0]
+		assert.Equal(t, domain.ActorSystem, entry.Actor.Type)
+		assert.Contains(t, entry.Diff, domain.FieldChange{Field: "cancel_reason", To: reasonPaymentWindowExpired})
+
+		// Nothing is due anymore.
+		n, err = env.svc.ExpirePending(ctx)
+		require.NoError(t, err)
+		assert.Zero(t, n)
+	})
+
+	t.Run("finishes unfinished saga", func(t *testing.T) {
+		env := newExpiryEnv(t)
+
+		id := env.addOrder(domain.OrderPending, time.Hour)
+		saga := domain.NewCheckoutSaga(id, env.now.Add(-time.Hour))
+		require.NoError(t, saga.StockReserved())
+		saga.Deadline = env.now.Add(time.Minute)
+		saga.RetryAt = env.now.Add(time.Minute)
+		env.repo.sagas[id] = *saga
+
+		_, err := env.svc.ExpirePending(ctx)
+		require.NoError(t, err)
+
+		got := env.repo.sagas[id]
+		assert.Equal(t, domain.SagaCompensated, got.State)
+		assert.Equal(t, reasonPaymentWindowExpired, got.Reason)
+		assert.True(t, got.RetryAt.IsZero())
+		assert.Equal(t, env.now, got.UpdatedAt)
+	})
+
+	t.Run("expires backlog in batches", func(t *testing.T) {
+		env := newExpiryEnv(t)
+
+		for i := range expiryBatchSize*2 + 5 {
+			env.addOrder(domain.OrderPending, time.Hour+time.Duration(i)*time.Second)
+		}
+
+		n, err := env.svc.ExpirePending(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, expiryBatchSize*2+5, n)
+
+		for _, o := range env.repo.orders {
+			assert.Equal(t, domain.OrderCancelled, o.Status)
+		}
+	})
+
+	t.Run("skips orders that fail to expire", func(t *testing.T) {
+		env := newExpiryEnv(t)
+
+		// Saga that is compensating already can't be aborted.
+		stuck := env.addOrder(domain.OrderPending, 2*time.Hour)
+		saga := domain.NewCheckoutSaga(stuck, env.now.Add(-2*time.Hour))
+		require.NoError(t, saga.Abort("stock timeout"))
+		env.repo.sagas[stuck] = *saga
+
+		var ids []uuid.UUID
+		for i := range expiryBatchSize + 5 {
+			ids = append(ids, env.addOrder(domain.OrderPending, time.Hour+time.Duration(i)*time.Second))
+		}
+
+		n, err := env.svc.ExpirePending(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, expiryBatchSize+5, n)
+
+		assert.Equal(t, domain.OrderPending, env.repo.orders[stuck].Status)
+		assert.Empty(t, env.repo.history[stuck])
+		for _, id := range ids {
+			assert.Equal(t, domain.OrderCancelled, env.repo.orders[id].Status)
+		}
+	})
+
+	t.Run("whole batch of failing orders doesn't hold back younger ones", func(t *testing.T) {
+		env := newExpiryEnv(t)
+
+		var stuck []uuid.UUID
+		for i := range expiryBatchSize + 5 {
+			id := env.addOrder(domain.OrderPending, 3*time.Hour+time.Duration(i)*time.Second)
+			saga := domain.NewCheckoutSaga(id, env.now.Add(-3*time.Hour))
+			require.NoError(t, saga.Abort("stock timeout"))
+			env.repo.sagas[id] = *saga
+			stuck = append(stuck, id)
+		}
+
+		younger := env.addOrder(domain.OrderPending, time.Hour)
+
+		n, err := env.svc.ExpirePending(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 1, n)
+
+		assert.Equal(t, domain.OrderCancelled, env.repo.orders[younger].Status)
+		for _, id := range stuck {
+			assert.Equal(t, domain.OrderPending, env.repo.orders[id].Status)
+		}
+	})
+
+	t.Run("repository error", func(t *testing.T) {
+		env := newExpiryEnv(t)
+		env.repo.err = errors.New("connection refused")
+
+		_, err := env.svc.ExpirePending(ctx)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,111 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+
+	"github.com/dzhordano/ecom-thing/services/order/internal/domain"
+	"github.com/google/uuid"
+)
+
+// Operations idempotency keys are scoped to.
+const (
+	idempotentCreateOrder = "create_order"
+)
+
+// requestHash fingerprints request payload. Request must not contain the key itself.
+func requestHash(req any) (string, error) {
+	b, err := json.Marshal(req)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// acquireKey reserves idempotency key for request. If the key was already used, stored key is returned:
+// it is completed one whose result should be replayed, otherwise an error is.
+func (o *OrderService) acquireKey(ctx context.Context, rawKey string, userId uuid.UUID, operation string, req any) (key, stored *domain.IdempotencyKey, err error) {
+	hash, err := requestHash(req)
+	if err != nil {
+		o.log.Error("failed to hash request", "error", err, "operation", operation)
+		return nil, nil, domain.NewAppError(err, "failed to hash request")
+	}
+
+	key, err = domain.NewIdempotencyKey(rawKey, userId, operation, hash, o.now(), o.keyTTL, o.keyLease)
+	if err != nil {
+		o.log.Error("failed to acquire idempotency key", "error", err, "operation", operation)
+		return nil, nil, domain.NewAppError(err, err.Error())
+	}
+
+	stored, err = o.keys.Acquire(ctx, key)
+	if err != nil {
+		o.log.Error("failed to acquire idempotency key", "error", err, "operation", operation)
+		return nil, nil, domain.NewAppError(err, "failed to acquire idempotency key")
+	}
+
+	if stored == nil {
+		return key, nil, nil
+	}
+
+	if stored.RequestHash != hash {
+		o.log.Error("failed to acquire idempotency key", "error", domain.ErrIdempotencyKeyReused, "operation", operation)
+		return nil, nil, domain.NewAppError(domain.ErrIdempotencyKeyReused, "idempotency key was used with another request")
+	}
+
+	if !stored.IsCompleted() {
+		o.log.Error("failed to acquire idempotency key", "error", domain.ErrRequestInProgress, "operation", operation)
+		return nil, nil, domain.NewAppError(domain.ErrRequestInProgress, "request with this idempotency key is in progress")
+	}
+
+	return key, stored, nil
+}
+
+// releaseKey lets request that failed be retried with the same key.
+func (o *OrderService) releaseKey(ctx context.Context, key *domain.IdempotencyKey) {
+	if err := o.keys.Release(ctx, key); err != nil {
+		o.log.Error("failed to release idempotency key", "error", err, "operation", key.Operation)
+	}
+}
+
+// saveWithKey saves order created by request with the key. Key is completed in the same transaction
+// and remembers order as it is returned, so retries get the same response.
+func (o *OrderService) saveWithKey(ctx context.Context, order *domain.Order, entry *domain.HistoryEntry, key *domain.IdempotencyKey) error {
+	response, err := json.Marshal(order)
+	if err != nil {
+		return err
+	}
+
+	key.Complete(order.ID.String(), response)
+
+	return o.repo.SaveWithKey(ctx, order, entry, key)
+}
+
+// replayOrder returns order remembered by completed key. Keys completed before responses were stored
+// remember only order id, current order is returned for them.
+func (o *OrderService) replayOrder(ctx context.Context, stored *domain.IdempotencyKey) (*domain.Order, error) {
+	if len(stored.Response) == 0 {
+		order, err := o.repo.GetById(ctx, stored.ResourceID)
+		if err != nil {
+			o.log.Error("failed to get order", "error", err, "order_id", stored.ResourceID)
+			return nil, domain.NewAppError(err, "failed to get order")
+		}
+
+		o.log.Debug("order creation replayed", "order_id", order.ID.String())
+
+		return order, nil
+	}
+
+	var order domain.Order
+	if err := json.Unmarshal(stored.Response, &order); err != nil {
+		o.log.Error("failed to replay order", "error", err, "order_id", stored.ResourceID)
+		return nil, domain.NewAppError(err, "failed to replay order")
+	}
+
+	o.log.Debug("order creation replayed", "order_id", order.ID.String())
+
+	return &order, nil
+}
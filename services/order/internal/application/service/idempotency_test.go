@@ -0,0 +1,354 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/dzhordano/ecom-thing/services/order/internal/application/dto"
+	"github.com/dzhordano/ecom-thing/services/order/internal/application/interfaces"
+	"github.com/dzhordano/ecom-thing/services/order/internal/domain"
+	"github.com/dzhordano/ecom-thing/services/order/pkg/logger"
+	"github.com/dzhordano/ecom-thing/services/order/pkg/money"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// memIdempotencyRepository behaves like pg one: live key is returned, expired one is replaced,
+// so is key of the same request whose lease ended before it was completed.
+type memIdempotencyRepository struct {
+	keys map[string]domain.IdempotencyKey
+}
+
+func (r *memIdempotencyRepository) id(key *domain.IdempotencyKey) string {
+	return key.UserID.String() + "/" + key.Operation + "/" + key.Key
+}
+
+func (r *memIdempotencyRepository) Acquire(_ context.Context, key *domain.IdempotencyKey) (*domain.IdempotencyKey, error) {
+	if stored, ok := r.keys[r.id(key)]; ok && stored.ExpiresAt.After(key.CreatedAt) {
+		abandoned := !stored.IsCompleted() && !stored.LockedUntil.After(key.CreatedAt) && stored.RequestHash == key.RequestHash
+		if !abandoned {
+			return &stored, nil
+		}
+	}
+	r.keys[r.id(key)] = *key
+	return nil, nil
+}
+
+func (r *memIdempotencyRepository) Release(_ context.Context, key *domain.IdempotencyKey) error {
+	if stored := r.keys[r.id(key)]; !stored.IsCompleted() && stored.LockID == key.LockID {
+		delete(r.keys, r.id(key))
+	}
+	return nil
+}
+
+func (r *memIdempotencyRepository) DeleteExpired(context.Context, time.Time) (int64, error) {
+	panic("not implemented")
+}
+
+type savingOrderRepository struct {
+	*memOrderRepository
+}
+
+func (r savingOrderRepository) Save(_ context.Context, order *domain.Order, _ *domain.HistoryEntry) error {
+	r.orders[order.ID] = *order.Clone()
+	return nil
+}
+
+func (r savingOrderRepository) UpdateItems(ctx context.Context, order *domain.Order, change *domain.StockChange, entry *domain.HistoryEntry) error {
+	if err := r.Update(ctx, order, entry); err != nil {
+		return err
+	}
+
+	r.changes = append(r.changes, change)
+	return nil
+}
+
+// keyedOrderRepository completes idempotency keys along with orders like pg one.
+type keyedOrderRepository struct {
+	savingOrderRepository
+	keys *memIdempotencyRepository
+}
+
+func (r keyedOrderRepository) SaveWithKey(ctx context.Context, order *domain.Order, entry *domain.HistoryEntry, key *domain.IdempotencyKey) error {
+	if stored := r.keys.keys[r.keys.id(key)]; stored.IsCompleted() || stored.LockID != key.LockID {
+		return domain.ErrRequestInProgress
+	}
+
+	if err := r.Save(ctx, order, entry); err != nil {
+		return err
+	}
+
+	r.keys.keys[r.keys.id(key)] = *key
+	return nil
+}
+
+type stubProducts struct{ err error }
+
+func (p stubProducts) GetProductInfo(context.Context, uuid.UUID) (*dto.ProductInfo, error) {
+	if p.err != nil {
+		return nil, p.err
+	}
+	return &dto.ProductInfo{Name: "Product", Price: money.New(1000, ""), IsActive: true}, nil
+}
+
+func (p stubProducts) GetProductsInfo(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]*dto.ProductInfo, error) {
+	products := make(map[uuid.UUID]*dto.ProductInfo, len(ids))
+	for _, id := range ids {
+		info, err := p.GetProductInfo(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		products[id] = info
+	}
+	return products, nil
+}
+
+type stubInventory struct{}
+
+func (stubInventory) IsReservable(context.Context, map[string]uint64) (bool, error) { return true, nil }
+
+type freeShipping struct{}
+
+func (freeShipping) ShippingCost(_ context.Context, _ domain.DeliveryMethod, _ domain.Items, c domain.Currency) (money.Money, error) {
+	return money.New(0, c.String()), nil
+}
+
+func (freeShipping) CheckDeliveryDate(context.Context, domain.DeliveryMethod, time.Time, time.Time) error {
+	return nil
+}
+
+type noTax struct{}
+
+func (noTax) Taxes(_ context.Context, region domain.TaxRegion) (*domain.TaxTable, error) {
+	return domain.NoTaxes(region), nil
+}
+
+type nopCheckout struct{ interfaces.Checkout }
+
+func (nopCheckout) Start(context.Context, *domain.Order) error { return nil }
+
+type idempotencyEnv struct {
+	orders   *memOrderRepository
+	keys     *memIdempotencyRepository
+	products *stubProducts
+	now      time.Time
+	svc      *OrderService
+	ctx      context.Context
+}
+
+func newIdempotencyEnv(t *testing.T) *idempotencyEnv {
+	t.Helper()
+
+	e := &idempotencyEnv{
+		orders:   &memOrderRepository{orders: map[uuid.UUID]domain.Order{}},
+		keys:     &memIdempotencyRepository{keys: map[string]domain.IdempotencyKey{}},
+		products: &stubProducts{},
+		now:      time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		ctx: domain.ContextWithPrincipal(context.Background(), domain.Principal{
+			UserID: uuid.New(),
+			Roles:  []domain.Role{domain.RoleUser},
+		}),
+	}
+
+	e.svc = NewOrderService(
+		logger.MustInit(logger.LevelError, filepath.Join(t.TempDir(), "order-test.log"), "json", false),
+		e.products,
+		stubInventory{},
+		freeShipping{},
+		noTax{},
+		keyedOrderRepository{savingOrderRepository{e.orders}, e.keys},
+		nil,
+		nopCheckout{},
+		e.keys,
+		time.Hour,
+		time.Minute,
+	).(*OrderService)
+	e.svc.now = func() time.Time { return e.now }
+
+	return e
+}
+
+func createOrderRequest(key string) dto.CreateOrderRequest {
+	return dto.CreateOrderRequest{
+		Description:     "Description",
+		Currency:        domain.USD.String(),
+		PaymentMethod:   domain.Cash.String(),
+		DeliveryMethod:  domain.Pickup.String(),
+		DeliveryAddress: testAddress,
+		DeliveryDate:    time.Now().Add(time.Hour).UTC(),
+		Items:           []domain.Item{{ProductID: uuid.New(), Quantity: 1}},
+		IdempotencyKey:  key,
+	}
+}
+
+func TestOrderService_CreateOrder_Idempotency(t *testing.T) {
+	t.Run("replay returns original order", func(t *testing.T) {
+		env := newIdempotencyEnv(t)
+		req := createOrderRequest("key")
+
+		first, err := env.svc.CreateOrder(env.ctx, req)
+		require.NoError(t, err)
+
+		second, err := env.svc.CreateOrder(env.ctx, req)
+		require.NoError(t, err)
+
+		assert.Equal(t, first.ID, second.ID)
+		assert.Len(t, env.orders.orders, 1)
+	})
+
+	t.Run("replay returns order as it was created", func(t *testing.T) {
+		env := newIdempotencyEnv(t)
+		req := createOrderRequest("key")
+
+		first, err := env.svc.CreateOrder(env.ctx, req)
+		require.NoError(t, err)
+
+		changed := env.orders.orders[first.ID]
+		changed.Status = domain.OrderCancelled
+		env.orders.orders[first.ID] = changed
+
+		second, err := env.svc.CreateOrder(env.ctx, req)
+		require.NoError(t, err)
+
+		assert.Equal(t, first.ID, second.ID)
+		assert.Equal(t, domain.OrderPending, second.Status)
+		assert.Equal(t, first.TotalPrice(), second.TotalPrice())
+	})
+
+	t.Run("key completed before responses were stored", func(t *testing.T) {
+		env := newIdempotencyEnv(t)
+		req := createOrderRequest("key")
+
+		first, err := env.svc.CreateOrder(env.ctx, req)
+		require.NoError(t, err)
+
+		for id, key := range env.keys.keys {
+			key.Response = nil
+			env.keys.keys[id] = key
+		}
+
+		second, err := env.svc.CreateOrder(env.ctx, req)
+		require.NoError(t, err)
+
+		assert.Equal(t, first.ID, second.ID)
+	})
+
+	t.Run("same key with another payload", func(t *testing.T) {
+		env := newIdempotencyEnv(t)
+		req := createOrderRequest("key")
+
+		_, err := env.svc.CreateOrder(env.ctx, req)
+		require.NoError(t, err)
+
+		req.Description = "Another"
+		_, err = env.svc.CreateOrder(env.ctx, req)
+		assert.ErrorIs(t, err, domain.ErrIdempotencyKeyReused)
+		assert.Len(t, env.orders.orders, 1)
+	})
+
+	t.Run("request in progress", func(t *testing.T) {
+		env := newIdempotencyEnv(t)
+		req := createOrderRequest("key")
+
+		payload := req
+		payload.IdempotencyKey = ""
+		hash, err := requestHash(payload)
+		require.NoError(t, err)
+
+		p, _ := domain.PrincipalFromContext(env.ctx)
+		key, err := domain.NewIdempotencyKey("key", p.UserID, idempotentCreateOrder, hash, env.now, time.Hour, time.Minute)
+		require.NoError(t, err)
+		_, err = env.keys.Acquire(env.ctx, key)
+		require.NoError(t, err)
+
+		_, err = env.svc.CreateOrder(env.ctx, req)
+		assert.ErrorIs(t, err, domain.ErrRequestInProgress)
+		assert.Empty(t, env.orders.orders)
+
+		// Request crashed holding key, retry takes it over once lease ends.
+		env.now = env.now.Add(time.Minute)
+		_, err = env.svc.CreateOrder(env.ctx, req)
+		require.NoError(t, err)
+		assert.Len(t, env.orders.orders, 1)
+
+		// Crashed request can't complete or release key it lost.
+		key.Complete(uuid.NewString(), nil)
+		err = keyedOrderRepository{savingOrderRepository{env.orders}, env.keys}.SaveWithKey(env.ctx, &domain.Order{ID: uuid.New()}, nil, key)
+		assert.ErrorIs(t, err, domain.ErrRequestInProgress)
+		require.NoError(t, env.keys.Release(env.ctx, key))
+		assert.Len(t, env.keys.keys, 1)
+		assert.Len(t, env.orders.orders, 1)
+	})
+
+	t.Run("lease of another request is not taken over", func(t *testing.T) {
+		env := newIdempotencyEnv(t)
+		req := createOrderRequest("key")
+
+		p, _ := domain.PrincipalFromContext(env.ctx)
+		key, err := domain.NewIdempotencyKey("key", p.UserID, idempotentCreateOrder, "another", env.now, time.Hour, time.Minute)
+		require.NoError(t, err)
+		_, err = env.keys.Acquire(env.ctx, key)
+		require.NoError(t, err)
+
+		env.now = env.now.Add(time.Minute)
+		_, err = env.svc.CreateOrder(env.ctx, req)
+		assert.ErrorIs(t, err, domain.ErrIdempotencyKeyReused)
+	})
+
+	t.Run("failed request can be retried", func(t *testing.T) {
+		env := newIdempotencyEnv(t)
+		req := createOrderRequest("key")
+
+		env.products.err = errors.New("product service is down")
+		_, err := env.svc.CreateOrder(env.ctx, req)
+		require.Error(t, err)
+		assert.Empty(t, env.keys.keys)
+
+		env.products.err = nil
+		_, err = env.svc.CreateOrder(env.ctx, req)
+		require.NoError(t, err)
+		assert.Len(t, env.orders.orders, 1)
+	})
+
+	t.Run("expired key starts new request", func(t *testing.T) {
+		env := newIdempotencyEnv(t)
+		req := createOrderRequest("key")
+
+		first, err := env.svc.CreateOrder(env.ctx, req)
+		require.NoError(t, err)
+
+		env.now = env.now.Add(2 * time.Hour)
+		second, err := env.svc.CreateOrder(env.ctx, req)
+		require.NoError(t, err)
+
+		assert.NotEqual(t, first.ID, second.ID)
+	})
+
+	t.Run("keys are scoped to user", func(t *testing.T) {
+		env := newIdempotencyEnv(t)
+		req := createOrderRequest("key")
+
+		first, err := env.svc.CreateOrder(env.ctx, req)
+		require.NoError(t, err)
+
+		other := domain.ContextWithPrincipal(context.Background(), domain.Principal{
+			UserID: uuid.New(),
+			Roles:  []domain.Role{domain.RoleUser},
+		})
+		second, err := env.svc.CreateOrder(other, req)
+		require.NoError(t, err)
+
+		assert.NotEqual(t, first.ID, second.ID)
+	})
+
+	t.Run("too long key", func(t *testing.T) {
+		env := newIdempotencyEnv(t)
+
+		_, err := env.svc.CreateOrder(env.ctx, createOrderRequest(string(make([]byte, domain.MaxIdempotencyKeyLength+1))))
+		assert.ErrorIs(t, err, domain.ErrInvalidIdempotencyKey)
+	})
+}
@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"github.com/dzhordano/ecom-thing/services/order/internal/application/dto"
@@ -9,6 +10,7 @@ import (
 	"github.com/dzhordano/ecom-thing/services/order/internal/domain"
 	"github.com/dzhordano/ecom-thing/services/order/internal/domain/repository"
 	"github.com/dzhordano/ecom-thing/services/order/pkg/logger"
+	"github.com/dzhordano/ecom-thing/services/order/pkg/money"
 	"github.com/google/uuid"
 )
 
@@ -25,102 +27,126 @@ type OrderService struct {
 	log              logger.Logger
 	productService   interfaces.ProductService
 	inventoryService interfaces.InventoryService
+	shipping         interfaces.ShippingCalculator
+	taxes            interfaces.TaxCalculator
 	repo             repository.OrderRepository
+	checkout         interfaces.Checkout
+	coupons          repository.CouponRepository
+	keys             repository.IdempotencyRepository
+	// How long idempotency key is remembered and how long request holds it in progress.
+	keyTTL   time.Duration
+	keyLease time.Duration
+	now      func() time.Time
 }
 
-func NewOrderService(l logger.Logger, ps interfaces.ProductService, is interfaces.InventoryService, r repository.OrderRepository) interfaces.OrderService {
+func NewOrderService(l logger.Logger, ps interfaces.ProductService, is interfaces.InventoryService,
+	shipping interfaces.ShippingCalculator, taxes interfaces.TaxCalculator, r repository.OrderRepository, coupons repository.CouponRepository, c interfaces.Checkout, keys repository.IdempotencyRepository, keyTTL, keyLease time.Duration) interfaces.OrderService {
 	return &OrderService{
 		log:              l,
 		productService:   ps,
 		inventoryService: is,
+		shipping:         shipping,
+		taxes:            taxes,
 		repo:             r,
+		coupons:          coupons,
+		checkout:         c,
+		keys:             keys,
+		keyTTL:           keyTTL,
+		keyLease:         keyLease,
+		now:              func() time.Time { return time.Now().UTC() },
 	}
 }
 
 // CreateOrder implements interfaces.OrderService.
+//
+// With idempotency key, repeated request returns order created by the first one.
 func (o *OrderService) CreateOrder(ctx context.Context, info dto.CreateOrderRequest) (*domain.Order, error) {
-	disc := &domain.Coupon{}
-	var err error
+	p, err := principalFromCtx(ctx)
+	if err != nil {
+		o.log.Error("failed to create order", "error", err)
+		return nil, err
+	}
 
-	if info.Coupon != "" {
-		disc, err = o.repo.GetCoupon(ctx, info.Coupon)
-		if err != nil {
-			o.log.Error("failed to get coupon", "error", err, "coupon", info.Coupon)
-			return nil, domain.NewAppError(err, "failed to get coupon")
-		}
+	if info.IdempotencyKey == "" {
+		return o.createOrder(ctx, p, info, nil)
+	}
 
-		// Если купон просрочен - ошибка.
-		if disc.ValidTo.Before(time.Now()) {
-			o.log.Error("failed to get coupon", "error", domain.ErrCouponExpired, "coupon", info.Coupon)
-			return nil, domain.NewAppError(domain.ErrCouponExpired, "coupon is expired")
-		}
+	payload := info
+	payload.IdempotencyKey = ""
 
-		// Купон есть, но не активен.
-		if disc.ValidFrom.After(time.Now()) {
-			o.log.Error("failed to get coupon", "error", domain.ErrCouponNotActive, "coupon", info.Coupon)
-			return nil, domain.NewAppError(domain.ErrCouponNotActive, "coupon is not active")
-		}
+	key, stored, err := o.acquireKey(ctx, info.IdempotencyKey, p.UserID, idempotentCreateOrder, payload)
+	if err != nil {
+		return nil, err
 	}
 
-	timeout, cancel := context.WithTimeout(ctx, 5*time.Second) // FIXME Тоже хардкод
-	defer cancel()
+	if stored != nil {
+		return o.replayOrder(ctx, stored)
+	}
 
-	var totalPrice float64
-	for _, item := range info.Items {
-		price, isActive, err := o.productService.GetProductInfo(timeout, item.ProductID)
-		if err != nil {
-			o.log.Error("failed to get product info", "error", err, "product_id", item.ProductID)
-			return nil, domain.NewAppError(err, "failed to get product info")
-		}
+	// Key is completed together with saving order, so it's released only if order wasn't saved.
+	order, err := o.createOrder(ctx, p, info, key)
+	if err != nil {
+		o.releaseKey(ctx, key)
+		return nil, err
+	}
 
-		if !isActive {
-			o.log.Error("product unavailable", "error", domain.ErrProductUnavailable, "product_id", item.ProductID)
-			return nil, domain.NewAppError(domain.ErrProductUnavailable, "product unavailable")
-		}
+	return order, nil
+}
+
+// createOrder creates order for request, key is idempotency key of request if it has one.
+func (o *OrderService) createOrder(ctx context.Context, p domain.Principal, info dto.CreateOrderRequest, key *domain.IdempotencyKey) (*domain.Order, error) {
+	quote, err := o.quote(ctx, info)
+	if err != nil {
+		return nil, err
+	}
 
-		totalPrice += float64(item.Quantity) * price
+	if err := quote.Err(); err != nil {
+		o.log.Error("failed to create order", "error", err, "warnings", len(quote.Warnings))
+		return nil, domain.NewAppError(err, err.Error())
+	}
+
+	if err := o.checkDeliveryDate(ctx, quote.DeliveryMethod, info.DeliveryDate); err != nil {
+		return nil, err
 	}
 
 	order, err := domain.NewOrder(
-		uuid.New(), // FIXME Щас рандомный пользователь. Потом получать из контекста.
+		p.UserID,
 		info.Description,
 		domain.OrderPending.String(),
 		info.Currency,
-		totalPrice,
-		disc.Discount,
 		info.PaymentMethod,
 		info.DeliveryMethod,
 		info.DeliveryAddress,
 		info.DeliveryDate,
-		info.Items,
+		quote.Items(),
 	)
 	if err != nil {
 		o.log.Error("failed to create order", "error", err)
 		return nil, domain.NewAppError(err, err.Error()) // TODO В идеале все таки валидацию вне NewOrder, т.к. там может вернуть что uuid не получилос сгенерить.
 	}
 
-	items := make(map[string]uint64)
-	for _, item := range order.Items {
-		items[item.ProductID.String()] += item.Quantity
-	}
-
-	timeout, cancel = context.WithTimeout(ctx, 5*time.Second) // FIXME Тоже хардкод
-	defer cancel()
+	order.Coupon = quote.Coupon
+	order.ShippingFee = quote.Shipping
+	order.TaxRegion = quote.TaxRegion
 
-	isReservable, err := o.inventoryService.IsReservable(timeout, items)
-	if err != nil {
-		o.log.Error("failed to check if items reservable", "error", err)
-		return nil, domain.NewAppError(err, "failed to check if items reservable")
+	entry := domain.NewHistoryEntry(ctx, nil, order)
+	if order.Coupon != "" {
+		entry.Diff = append(entry.Diff, domain.FieldChange{Field: "coupon", To: order.Coupon})
 	}
 
-	if !isReservable {
-		o.log.Error("failed to reserve order", "error", domain.ErrNotEnoughQuantity)
-		return nil, domain.NewAppError(domain.ErrNotEnoughQuantity, "not enough quantity")
+	if key == nil {
+		err = o.repo.Save(ctx, order, entry)
+	} else {
+		err = o.saveWithKey(ctx, order, entry, key)
 	}
-
-	if err = o.repo.Save(ctx, order); err != nil {
+	if err != nil {
 		o.log.Error("failed to save order", "error", err)
-		return nil, domain.NewAppError(err, "failed to save order")
+		return nil, saveError(err, "failed to save order")
+	}
+
+	if err := o.checkout.Start(ctx, order); err != nil {
+		o.log.Error("failed to start checkout", "error", err, "order_id", order.ID.String())
+		return nil, err
 	}
 
 	o.log.Debug("order created", "order_id", order.ID.String())
@@ -130,42 +156,54 @@ func (o *OrderService) CreateOrder(ctx context.Context, info dto.CreateOrderRequ
 
 // GetById implements interfaces.OrderService.
 func (o *OrderService) GetById(ctx context.Context, orderId uuid.UUID) (*domain.Order, error) {
-	order, err := o.repo.GetById(ctx, orderId.String())
+	order, err := o.getOwnedOrder(ctx, orderId)
 	if err != nil {
 		o.log.Error("failed to get order", "error", err, "order_id", orderId.String())
-		return nil, domain.NewAppError(err, "failed to get order")
+		return nil, err
 	}
 
-	// FIXME Тут проверка на принадлежность пользователю. Получение Id пользователя из контекста.
-
 	o.log.Debug("order retrieved", "order_id", order.ID.String())
 
 	return order, nil
 }
 
 // ListByUser implements interfaces.OrderService.
-func (o *OrderService) ListByUser(ctx context.Context, limit uint64, offset uint64) ([]*domain.Order, error) {
-	// FIXME Щас тут рандомный uuid, потом из контекста.
-
-	randUUID, err := uuid.NewRandom()
+func (o *OrderService) ListByUser(ctx context.Context, filters map[string]any) (*domain.OrderPage, error) {
+	p, err := principalFromCtx(ctx)
 	if err != nil {
 		o.log.Error("failed to list orders", "error", err)
-		return nil, domain.NewAppError(err, "failed to list orders")
+		return nil, err
 	}
 
-	orders, err := o.repo.ListByUser(ctx, randUUID.String())
+	params := domain.SearchParams{
+		UserID:     &p.UserID,
+		PageParams: domain.NewPageParams(filters),
+	}
+
+	if err := params.Validate(); err != nil {
+		o.log.Error("failed to list orders", "error", err)
+		return nil, domain.NewAppError(err, err.Error())
+	}
+
+	page, err := o.searchPage(ctx, params)
 	if err != nil {
-		o.log.Error("failed to list orders", "error", err, "user_id", randUUID.String())
+		o.log.Error("failed to list orders", "error", err, "user_id", p.UserID.String())
 		return nil, domain.NewAppError(err, "failed to list orders")
 	}
 
-	o.log.Debug("orders retrieved", "count", len(orders))
+	o.log.Debug("orders retrieved", "count", len(page.Orders))
 
-	return orders, nil
+	return page, nil
 }
 
 // Search implements interfaces.OrderService.
-func (o *OrderService) SearchOrders(ctx context.Context, filters map[string]any) ([]*domain.Order, error) {
+func (o *OrderService) SearchOrders(ctx context.Context, filters map[string]any) (*domain.OrderPage, error) {
+	p, err := principalFromCtx(ctx)
+	if err != nil {
+		o.log.Error("failed to search orders", "error", err)
+		return nil, err
+	}
+
 	params := domain.NewSearchParams(filters)
 
 	if err := params.Validate(); err != nil {
@@ -173,38 +211,78 @@ func (o *OrderService) SearchOrders(ctx context.Context, filters map[string]any)
 		return nil, domain.NewAppError(err, err.Error())
 	}
 
-	orders, err := o.repo.Search(ctx, params)
+	// Regular users only see their own orders, and archived ones are not theirs anymore.
+	if !p.IsPrivileged() {
+		if params.IncludeDeleted {
+			o.log.Error("failed to search orders", "error", domain.ErrPermissionDenied)
+			return nil, domain.NewAppError(domain.ErrPermissionDenied, "only staff may search archived orders")
+		}
+		params.UserID = &p.UserID
+	}
+
+	page, err := o.searchPage(ctx, params)
 	if err != nil {
 		o.log.Error("failed to search orders", "error", err)
 		return nil, domain.NewAppError(err, "failed to search orders")
 	}
 
-	o.log.Debug("orders retrieved", "count", len(orders))
+	o.log.Debug("orders retrieved", "count", len(page.Orders))
+
+	return page, nil
+}
+
+// searchPage reads one order past params limit to know whether next page exists,
+// the extra order is not returned.
+func (o *OrderService) searchPage(ctx context.Context, params domain.SearchParams) (*domain.OrderPage, error) {
+	limit := params.Limit
+
+	params.Limit++
+	hits, err := o.repo.Search(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &domain.OrderPage{}
+
+	if uint64(len(hits)) > limit {
+		hits = hits[:limit]
+		page.NextPageToken = domain.NewPageCursor(hits[limit-1], params.SortBy, params.SortOrder).Encode()
+	}
 
-	return orders, nil
+	for _, hit := range hits {
+		page.Orders = append(page.Orders, hit.Order)
+	}
+
+	if params.WithTotal {
+		total, err := o.repo.Count(ctx, params)
+		if err != nil {
+			return nil, err
+		}
+		page.Total = &total
+	}
+
+	return page, nil
 }
 
 // FIXME тут возможен возврат одной ошибки, когда можно вернуть несколько... испрвить.
 // UpdateOrder implements interfaces.OrderService.
 func (o *OrderService) UpdateOrder(ctx context.Context, info dto.UpdateOrderRequest) (*domain.Order, error) {
-	order, err := o.repo.GetById(ctx, info.OrderID.String())
+	order, err := o.getOwnedOrder(ctx, info.OrderID)
 	if err != nil {
 		o.log.Error("failed to update order", "error", err, "order_id", info.OrderID.String())
-		return nil, domain.NewAppError(err, "failed to get order")
+		return nil, err
 	}
 
-	// FIXME Тут проверка на принадлежность пользователю. Получение Id пользователя из контекста.
-
-	if info.Description != nil {
-		order.Description = *info.Description
+	if info.Version != nil && *info.Version != order.Version {
+		o.log.Error("failed to update order", "error", domain.ErrOrderVersionMismatch, "order_id", info.OrderID.String(),
+			"version", order.Version, "expected_version", *info.Version)
+		return nil, domain.NewAppError(domain.ErrOrderVersionMismatch, domain.ErrOrderVersionMismatch.Error())
 	}
 
-	if info.Status != nil {
-		order.Status = domain.Status(*info.Status)
-	}
+	before := order.Clone()
 
-	if info.TotalPrice != nil {
-		order.TotalPrice = *info.TotalPrice
+	if info.Description != nil {
+		order.Description = *info.Description
 	}
 
 	if info.PaymentMethod != nil {
@@ -216,7 +294,7 @@ func (o *OrderService) UpdateOrder(ctx context.Context, info dto.UpdateOrderRequ
 	}
 
 	if info.DeliveryAddress != nil {
-		order.DeliveryAddress = *info.DeliveryAddress
+		order.DeliveryAddress = info.DeliveryAddress.Normalize()
 	}
 
 	if !info.DeliveryDate.IsZero() {
@@ -224,17 +302,50 @@ func (o *OrderService) UpdateOrder(ctx context.Context, info dto.UpdateOrderRequ
 	}
 
 	if len(info.Items) > 0 {
-		order.Items = info.Items
+		order.Items = info.Items // Only quantities so far, lines are priced once the change is allowed.
+	}
+
+	if err := order.CheckChanges(before); err != nil {
+		o.log.Error("failed to update order", "error", err, "order_id", info.OrderID.String())
+		return nil, domain.NewAppError(err, err.Error())
+	}
+
+	var change *domain.StockChange
+	if len(info.Items) > 0 {
+		if change, err = domain.NewStockChange(order.ID, before.Items, info.Items); err != nil {
+			o.log.Error("failed to update order", "error", err, "order_id", info.OrderID.String())
+			return nil, domain.NewAppError(err, "failed to update order")
+		}
+
+		if order.Items, err = o.repriceItems(ctx, before, info.Items); err != nil {
+			return nil, err
+		}
+
+		if err := o.checkLocked(ctx, change); err != nil {
+			return nil, err
+		}
 	}
 
-	if err = order.Validate(); err != nil {
+	if err = order.ValidateChanges(before); err != nil {
 		o.log.Error("failed to update order", "error", err, "order_id", info.OrderID.String())
 		return nil, domain.NewAppError(err, err.Error())
 	}
 
-	if err := o.repo.Update(ctx, order); err != nil {
+	if err := o.reship(ctx, before, order); err != nil {
+		return nil, err
+	}
+
+	order.UpdatedAt = o.now()
+	entry := domain.NewHistoryEntry(ctx, before, order)
+
+	if change == nil || change.IsEmpty() {
+		err = o.repo.Update(ctx, order, entry)
+	} else {
+		err = o.repo.UpdateItems(ctx, order, change, entry)
+	}
+	if err != nil {
 		o.log.Error("failed to update order", "error", err, "order_id", info.OrderID.String())
-		return nil, domain.NewAppError(err, "failed to update order")
+		return nil, saveError(err, "failed to update order")
 	}
 
 	o.log.Debug("order updated", "order_id", order.ID.String())
@@ -243,24 +354,31 @@ func (o *OrderService) UpdateOrder(ctx context.Context, info dto.UpdateOrderRequ
 }
 
 // DeleteOrder implements interfaces.OrderService.
+//
+// Order is archived, not deleted, see domain.Order.Archive. Retention erases it later.
 func (o *OrderService) DeleteOrder(ctx context.Context, orderId uuid.UUID) error {
-	order, err := o.repo.GetById(ctx, orderId.String())
+	p, err := principalFromCtx(ctx)
 	if err != nil {
 		o.log.Error("failed to delete order", "error", err, "order_id", orderId.String())
-		return domain.NewAppError(err, "failed to get order")
+		return err
 	}
 
-	// Чтобы компилятор не жаловался...
-	// FIXME не забыть убрать
-	if order.ID == uuid.Nil {
-		return domain.NewAppError(domain.ErrOrderNotFound, "order not found")
+	order, err := o.getOwnedOrder(ctx, orderId)
+	if err != nil {
+		o.log.Error("failed to delete order", "error", err, "order_id", orderId.String())
+		return err
 	}
 
-	// FIXME Тут проверка на принадлежность пользователю. Получение Id пользователя из контекста.
+	before := order.Clone()
+
+	if err := order.Archive(p.UserID, o.now()); err != nil {
+		o.log.Error("failed to delete order", "error", err, "order_id", orderId.String())
+		return domain.NewAppError(err, err.Error())
+	}
 
-	if err := o.repo.Delete(ctx, orderId.String()); err != nil {
+	if err := o.repo.Delete(ctx, order, domain.NewHistoryEntry(ctx, before, order)); err != nil {
 		o.log.Error("failed to delete order", "error", err, "order_id", orderId.String())
-		return domain.NewAppError(err, "failed to delete order")
+		return saveError(err, "failed to delete order")
 	}
 
 	o.log.Debug("order deleted", "order_id", order.ID.String())
@@ -268,75 +386,335 @@ func (o *OrderService) DeleteOrder(ctx context.Context, orderId uuid.UUID) error
 	return nil
 }
 
+// RestoreOrder implements interfaces.OrderService.
+func (o *OrderService) RestoreOrder(ctx context.Context, orderId uuid.UUID) (*domain.Order, error) {
+	order, err := o.getOwnedOrder(ctx, orderId)
+	if err != nil {
+		o.log.Error("failed to restore order", "error", err, "order_id", orderId.String())
+		return nil, err
+	}
+
+	before := order.Clone()
+
+	if err := order.Restore(o.now()); err != nil {
+		o.log.Error("failed to restore order", "error", err, "order_id", orderId.String())
+		return nil, domain.NewAppError(err, err.Error())
+	}
+
+	if err := o.repo.Update(ctx, order, domain.NewHistoryEntry(ctx, before, order)); err != nil {
+		o.log.Error("failed to restore order", "error", err, "order_id", orderId.String())
+		return nil, saveError(err, "failed to restore order")
+	}
+
+	o.log.Debug("order restored", "order_id", order.ID.String())
+
+	return order, nil
+}
+
+// PayOrder implements interfaces.OrderService.
+func (o *OrderService) PayOrder(ctx context.Context, orderId uuid.UUID) error {
+	return o.changeStatus(ctx, orderId, "pay", (*domain.Order).MarkPaid)
+}
+
+// ShipOrder implements interfaces.OrderService.
+func (o *OrderService) ShipOrder(ctx context.Context, orderId uuid.UUID) error {
+	return o.changeStatus(ctx, orderId, "ship", (*domain.Order).Ship)
+}
+
+// DeliverOrder implements interfaces.OrderService.
+func (o *OrderService) DeliverOrder(ctx context.Context, orderId uuid.UUID) error {
+	return o.changeStatus(ctx, orderId, "deliver", (*domain.Order).Deliver)
+}
+
 // CompleteOrder implements interfaces.OrderService.
 func (o *OrderService) CompleteOrder(ctx context.Context, orderId uuid.UUID) error {
-	order, err := o.repo.GetById(ctx, orderId.String())
+	return o.changeStatus(ctx, orderId, "complete", (*domain.Order).Complete)
+}
+
+// CancelOrder implements interfaces.OrderService.
+func (o *OrderService) CancelOrder(ctx context.Context, orderId uuid.UUID) error {
+	order, err := o.getOwnedOrder(ctx, orderId)
 	if err != nil {
-		o.log.Error("failed to complete order", "error", err, "order_id", orderId.String())
-		return domain.NewAppError(err, "failed to get order")
+		o.log.Error("failed to cancel order", "error", err, "order_id", orderId.String())
+		return err
 	}
 
-	// FIXME Тут проверка на принадлежность пользователю. Получение Id пользователя из контекста.
+	// Paid order is cancelled by checkout, which returns its stock and refunds payment.
+	if order.Status == domain.OrderPaid {
+		if err := o.checkout.CancelPaid(ctx, orderId); err != nil {
+			o.log.Error("failed to cancel order", "error", err, "order_id", orderId.String())
+			return err
+		}
+		return nil
+	}
 
-	if order.Status == domain.OrderCancelled {
-		o.log.Error("failed to complete order", "error", domain.ErrOrderAlreadyCancelled, "order_id", orderId.String())
-		return domain.NewAppError(domain.ErrOrderAlreadyCancelled, "order already cancelled")
+	err = o.changeStatus(ctx, orderId, "cancel", func(order *domain.Order) error {
+		// Order was paid meanwhile, caller retries to have it cancelled with refund.
+		if order.Status == domain.OrderPaid {
+			return domain.ErrOrderConflict
+		}
+		return order.Cancel()
+	})
+	if err != nil {
+		return err
 	}
 
-	order.Status = domain.OrderCompleted
-	order.UpdatedAt = time.Now()
+	// Order is cancelled already, so checkout failing here is retried by its own worker, not by caller.
+	if err := o.checkout.Abort(ctx, orderId, reasonOrderCancelled); err != nil {
+		o.log.Error("failed to abort checkout", "error", err, "order_id", orderId.String())
+	}
 
-	// for _, item := range order.Items {
-	// 	if err := o.inventoryService.SubReservedQuantity(ctx, item.ProductID, item.Quantity); err != nil {
-	// 		o.log.Error("failed to complete order", "error", err)
-	// 		return err
-	// 	}
-	// }
+	return nil
+}
 
-	items := map[string]uint64{}
-	for _, item := range order.Items {
-		items[item.ProductID.String()] = item.Quantity
+// CancelOrderItems implements interfaces.OrderService.
+func (o *OrderService) CancelOrderItems(ctx context.Context, orderId uuid.UUID, items []domain.Item) (*domain.Order, error) {
+	p, err := principalFromCtx(ctx)
+	if err != nil {
+		o.log.Error("failed to cancel order items", "error", err, "order_id", orderId.String())
+		return nil, err
+	}
+
+	var cancellation *domain.Cancellation
+	order, err := o.repo.CancelItems(ctx, orderId.String(), func(order *domain.Order) (*domain.Cancellation, *domain.HistoryEntry, error) {
+		if !p.CanAccess(order.UserID) {
+			return nil, nil, domain.NewAppError(domain.ErrPermissionDenied, "order belongs to another user")
+		}
+
+		before := order.Clone()
+
+		c, err := order.CancelItems(items)
+		if err != nil {
+			return nil, nil, domain.NewAppError(err, err.Error())
+		}
+		cancellation = c
+
+		entry := domain.NewHistoryEntry(ctx, before, order)
+		if cancellation.Paid {
+			entry.Diff = append(entry.Diff, domain.FieldChange{Field: "refund", To: cancellation.Total().String()})
+		}
+
+		return cancellation, entry, nil
+	})
+	if err != nil {
+		o.log.Error("failed to cancel order items", "error", err, "order_id", orderId.String())
+		return nil, callbackError(err, "failed to cancel order items")
+	}
+
+	o.log.Debug("order items cancelled", "order_id", orderId.String(), "cancellation_id", cancellation.ID.String(),
+		"total", cancellation.Total().String(), "refunded", cancellation.Paid)
+
+	return order, nil
+}
+
+// RefundOrder implements interfaces.OrderService.
+func (o *OrderService) RefundOrder(ctx context.Context, orderId uuid.UUID) error {
+	return o.changeStatus(ctx, orderId, "refund", (*domain.Order).Refund)
+}
+
+// changeStatus applies transition to owned order and saves it. Action is used for logs and error messages only.
+func (o *OrderService) changeStatus(ctx context.Context, orderId uuid.UUID, action string, transition func(*domain.Order) error) error {
+	order, err := o.getOwnedOrder(ctx, orderId)
+	if err != nil {
+		o.log.Error("failed to "+action+" order", "error", err, "order_id", orderId.String())
+		return err
+	}
+
+	before := order.Clone()
+
+	if err := transition(order); err != nil {
+		o.log.Error("failed to "+action+" order", "error", err, "order_id", orderId.String())
+		return domain.NewAppError(err, err.Error())
 	}
 
-	if err := o.repo.Update(ctx, order); err != nil {
-		o.log.Error("failed to complete order", "error", err, "order_id", orderId.String())
-		return domain.NewAppError(err, "failed to complete order")
+	if err := o.repo.Update(ctx, order, domain.NewHistoryEntry(ctx, before, order)); err != nil {
+		o.log.Error("failed to "+action+" order", "error", err, "order_id", orderId.String())
+		return saveError(err, "failed to "+action+" order")
 	}
 
-	o.log.Debug("order completed", "order_id", order.ID.String())
+	o.log.Debug("order status changed", "order_id", order.ID.String(), "status", order.Status.String())
 
 	return nil
 }
 
-// CancelOrder implements interfaces.OrderService.
-func (o *OrderService) CancelOrder(ctx context.Context, orderId uuid.UUID) error {
-	order, err := o.repo.GetById(ctx, orderId.String())
+// GetOrderHistory implements interfaces.OrderService.
+func (o *OrderService) GetOrderHistory(ctx context.Context, orderId uuid.UUID) ([]*domain.HistoryEntry, error) {
+	p, err := principalFromCtx(ctx)
 	if err != nil {
-		o.log.Error("failed to cancel order", "error", err, "order_id", orderId.String())
-		return domain.NewAppError(err, "failed to get order")
+		o.log.Error("failed to get order history", "error", err, "order_id", orderId.String())
+		return nil, err
 	}
 
-	// FIXME Тут проверка на принадлежность пользователю. Получение Id пользователя из контекста.
+	// Privileged callers may look into history of deleted orders, so ownership is checked for users only.
+	if !p.IsPrivileged() {
+		if _, err := o.getOwnedOrder(ctx, orderId); err != nil {
+			o.log.Error("failed to get order history", "error", err, "order_id", orderId.String())
+			return nil, err
+		}
+	}
 
-	if order.Status == domain.OrderCompleted {
-		o.log.Error("failed to cancel order", "error", domain.ErrOrderAlreadyCompleted, "order_id", orderId.String())
-		return domain.NewAppError(domain.ErrOrderAlreadyCompleted, "order already completed")
+	entries, err := o.repo.GetHistory(ctx, orderId.String())
+	if err != nil {
+		o.log.Error("failed to get order history", "error", err, "order_id", orderId.String())
+		return nil, domain.NewAppError(err, "failed to get order history")
 	}
 
-	order.Status = domain.OrderCancelled
-	order.UpdatedAt = time.Now()
+	o.log.Debug("order history retrieved", "order_id", orderId.String(), "count", len(entries))
 
-	items := map[string]uint64{}
+	return entries, nil
+}
+
+// newLine snapshots current product price into order line without discount.
+func newLine(item domain.Item, product *dto.ProductInfo, currency domain.Currency) domain.Item {
+	price := money.New(product.Price.Amount, currency.String())
+	return domain.NewItem(item.ProductID, item.Quantity, product.Name, price, 0)
+}
+
+// repriceItems builds new lines of order. Lines whose quantity stays the same keep their snapshot, new and
+// changed lines are priced at current price and taxed by current rates of order region. Changed line keeps
+// discount it had for units ordered before, added units get none.
+func (o *OrderService) repriceItems(ctx context.Context, order *domain.Order, items domain.Items) (domain.Items, error) {
+	snapshots := make(map[uuid.UUID]domain.Item, len(order.Items))
 	for _, item := range order.Items {
+		snapshots[item.ProductID] = item
+	}
+
+	var changed domain.Items
+	for _, item := range items {
+		if snapshot, ok := snapshots[item.ProductID]; !ok || snapshot.Quantity != item.Quantity {
+			changed = append(changed, item)
+		}
+	}
+
+	var (
+		taxes    *domain.TaxTable
+		products map[uuid.UUID]*dto.ProductInfo
+	)
+
+	if len(changed) > 0 {
+		timeout, cancel := context.WithTimeout(ctx, 5*time.Second) // FIXME Тоже хардкод
+		defer cancel()
+
+		var err error
+		if taxes, err = o.taxTable(timeout, orderTaxRegion(order)); err != nil {
+			return nil, err
+		}
+
+		if products, err = o.productsInfo(timeout, changed); err != nil {
+			return nil, err
+		}
+	}
+
+	lines := make(domain.Items, 0, len(items))
+	for _, item := range items {
+		snapshot, ok := snapshots[item.ProductID]
+		if ok && snapshot.Quantity == item.Quantity {
+			lines = append(lines, snapshot)
+			continue
+		}
+
+		product := products[item.ProductID]
+		if !product.IsActive {
+			o.log.Error("product unavailable", "error", domain.ErrProductUnavailable, "product_id", item.ProductID)
+			return nil, domain.NewAppError(domain.ErrProductUnavailable, "product unavailable")
+		}
+
+		line := newLine(item, product, order.Currency)
+
+		if ok {
+			line.Discount = snapshot.WithQuantity(min(item.Quantity, snapshot.Quantity)).Discount
+			if gross := line.UnitPrice.Mul(line.Quantity); line.Discount.Cmp(gross) > 0 {
+				line.Discount = gross
+			}
+		}
+
+		lines = append(lines, taxes.Apply(line, product.Category))
+	}
+
+	return lines, nil
+}
+
+// checkLocked checks that stock of units added to order can be reserved. Only a fast pre-check,
+// stock is actually locked by inventory once order is saved. Units it fails to lock are taken off order,
+// see interfaces.Checkout.StockLockRejected.
+//
+// Returns wrapped errors ready to be returned to client.
+func (o *OrderService) checkLocked(ctx context.Context, change *domain.StockChange) error {
+	if len(change.Locked) == 0 {
+		return nil
+	}
+
+	timeout, cancel := context.WithTimeout(ctx, 5*time.Second) // FIXME Тоже хардкод
+	defer cancel()
+
+	items := make(map[string]uint64, len(change.Locked))
+	for _, item := range change.Locked {
 		items[item.ProductID.String()] = item.Quantity
 	}
 
-	if err := o.repo.Update(ctx, order); err != nil {
-		o.log.Error("failed to cancel order", "error", err, "order_id", orderId.String())
-		return domain.NewAppError(err, "failed to cancel order")
+	isReservable, err := o.inventoryService.IsReservable(timeout, items)
+	if err != nil {
+		o.log.Error("failed to check if items reservable", "error", err, "order_id", change.OrderID.String())
+		return domain.NewAppError(err, "failed to check if items reservable")
 	}
 
-	o.log.Debug("order cancelled", "order_id", order.ID.String())
+	if !isReservable {
+		o.log.Error("failed to update order", "error", domain.ErrNotEnoughQuantity, "order_id", change.OrderID.String())
+		return domain.NewAppError(domain.ErrNotEnoughQuantity, domain.ErrNotEnoughQuantity.Error())
+	}
 
 	return nil
 }
+
+// orderTaxRegion returns region order is taxed in. Orders placed before taxes existed have none stored.
+func orderTaxRegion(order *domain.Order) domain.TaxRegion {
+	if order.TaxRegion != "" {
+		return order.TaxRegion
+	}
+	return domain.TaxRegionOf(order.Currency)
+}
+
+// principalFromCtx returns caller identity put into context by authentication interceptor.
+func principalFromCtx(ctx context.Context) (domain.Principal, error) {
+	p, ok := domain.PrincipalFromContext(ctx)
+	if !ok {
+		return domain.Principal{}, domain.NewAppError(domain.ErrUnauthenticated, "unauthenticated")
+	}
+	return p, nil
+}
+
+// callbackError returns error raised by callback passed to repository as is, so client gets its message.
+// Errors of repository itself are wrapped with msg.
+func callbackError(err error, msg string) error {
+	var appErr *domain.AppError
+	if errors.As(err, &appErr) {
+		return appErr
+	}
+	return domain.NewAppError(err, msg)
+}
+
+// getOwnedOrder returns order if caller is its owner (or privileged). Archived orders are found
+// by privileged callers only.
+//
+// Returns wrapped errors ready to be returned to client.
+func (o *OrderService) getOwnedOrder(ctx context.Context, orderId uuid.UUID) (*domain.Order, error) {
+	p, err := principalFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	order, err := o.repo.GetById(ctx, orderId.String())
+	if err != nil {
+		return nil, domain.NewAppError(err, "failed to get order")
+	}
+
+	if !p.CanAccess(order.UserID) {
+		return nil, domain.NewAppError(domain.ErrPermissionDenied, "order belongs to another user")
+	}
+
+	if order.IsDeleted() && !p.IsPrivileged() {
+		return nil, domain.NewAppError(domain.ErrOrderNotFound, "failed to get order")
+	}
+
+	return order, nil
+}
@@ -0,0 +1,298 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/dzhordano/ecom-thing/services/order/internal/application/dto"
+	"github.com/dzhordano/ecom-thing/services/order/internal/domain"
+	"github.com/dzhordano/ecom-thing/services/order/pkg/money"
+	"github.com/google/uuid"
+)
+
+// QuoteOrder implements interfaces.OrderService.
+func (o *OrderService) QuoteOrder(ctx context.Context, info dto.CreateOrderRequest) (*domain.Quote, error) {
+	if _, err := principalFromCtx(ctx); err != nil {
+		o.log.Error("failed to quote order", "error", err)
+		return nil, err
+	}
+
+	quote, err := o.quote(ctx, info)
+	if err != nil {
+		return nil, err
+	}
+
+	o.log.Debug("order quoted", "total", quote.Total().String(), "warnings", len(quote.Warnings))
+
+	return quote, nil
+}
+
+// quote is pricing pipeline shared by QuoteOrder and CreateOrder: product prices, coupon, shipping, taxes
+// and stock availability. Error is returned only if order can't be priced at all, problems of lines
+// and coupon are recorded as quote warnings.
+//
+// Returns wrapped errors ready to be returned to client.
+func (o *OrderService) quote(ctx context.Context, info dto.CreateOrderRequest) (*domain.Quote, error) {
+	quote, err := domain.NewQuote(info.Currency, info.DeliveryMethod)
+	if err != nil {
+		o.log.Error("failed to quote order", "error", err)
+		return nil, domain.NewAppError(err, err.Error())
+	}
+
+	timeout, cancel := context.WithTimeout(ctx, 5*time.Second) // FIXME Тоже хардкод
+	defer cancel()
+
+	products, err := o.productsInfo(timeout, info.Items)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, item := range info.Items {
+		product := products[item.ProductID]
+
+		if !product.IsActive {
+			o.log.Debug("product unavailable", "product_id", item.ProductID)
+			quote.Warn(item.ProductID, domain.ErrProductUnavailable)
+		}
+
+		quote.Lines = append(quote.Lines, domain.QuoteLine{
+			Item:      newLine(item, product, quote.Currency),
+			Category:  product.Category,
+			Available: product.IsActive,
+		})
+	}
+
+	if info.Coupon != "" {
+		if err := o.applyCoupon(ctx, quote, info.Coupon); err != nil {
+			return nil, err
+		}
+	}
+
+	quote.Shipping, err = o.shippingCost(ctx, quote.DeliveryMethod, quote.Items(), quote.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	taxes, err := o.taxTable(ctx, quote.TaxRegion)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, line := range quote.Lines {
+		quote.Lines[i].Item = taxes.Apply(line.Item, line.Category)
+	}
+
+	if err := o.checkAvailability(ctx, quote); err != nil {
+		return nil, err
+	}
+
+	return quote, nil
+}
+
+// productsInfo looks up products of all items in one call.
+//
+// Returns wrapped errors ready to be returned to client, ErrProductNotFound if any of products doesn't exist.
+func (o *OrderService) productsInfo(ctx context.Context, items domain.Items) (map[uuid.UUID]*dto.ProductInfo, error) {
+	ids := make([]uuid.UUID, 0, len(items))
+	for _, item := range items {
+		ids = append(ids, item.ProductID)
+	}
+
+	products, err := o.productService.GetProductsInfo(ctx, ids)
+	if err != nil {
+		o.log.Error("failed to get product info", "error", err, "products", len(ids))
+		return nil, domain.NewAppError(err, "failed to get product info")
+	}
+
+	for _, id := range ids {
+		if _, ok := products[id]; !ok {
+			o.log.Error("failed to get product info", "error", domain.ErrProductNotFound, "product_id", id)
+			return nil, domain.NewAppError(domain.ErrProductNotFound, "product "+id.String()+" not found")
+		}
+	}
+
+	return products, nil
+}
+
+// applyCoupon sets discount of coupon on quote lines. Coupon that is unknown, can't be redeemed now
+// or doesn't fit order is a warning, lines keep no discount then.
+func (o *OrderService) applyCoupon(ctx context.Context, quote *domain.Quote, code string) error {
+	coupon, err := o.coupons.GetByCode(ctx, code)
+	if errors.Is(err, domain.ErrCouponNotFound) {
+		quote.Warn(uuid.Nil, domain.ErrCouponNotFound)
+		return nil
+	}
+	if err != nil {
+		o.log.Error("failed to get coupon", "error", err, "coupon", code)
+		return domain.NewAppError(err, "failed to get coupon")
+	}
+
+	if err := coupon.CheckRedeemable(o.now()); err != nil {
+		o.log.Debug("coupon not redeemable", "error", err, "coupon", code)
+		quote.Warn(uuid.Nil, err)
+		return nil
+	}
+
+	categories := make(map[uuid.UUID]string, len(quote.Lines))
+	for _, line := range quote.Lines {
+		categories[line.ProductID] = line.Category
+	}
+
+	items, err := coupon.Apply(quote.Items(), quote.Currency, categories)
+	if err != nil {
+		o.log.Debug("coupon not applicable", "error", err, "coupon", code)
+		quote.Warn(uuid.Nil, err)
+		return nil
+	}
+
+	for i := range quote.Lines {
+		quote.Lines[i].Item = items[i]
+	}
+	quote.Coupon = coupon.Code
+
+	return nil
+}
+
+// checkAvailability marks lines that can't be reserved. Only a fast pre-check, stock is actually reserved
+// by checkout. Whole order is checked first, lines are checked one by one only if it doesn't fit.
+func (o *OrderService) checkAvailability(ctx context.Context, quote *domain.Quote) error {
+	timeout, cancel := context.WithTimeout(ctx, 5*time.Second) // FIXME Тоже хардкод
+	defer cancel()
+
+	items := make(map[string]uint64, len(quote.Lines))
+	for _, line := range quote.Lines {
+		items[line.ProductID.String()] += line.Quantity
+	}
+
+	isReservable, err := o.inventoryService.IsReservable(timeout, items)
+	if err != nil {
+		o.log.Error("failed to check if items reservable", "error", err)
+		return domain.NewAppError(err, "failed to check if items reservable")
+	}
+
+	if isReservable {
+		return nil
+	}
+
+	missing := make(map[uuid.UUID]bool, len(items))
+	for _, line := range quote.Lines {
+		if _, checked := missing[line.ProductID]; checked {
+			continue
+		}
+
+		id := line.ProductID.String()
+		isReservable, err := o.inventoryService.IsReservable(timeout, map[string]uint64{id: items[id]})
+		if err != nil {
+			o.log.Error("failed to check if items reservable", "error", err, "product_id", id)
+			return domain.NewAppError(err, "failed to check if items reservable")
+		}
+
+		missing[line.ProductID] = !isReservable
+		if !isReservable {
+			quote.Warn(line.ProductID, domain.ErrNotEnoughQuantity)
+		}
+	}
+
+	var found bool
+	for i, line := range quote.Lines {
+		if missing[line.ProductID] {
+			quote.Lines[i].Available = false
+			found = true
+		}
+	}
+
+	// Stock changed between checks, nothing to blame but the order as a whole.
+	if !found {
+		quote.Warn(uuid.Nil, domain.ErrNotEnoughQuantity)
+	}
+
+	return nil
+}
+
+// taxTable returns taxes of region.
+//
+// Returns wrapped errors ready to be returned to client.
+func (o *OrderService) taxTable(ctx context.Context, region domain.TaxRegion) (*domain.TaxTable, error) {
+	taxes, err := o.taxes.Taxes(ctx, region)
+	if err != nil {
+		o.log.Error("failed to get tax table", "error", err, "region", region)
+		return nil, domain.NewAppError(err, "failed to calculate tax")
+	}
+	return taxes, nil
+}
+
+// shippingCost prices delivery of items with method.
+//
+// Returns wrapped errors ready to be returned to client.
+func (o *OrderService) shippingCost(ctx context.Context, method domain.DeliveryMethod, items domain.Items, currency domain.Currency) (money.Money, error) {
+	fee, err := o.shipping.ShippingCost(ctx, method, items, currency)
+	if errors.Is(err, domain.ErrShippingRuleNotFound) {
+		o.log.Debug("delivery method unavailable", "delivery_method", method)
+		return money.Money{}, domain.NewAppError(err, err.Error())
+	}
+	if err != nil {
+		o.log.Error("failed to calculate shipping cost", "error", err, "delivery_method", method)
+		return money.Money{}, domain.NewAppError(err, "failed to calculate shipping cost")
+	}
+
+	return fee, nil
+}
+
+// checkDeliveryDate checks that order placed now may be delivered with method on date.
+//
+// Returns wrapped errors ready to be returned to client.
+func (o *OrderService) checkDeliveryDate(ctx context.Context, method domain.DeliveryMethod, date time.Time) error {
+	err := o.shipping.CheckDeliveryDate(ctx, method, date, o.now())
+	if errors.Is(err, domain.ErrShippingRuleNotFound) || errors.Is(err, domain.ErrDeliveryDateUnavailable) {
+		o.log.Debug("delivery date unavailable", "error", err, "delivery_method", method)
+		return domain.NewAppError(err, err.Error())
+	}
+	if err != nil {
+		o.log.Error("failed to check delivery date", "error", err, "delivery_method", method)
+		return domain.NewAppError(err, "failed to check delivery date")
+	}
+
+	return nil
+}
+
+// reship reprices delivery of updated order if its method or items changed, and checks its delivery date
+// if method or date changed. Dates of untouched delivery are not rechecked, window may have moved since.
+//
+// Returns wrapped errors ready to be returned to client.
+func (o *OrderService) reship(ctx context.Context, before, order *domain.Order) error {
+	methodChanged := before.DeliveryMethod != order.DeliveryMethod
+
+	if methodChanged || !before.DeliveryDate.Equal(order.DeliveryDate) {
+		if err := o.checkDeliveryDate(ctx, order.DeliveryMethod, order.DeliveryDate); err != nil {
+			return err
+		}
+	}
+
+	if methodChanged || !slices.Equal(before.Items, order.Items) {
+		fee, err := o.shippingCost(ctx, order.DeliveryMethod, order.Items, order.Currency)
+		if err != nil {
+			return err
+		}
+		order.ShippingFee = fee
+	}
+
+	return nil
+}
+
+// saveError wraps error of saving order, rejections of coupon and delivery slot and conflicts keep their own message.
+func saveError(err error, msg string) error {
+	switch {
+	case errors.Is(err, domain.ErrCouponRedemptionLimit):
+		return domain.NewAppError(err, domain.ErrCouponRedemptionLimit.Error())
+	case errors.Is(err, domain.ErrDeliverySlotUnavailable):
+		return domain.NewAppError(err, domain.ErrDeliverySlotUnavailable.Error())
+	case errors.Is(err, domain.ErrOrderConflict):
+		return domain.NewAppError(err, domain.ErrOrderConflict.Error())
+	case errors.Is(err, domain.ErrOrderNotArchivable):
+		return domain.NewAppError(err, domain.ErrOrderNotArchivable.Error())
+	default:
+		return domain.NewAppError(err, msg)
+	}
+}
@@ -0,0 +1,618 @@
+package service
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/dzhordano/ecom-thing/services/order/internal/application/dto"
+	"github.com/dzhordano/ecom-thing/services/order/internal/domain"
+	"github.com/dzhordano/ecom-thing/services/order/internal/domain/repository"
+	"github.com/dzhordano/ecom-thing/services/order/pkg/logger"
+	"github.com/dzhordano/ecom-thing/services/order/pkg/money"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+)
+
+var testAddress = domain.Address{Country: "US", City: "New York", PostalCode: "10001", Street: "5th Avenue", Building: "1",
+	Recipient: "John Doe", Phone: "+12125550100"}
+
+type catalogProducts map[uuid.UUID]*dto.ProductInfo
+
+func (c catalogProducts) GetProductInfo(_ context.Context, id uuid.UUID) (*dto.ProductInfo, error) {
+	p, ok := c[id]
+	if !ok {
+		return nil, domain.ErrProductUnavailable
+	}
+	return p, nil
+}
+
+func (c catalogProducts) GetProductsInfo(_ context.Context, ids []uuid.UUID) (map[uuid.UUID]*dto.ProductInfo, error) {
+	products := make(map[uuid.UUID]*dto.ProductInfo, len(ids))
+	for _, id := range ids {
+		if p, ok := c[id]; ok {
+			products[id] = p
+		}
+	}
+	return products, nil
+}
+
+// stockInventory tells whether requested quantities fit stock it knows of.
+type stockInventory map[string]uint64
+
+func (s stockInventory) IsReservable(_ context.Context, items map[string]uint64) (bool, error) {
+	for id, q := range items {
+		if s[id] < q {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+type shippingRules map[domain.DeliveryMethod]*domain.ShippingRule
+
+func (r shippingRules) ShippingCost(_ context.Context, method domain.DeliveryMethod, items domain.Items, c domain.Currency) (money.Money, error) {
+	rule, ok := r[method]
+	if !ok {
+		return money.Money{}, domain.ErrShippingRuleNotFound
+	}
+	return rule.Fee(items, c), nil
+}
+
+func (r shippingRules) CheckDeliveryDate(_ context.Context, method domain.DeliveryMethod, date, now time.Time) error {
+	rule, ok := r[method]
+	if !ok {
+		return domain.ErrShippingRuleNotFound
+	}
+	return rule.CheckDate(date, now)
+}
+
+// taxTables charges 10% on top of prices, except for regions it has tables of.
+type taxTables map[domain.TaxRegion]*domain.TaxTable
+
+func (t taxTables) Taxes(_ context.Context, region domain.TaxRegion) (*domain.TaxTable, error) {
+	if table, ok := t[region]; ok {
+		return table, nil
+	}
+	return &domain.TaxTable{Region: region, DefaultRate: 10}, nil
+}
+
+type stubCoupons struct {
+	repository.CouponRepository
+	coupons map[string]*domain.Coupon
+}
+
+func (c stubCoupons) GetByCode(_ context.Context, code string) (*domain.Coupon, error) {
+	coupon, ok := c.coupons[code]
+	if !ok {
+		return nil, domain.ErrCouponNotFound
+	}
+	return coupon, nil
+}
+
+type pricingEnv struct {
+	book, pen uuid.UUID
+	stock     stockInventory
+	products  catalogProducts
+	orders    *memOrderRepository
+	svc       *OrderService
+	ctx       context.Context
+}
+
+func newPricingEnv(t *testing.T) *pricingEnv {
+	t.Helper()
+
+	now := time.Now().UTC()
+
+	e := &pricingEnv{
+		book:   uuid.New(),
+		pen:    uuid.New(),
+		orders: &memOrderRepository{orders: map[uuid.UUID]domain.Order{}},
+		ctx: domain.ContextWithPrincipal(context.Background(), domain.Principal{
+			UserID: uuid.New(),
+			Roles:  []domain.Role{domain.RoleUser},
+		}),
+	}
+
+	e.products = catalogProducts{
+		e.book: {Name: "Book", Price: money.New(1000, ""), Category: "books", IsActive: true},
+		e.pen:  {Name: "Pen", Price: money.New(250, ""), Category: "stationery", IsActive: true},
+	}
+	e.stock = stockInventory{e.book.String(): 10, e.pen.String(): 10}
+
+	coupons := stubCoupons{coupons: map[string]*domain.Coupon{
+		"BOOKS20": {
+			Code:       "BOOKS20",
+			Type:       domain.DiscountPercent,
+			Discount:   20,
+			Categories: []string{"books"},
+			Active:     true,
+			ValidFrom:  now.Add(-time.Hour),
+			ValidTo:    now.Add(time.Hour),
+		},
+	}}
+
+	e.svc = NewOrderService(
+		logger.MustInit(logger.LevelError, filepath.Join(t.TempDir(), "pricing-test.log"), "json", false),
+		e.products,
+		e.stock,
+		shippingRules{
+			domain.Standard: {Method: domain.Standard, BaseFee: money.New(500, ""), FreeFrom: money.New(5000, "")},
+			domain.Express:  {Method: domain.Express, BaseFee: money.New(1500, ""), MaxDays: 2},
+		},
+		taxTables{
+			domain.RegionRU: {Region: domain.RegionRU, Included: true, DefaultRate: 20, Rates: map[string]float64{"books": 10}},
+		},
+		savingOrderRepository{e.orders},
+		coupons,
+		nopCheckout{},
+		nil,
+		time.Hour,
+		time.Minute,
+	).(*OrderService)
+	e.svc.now = func() time.Time { return now }
+
+	return e
+}
+
+func (e *pricingEnv) request(coupon string) dto.CreateOrderRequest {
+	return dto.CreateOrderRequest{
+		Description:     "Description",
+		Currency:        domain.USD.String(),
+		Coupon:          coupon,
+		PaymentMethod:   domain.Cash.String(),
+		DeliveryMethod:  domain.Standard.String(),
+		DeliveryAddress: testAddress,
+		DeliveryDate:    time.Now().Add(time.Hour).UTC(),
+		Items: []domain.Item{
+			{ProductID: e.book, Quantity: 2},
+			{ProductID: e.pen, Quantity: 4},
+		},
+	}
+}
+
+func TestOrderService_QuoteOrder(t *testing.T) {
+	t.Run("breakdown", func(t *testing.T) {
+		env := newPricingEnv(t)
+
+		quote, err := env.svc.QuoteOrder(env.ctx, env.request("BOOKS20"))
+		require.NoError(t, err)
+
+		require.Len(t, quote.Lines, 2)
+		assert.Empty(t, quote.Warnings)
+		assert.Equal(t, "BOOKS20", quote.Coupon)
+
+		// Book: 2 * 10.00 - 20% = 16.00, tax 1.60. Pen: 4 * 2.50 = 10.00, tax 1.00.
+		assert.Equal(t, money.New(1600, "USD"), quote.Lines[0].Total())
+		assert.Equal(t, money.New(160, "USD"), quote.Lines[0].Tax)
+		assert.Equal(t, money.New(1000, "USD"), quote.Lines[1].Total())
+		assert.True(t, quote.Lines[1].Available)
+
+		assert.Equal(t, money.New(2600, "USD"), quote.Subtotal())
+		assert.Equal(t, money.New(400, "USD"), quote.Discount())
+		assert.Equal(t, money.New(260, "USD"), quote.Tax())
+		assert.Equal(t, money.New(500, "USD"), quote.Shipping)
+		assert.Equal(t, money.New(3360, "USD"), quote.Total())
+
+		assert.Empty(t, env.orders.orders)
+	})
+
+	t.Run("tax included in prices", func(t *testing.T) {
+		env := newPricingEnv(t)
+		req := env.request("")
+		req.Currency = domain.RUB.String()
+
+		quote, err := env.svc.QuoteOrder(env.ctx, req)
+		require.NoError(t, err)
+
+		// Book: 20.00 at 10% holds 1.82 of tax. Pen: 10.00 at default 20% holds 1.67.
+		assert.Equal(t, money.New(182, "RUB"), quote.Lines[0].Tax)
+		assert.Equal(t, 10.0, quote.Lines[0].TaxRate)
+		assert.Equal(t, money.New(167, "RUB"), quote.Lines[1].Tax)
+		assert.True(t, quote.Lines[1].TaxIncluded)
+
+		assert.Equal(t, money.New(349, "RUB"), quote.Tax())
+		assert.Equal(t, money.New(3000+500, "RUB"), quote.Total())
+	})
+
+	t.Run("problems are warnings", func(t *testing.T) {
+		env := newPricingEnv(t)
+		env.products[env.book].IsActive = false
+		env.stock[env.pen.String()] = 3
+
+		quote, err := env.svc.QuoteOrder(env.ctx, env.request("UNKNOWN"))
+		require.NoError(t, err)
+
+		assert.False(t, quote.Lines[0].Available)
+		assert.False(t, quote.Lines[1].Available)
+		assert.Empty(t, quote.Coupon)
+		assert.True(t, quote.Discount().IsZero())
+
+		require.Len(t, quote.Warnings, 3)
+		assert.ErrorIs(t, quote.Warnings[0].Err, domain.ErrProductUnavailable)
+		assert.Equal(t, env.book, quote.Warnings[0].ProductID)
+		assert.ErrorIs(t, quote.Warnings[1].Err, domain.ErrCouponNotFound)
+		assert.Equal(t, uuid.Nil, quote.Warnings[1].ProductID)
+		assert.ErrorIs(t, quote.Warnings[2].Err, domain.ErrNotEnoughQuantity)
+		assert.Equal(t, env.pen, quote.Warnings[2].ProductID)
+	})
+
+	t.Run("coupon that doesn't fit order", func(t *testing.T) {
+		env := newPricingEnv(t)
+		req := env.request("BOOKS20")
+		req.Items = req.Items[1:]
+
+		quote, err := env.svc.QuoteOrder(env.ctx, req)
+		require.NoError(t, err)
+
+		require.Len(t, quote.Warnings, 1)
+		assert.ErrorIs(t, quote.Warnings[0].Err, domain.ErrCouponNotApplicable)
+		assert.Empty(t, quote.Coupon)
+	})
+
+	t.Run("invalid currency", func(t *testing.T) {
+		env := newPricingEnv(t)
+		req := env.request("")
+		req.Currency = "XXX"
+
+		_, err := env.svc.QuoteOrder(env.ctx, req)
+		assert.ErrorIs(t, err, domain.ErrInvalidArgument)
+	})
+
+	t.Run("unknown product", func(t *testing.T) {
+		env := newPricingEnv(t)
+		delete(env.products, env.pen)
+
+		_, err := env.svc.QuoteOrder(env.ctx, env.request(""))
+
+		var appErr *domain.AppError
+		require.ErrorAs(t, err, &appErr)
+		assert.ErrorIs(t, err, domain.ErrProductNotFound)
+		assert.Equal(t, codes.NotFound, appErr.GRPCCode())
+	})
+}
+
+func TestOrderService_CreateOrder_Pricing(t *testing.T) {
+	t.Run("order gets quoted lines", func(t *testing.T) {
+		env := newPricingEnv(t)
+		req := env.request("BOOKS20")
+
+		quote, err := env.svc.QuoteOrder(env.ctx, req)
+		require.NoError(t, err)
+
+		order, err := env.svc.CreateOrder(env.ctx, req)
+		require.NoError(t, err)
+
+		assert.Equal(t, quote.Items(), order.Items)
+		assert.Equal(t, "BOOKS20", order.Coupon)
+		assert.Equal(t, money.New(500, "USD"), order.ShippingFee)
+		assert.Equal(t, money.New(260, "USD"), order.Tax())
+		assert.Equal(t, money.New(3360, "USD"), order.TotalPrice())
+		assert.Equal(t, domain.RegionUS, order.TaxRegion)
+	})
+
+	t.Run("delivery date out of window", func(t *testing.T) {
+		env := newPricingEnv(t)
+		req := env.request("")
+		req.DeliveryMethod = domain.Express.String()
+		req.DeliveryDate = time.Now().AddDate(0, 0, 5).UTC()
+
+		_, err := env.svc.CreateOrder(env.ctx, req)
+		assert.ErrorIs(t, err, domain.ErrDeliveryDateUnavailable)
+		assert.Empty(t, env.orders.orders)
+	})
+
+	t.Run("unavailable delivery method", func(t *testing.T) {
+		env := newPricingEnv(t)
+		req := env.request("")
+		req.DeliveryMethod = domain.Pickup.String()
+
+		_, err := env.svc.CreateOrder(env.ctx, req)
+		assert.ErrorIs(t, err, domain.ErrShippingRuleNotFound)
+	})
+
+	t.Run("invalid address", func(t *testing.T) {
+		env := newPricingEnv(t)
+		req := env.request("")
+		req.DeliveryAddress.PostalCode = "101000"
+
+		_, err := env.svc.CreateOrder(env.ctx, req)
+		assert.ErrorIs(t, err, domain.ErrInvalidArgument)
+		assert.ErrorContains(t, err, "invalid postal code")
+		assert.Empty(t, env.orders.orders)
+	})
+
+	t.Run("warning rejects order", func(t *testing.T) {
+		env := newPricingEnv(t)
+		env.stock[env.pen.String()] = 0
+
+		_, err := env.svc.CreateOrder(env.ctx, env.request(""))
+		assert.ErrorIs(t, err, domain.ErrNotEnoughQuantity)
+		assert.Empty(t, env.orders.orders)
+	})
+}
+
+func TestOrderService_UpdateOrder_Shipping(t *testing.T) {
+	t.Run("changed method reprices shipping", func(t *testing.T) {
+		env := newPricingEnv(t)
+
+		order, err := env.svc.CreateOrder(env.ctx, env.request(""))
+		require.NoError(t, err)
+
+		method := domain.Express.String()
+		order, err = env.svc.UpdateOrder(env.ctx, dto.UpdateOrderRequest{OrderID: order.ID, DeliveryMethod: &method})
+		require.NoError(t, err)
+
+		assert.Equal(t, money.New(1500, "USD"), order.ShippingFee)
+		assert.Equal(t, money.New(1500, "USD"), env.orders.orders[order.ID].ShippingFee)
+	})
+
+	t.Run("items over free threshold ship for free", func(t *testing.T) {
+		env := newPricingEnv(t)
+
+		order, err := env.svc.CreateOrder(env.ctx, env.request(""))
+		require.NoError(t, err)
+
+		order, err = env.svc.UpdateOrder(env.ctx, dto.UpdateOrderRequest{
+			OrderID: order.ID,
+			Items:   []domain.Item{{ProductID: env.book, Quantity: 5}},
+		})
+		require.NoError(t, err)
+
+		assert.True(t, order.ShippingFee.IsZero())
+	})
+
+	t.Run("new date out of window", func(t *testing.T) {
+		env := newPricingEnv(t)
+		req := env.request("")
+		req.DeliveryMethod = domain.Express.String()
+
+		order, err := env.svc.CreateOrder(env.ctx, req)
+		require.NoError(t, err)
+
+		_, err = env.svc.UpdateOrder(env.ctx, dto.UpdateOrderRequest{
+			OrderID:      order.ID,
+			DeliveryDate: time.Now().AddDate(0, 0, 7).UTC(),
+		})
+		assert.ErrorIs(t, err, domain.ErrDeliveryDateUnavailable)
+		assert.Equal(t, req.DeliveryDate, env.orders.orders[order.ID].DeliveryDate)
+	})
+
+	t.Run("new address is normalized", func(t *testing.T) {
+		env := newPricingEnv(t)
+
+		order, err := env.svc.CreateOrder(env.ctx, env.request(""))
+		require.NoError(t, err)
+
+		address := testAddress
+		address.City, address.Phone = " Boston ", "+1 (617) 555-0100"
+		order, err = env.svc.UpdateOrder(env.ctx, dto.UpdateOrderRequest{OrderID: order.ID, DeliveryAddress: &address})
+		require.NoError(t, err)
+
+		assert.Equal(t, "Boston", order.DeliveryAddress.City)
+		assert.Equal(t, "+16175550100", env.orders.orders[order.ID].DeliveryAddress.Phone)
+	})
+
+	t.Run("legacy address is only checked once changed", func(t *testing.T) {
+		env := newPricingEnv(t)
+
+		order, err := env.svc.CreateOrder(env.ctx, env.request(""))
+		require.NoError(t, err)
+
+		// Orders placed before addresses were structured have whole free-form address in street.
+		legacy := env.orders.orders[order.ID]
+		legacy.DeliveryAddress = domain.Address{Street: "Boston, 1 Main St"}
+		env.orders.orders[order.ID] = legacy
+
+		description := "Leave at the door"
+		order, err = env.svc.UpdateOrder(env.ctx, dto.UpdateOrderRequest{OrderID: order.ID, Description: &description})
+		require.NoError(t, err)
+		assert.Equal(t, "Boston, 1 Main St", order.DeliveryAddress.Street)
+
+		incomplete := domain.Address{Street: "1 Main St"}
+		_, err = env.svc.UpdateOrder(env.ctx, dto.UpdateOrderRequest{OrderID: order.ID, DeliveryAddress: &incomplete})
+		assert.ErrorIs(t, err, domain.ErrInvalidArgument)
+		assert.ErrorContains(t, err, "invalid city")
+		assert.Equal(t, legacy.DeliveryAddress, env.orders.orders[order.ID].DeliveryAddress)
+	})
+}
+
+func TestOrderService_UpdateOrder_Items(t *testing.T) {
+	t.Run("changed lines are repriced", func(t *testing.T) {
+		env := newPricingEnv(t)
+
+		order, err := env.svc.CreateOrder(env.ctx, env.request(""))
+		require.NoError(t, err)
+
+		env.products[env.book].Price = money.New(1200, "")
+		env.products[env.pen].Price = money.New(300, "")
+
+		order, err = env.svc.UpdateOrder(env.ctx, dto.UpdateOrderRequest{
+			OrderID: order.ID,
+			Items:   []domain.Item{{ProductID: env.book, Quantity: 3}, {ProductID: env.pen, Quantity: 4}},
+		})
+		require.NoError(t, err)
+
+		require.Len(t, order.Items, 2)
+		assert.Equal(t, money.New(1200, "USD"), order.Items[0].UnitPrice)
+		assert.Equal(t, money.New(360, "USD"), order.Items[0].Tax)
+		// Unchanged line keeps price it was ordered at.
+		assert.Equal(t, money.New(250, "USD"), order.Items[1].UnitPrice)
+	})
+
+	t.Run("only delta of stock is changed", func(t *testing.T) {
+		env := newPricingEnv(t)
+
+		order, err := env.svc.CreateOrder(env.ctx, env.request(""))
+		require.NoError(t, err)
+
+		_, err = env.svc.UpdateOrder(env.ctx, dto.UpdateOrderRequest{
+			OrderID: order.ID,
+			Items:   []domain.Item{{ProductID: env.book, Quantity: 5}},
+		})
+		require.NoError(t, err)
+
+		require.Len(t, env.orders.changes, 1)
+		assert.Equal(t, domain.Items{{ProductID: env.book, Quantity: 3}}, env.orders.changes[0].Locked)
+		assert.Equal(t, domain.Items{{ProductID: env.pen, Quantity: 4}}, env.orders.changes[0].Released)
+
+		// Same quantities change no stock.
+		_, err = env.svc.UpdateOrder(env.ctx, dto.UpdateOrderRequest{
+			OrderID: order.ID,
+			Items:   []domain.Item{{ProductID: env.book, Quantity: 5}},
+		})
+		require.NoError(t, err)
+		assert.Len(t, env.orders.changes, 1)
+	})
+
+	t.Run("discount is kept for ordered units", func(t *testing.T) {
+		env := newPricingEnv(t)
+
+		order, err := env.svc.CreateOrder(env.ctx, env.request("BOOKS20"))
+		require.NoError(t, err)
+		require.Equal(t, money.New(400, "USD"), order.Items[0].Discount)
+
+		order, err = env.svc.UpdateOrder(env.ctx, dto.UpdateOrderRequest{
+			OrderID: order.ID,
+			Items:   []domain.Item{{ProductID: env.book, Quantity: 3}, {ProductID: env.pen, Quantity: 4}},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, money.New(400, "USD"), order.Items[0].Discount)
+
+		order, err = env.svc.UpdateOrder(env.ctx, dto.UpdateOrderRequest{
+			OrderID: order.ID,
+			Items:   []domain.Item{{ProductID: env.book, Quantity: 1}, {ProductID: env.pen, Quantity: 4}},
+		})
+		require.NoError(t, err)
+		// Removed units take their share of discount with them.
+		assert.Equal(t, money.New(133, "USD"), order.Items[0].Discount)
+	})
+
+	t.Run("added units out of stock", func(t *testing.T) {
+		env := newPricingEnv(t)
+
+		order, err := env.svc.CreateOrder(env.ctx, env.request(""))
+		require.NoError(t, err)
+
+		_, err = env.svc.UpdateOrder(env.ctx, dto.UpdateOrderRequest{
+			OrderID: order.ID,
+			Items:   []domain.Item{{ProductID: env.book, Quantity: 13}},
+		})
+		assert.ErrorIs(t, err, domain.ErrNotEnoughQuantity)
+		assert.Empty(t, env.orders.changes)
+	})
+
+	t.Run("added product unavailable", func(t *testing.T) {
+		env := newPricingEnv(t)
+		req := env.request("")
+		req.Items = req.Items[:1]
+
+		order, err := env.svc.CreateOrder(env.ctx, req)
+		require.NoError(t, err)
+
+		env.products[env.pen].IsActive = false
+
+		_, err = env.svc.UpdateOrder(env.ctx, dto.UpdateOrderRequest{
+			OrderID: order.ID,
+			Items:   []domain.Item{{ProductID: env.book, Quantity: 3}, {ProductID: env.pen, Quantity: 1}},
+		})
+		assert.ErrorIs(t, err, domain.ErrProductUnavailable)
+		assert.Empty(t, env.orders.changes)
+	})
+
+	t.Run("paid order keeps its lines", func(t *testing.T) {
+		env := newPricingEnv(t)
+
+		order, err := env.svc.CreateOrder(env.ctx, env.request(""))
+		require.NoError(t, err)
+
+		paid := env.orders.orders[order.ID]
+		paid.Status = domain.OrderPaid
+		env.orders.orders[order.ID] = paid
+
+		_, err = env.svc.UpdateOrder(env.ctx, dto.UpdateOrderRequest{
+			OrderID: order.ID,
+			Items:   []domain.Item{{ProductID: env.book, Quantity: 1}},
+		})
+
+		var appErr *domain.AppError
+		require.ErrorAs(t, err, &appErr)
+		assert.ErrorIs(t, err, domain.ErrOrderNotMutable)
+		assert.Equal(t, codes.FailedPrecondition, appErr.GRPCCode())
+		assert.Len(t, env.orders.orders[order.ID].Items, 2)
+
+		method := domain.BankCard.String()
+		_, err = env.svc.UpdateOrder(env.ctx, dto.UpdateOrderRequest{OrderID: order.ID, PaymentMethod: &method})
+		assert.ErrorIs(t, err, domain.ErrOrderNotMutable)
+
+		// Paid order can still be redirected.
+		address := testAddress
+		address.City = "Boston"
+		order, err = env.svc.UpdateOrder(env.ctx, dto.UpdateOrderRequest{OrderID: order.ID, DeliveryAddress: &address})
+		require.NoError(t, err)
+		assert.Equal(t, "Boston", order.DeliveryAddress.City)
+	})
+}
+
+func TestOrderService_UpdateOrder_Version(t *testing.T) {
+	t.Run("stale version is refused", func(t *testing.T) {
+		env := newPricingEnv(t)
+
+		order, err := env.svc.CreateOrder(env.ctx, env.request(""))
+		require.NoError(t, err)
+
+		description := "First"
+		order, err = env.svc.UpdateOrder(env.ctx, dto.UpdateOrderRequest{OrderID: order.ID, Description: &description, Version: &order.Version})
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), order.Version)
+
+		stale := int64(0)
+		description = "Second"
+		_, err = env.svc.UpdateOrder(env.ctx, dto.UpdateOrderRequest{OrderID: order.ID, Description: &description, Version: &stale})
+
+		var appErr *domain.AppError
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, codes.FailedPrecondition, appErr.GRPCCode())
+		assert.Equal(t, "First", env.orders.orders[order.ID].Description)
+	})
+
+	t.Run("concurrent change aborts update", func(t *testing.T) {
+		env := newPricingEnv(t)
+
+		order, err := env.svc.CreateOrder(env.ctx, env.request(""))
+		require.NoError(t, err)
+
+		// Order changes after service read it, but before it is saved.
+		env.svc.repo = racingOrderRepository{memOrderRepository: env.orders}
+
+		description := "Mine"
+		_, err = env.svc.UpdateOrder(env.ctx, dto.UpdateOrderRequest{OrderID: order.ID, Description: &description})
+
+		var appErr *domain.AppError
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, codes.Aborted, appErr.GRPCCode())
+		assert.Equal(t, "Theirs", env.orders.orders[order.ID].Description)
+	})
+}
+
+// racingOrderRepository changes order every time it is read, as if another request saved it right after.
+type racingOrderRepository struct {
+	*memOrderRepository
+}
+
+func (r racingOrderRepository) GetById(ctx context.Context, orderId string) (*domain.Order, error) {
+	order, err := r.memOrderRepository.GetById(ctx, orderId)
+	if err != nil {
+		return nil, err
+	}
+
+	theirs := *order.Clone()
+	theirs.Description = "Theirs"
+	theirs.Version++
+	r.orders[order.ID] = theirs
+
+	return order, nil
+}
@@ -0,0 +1,62 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/dzhordano/ecom-thing/services/order/internal/application/interfaces"
+	"github.com/dzhordano/ecom-thing/services/order/internal/domain"
+	"github.com/dzhordano/ecom-thing/services/order/internal/domain/repository"
+	"github.com/dzhordano/ecom-thing/services/order/pkg/logger"
+)
+
+// How many archived orders are erased in one transaction.
+const retentionBatchSize = 100
+
+type RetentionService struct {
+	log    logger.Logger
+	orders repository.OrderRepository
+	// How long order stays archived before it is erased.
+	period time.Duration
+	// Whether orders are deleted instead of anonymized.
+	purge bool
+	now   func() time.Time
+}
+
+// NewRetentionService returns service erasing orders archived for longer than period. Orders are anonymized,
+// so their amounts still add up in accounting, unless purge is set.
+func NewRetentionService(l logger.Logger, orders repository.OrderRepository, period time.Duration, purge bool) interfaces.OrderRetention {
+	return &RetentionService{
+		log:    l,
+		orders: orders,
+		period: period,
+		purge:  purge,
+		now:    func() time.Time { return time.Now().UTC() },
+	}
+}
+
+// EraseArchived implements interfaces.OrderRetention.
+func (r *RetentionService) EraseArchived(ctx context.Context) (int, error) {
+	erase, action := r.orders.AnonymizeArchived, "anonymize"
+	if r.purge {
+		erase, action = r.orders.PurgeArchived, "purge"
+	}
+
+	deletedBefore := r.now().Add(-r.period)
+	total := 0
+
+	for {
+		erased, err := erase(ctx, deletedBefore, retentionBatchSize)
+		if err != nil {
+			r.log.Error("failed to "+action+" archived orders", "error", err)
+			return total, domain.NewAppError(err, "failed to "+action+" archived orders")
+		}
+
+		total += int(erased)
+
+		// Short batch means no more due orders, except ones other replicas are busy with.
+		if erased < retentionBatchSize {
+			return total, nil
+		}
+	}
+}
@@ -0,0 +1,311 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/dzhordano/ecom-thing/services/order/internal/domain"
+	"github.com/dzhordano/ecom-thing/services/order/pkg/logger"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// archivingOrderRepository archives and erases orders in memory. Orders listed in unsettled
+// have checkout or return in progress and can't be archived.
+type archivingOrderRepository struct {
+	memOrderRepository
+
+	unsettled map[uuid.UUID]bool
+	history   map[uuid.UUID][]*domain.HistoryEntry
+	// Batch sizes erase was called with.
+	batches []uint64
+	err     error
+}
+
+func (r *archivingOrderRepository) Update(ctx context.Context, order *domain.Order, entry *domain.HistoryEntry) error {
+	if err := r.memOrderRepository.Update(ctx, order, entry); err != nil {
+		return err
+	}
+	r.history[order.ID] = append(r.history[order.ID], entry)
+	return nil
+}
+
+func (r *archivingOrderRepository) Delete(ctx context.Context, order *domain.Order, entry *domain.HistoryEntry) error {
+	if r.unsettled[order.ID] {
+		return domain.ErrOrderNotArchivable
+	}
+	return r.Update(ctx, order, entry)
+}
+
+func (r *archivingOrderRepository) AnonymizeArchived(_ context.Context, deletedBefore time.Time, limit uint64) (int64, error) {
+	due, err := r.due(deletedBefore, limit, true)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, o := range due {
+		o.UserID = uuid.Nil
+		o.Description = ""
+		o.AnonymizedAt = deletedBefore
+		r.orders[o.ID] = o
+	}
+	return int64(len(due)), nil
+}
+
+func (r *archivingOrderRepository) PurgeArchived(_ context.Context, deletedBefore time.Time, limit uint64) (int64, error) {
+	due, err := r.due(deletedBefore, limit, false)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, o := range due {
+		delete(r.orders, o.ID)
+		delete(r.history, o.ID)
+	}
+	return int64(len(due)), nil
+}
+
+// due returns up to limit orders archived before given time, oldest first.
+func (r *archivingOrderRepository) due(deletedBefore time.Time, limit uint64, notAnonymized bool) ([]domain.Order, error) {
+	r.batches = append(r.batches, limit)
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	var due []domain.Order
+	for _, o := range r.orders {
+		if o.IsDeleted() && o.DeletedAt.Before(deletedBefore) && (!notAnonymized || o.AnonymizedAt.IsZero()) {
+			due = append(due, o)
+		}
+	}
+	sort.Slice(due, func(i, j int) bool { return due[i].DeletedAt.Before(due[j].DeletedAt) })
+	if uint64(len(due)) > limit {
+		due = due[:limit]
+	}
+
+	return due, nil
+}
+
+type archiveEnv struct {
+	repo *archivingOrderRepository
+	svc  *OrderService
+	now  time.Time
+	dir  string
+
+	owner context.Context
+	other context.Context
+	admin context.Context
+}
+
+func newArchiveEnv(t *testing.T) *archiveEnv {
+	t.Helper()
+
+	principal := func(role domain.Role) context.Context {
+		return domain.ContextWithPrincipal(context.Background(), domain.Principal{UserID: uuid.New(), Roles: []domain.Role{role}})
+	}
+
+	e := &archiveEnv{
+		repo: &archivingOrderRepository{
+			memOrderRepository: memOrderRepository{orders: map[uuid.UUID]domain.Order{}},
+			unsettled:          map[uuid.UUID]bool{},
+			history:            map[uuid.UUID][]*domain.HistoryEntry{},
+		},
+		now:   time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC),
+		dir:   t.TempDir(),
+		owner: principal(domain.RoleUser),
+		other: principal(domain.RoleUser),
+		admin: principal(domain.RoleAdmin),
+	}
+
+	log := logger.MustInit(logger.LevelError, filepath.Join(e.dir, "archive-test.log"), "json", false)
+	e.svc = NewOrderService(log, nil, nil, nil, nil, e.repo, nil, nopCheckout{}, nil, time.Hour, time.Minute).(*OrderService)
+	e.svc.now = func() time.Time { return e.now }
+
+	return e
+}
+
+// addOrder stores order of owner, archived age ago unless age is zero.
+func (e *archiveEnv) addOrder(status domain.Status, age time.Duration) uuid.UUID {
+	p, _ := domain.PrincipalFromContext(e.owner)
+
+	o := domain.Order{
+		ID:          uuid.New(),
+		UserID:      p.UserID,
+		Description: "leave at the door",
+		Status:      status,
+		Items:       domain.Items{{ProductID: uuid.New(), Quantity: 1}},
+	}
+	if age != 0 {
+		o.DeletedAt = e.now.Add(-age)
+		o.DeletedBy = p.UserID
+	}
+	e.repo.orders[o.ID] = o
+	return o.ID
+}
+
+// retention returns service erasing orders of env archived longer than period.
+func (e *archiveEnv) retention(period time.Duration, purge bool) *RetentionService {
+	log := logger.MustInit(logger.LevelError, filepath.Join(e.dir, "retention-test.log"), "json", false)
+	svc := NewRetentionService(log, e.repo, period, purge).(*RetentionService)
+	svc.now = func() time.Time { return e.now }
+	return svc
+}
+
+func TestOrderService_DeleteOrder(t *testing.T) {
+	t.Run("archives completed order", func(t *testing.T) {
+		env := newArchiveEnv(t)
+		id := env.addOrder(domain.OrderCompleted, 0)
+
+		require.NoError(t, env.svc.DeleteOrder(env.owner, id))
+
+		p, _ := domain.PrincipalFromContext(env.owner)
+		got := env.repo.orders[id]
+		assert.Equal(t, env.now, got.DeletedAt)
+		assert.Equal(t, p.UserID, got.DeletedBy)
+
+		require.Len(t, env.repo.history[id], 1)
+		assert.Equal(t, domain.ChangeDeleted, env.repo.history[id][0].Type)
+
+		// Owner no longer sees it, staff does.
+		_, err := env.svc.GetById(env.owner, id)
+		assert.ErrorIs(t, err, domain.ErrOrderNotFound)
+
+		_, err = env.svc.GetById(env.admin, id)
+		assert.NoError(t, err)
+
+		err = env.svc.DeleteOrder(env.admin, id)
+		assert.ErrorIs(t, err, domain.ErrOrderNotArchivable)
+	})
+
+	t.Run("refuses open order", func(t *testing.T) {
+		env := newArchiveEnv(t)
+		id := env.addOrder(domain.OrderPaid, 0)
+
+		err := env.svc.DeleteOrder(env.owner, id)
+		assert.ErrorIs(t, err, domain.ErrOrderNotArchivable)
+		assert.True(t, env.repo.orders[id].DeletedAt.IsZero())
+	})
+
+	t.Run("refuses unsettled order", func(t *testing.T) {
+		env := newArchiveEnv(t)
+		id := env.addOrder(domain.OrderCancelled, 0)
+		env.repo.unsettled[id] = true
+
+		err := env.svc.DeleteOrder(env.owner, id)
+		assert.ErrorIs(t, err, domain.ErrOrderNotArchivable)
+		assert.True(t, env.repo.orders[id].DeletedAt.IsZero())
+	})
+
+	t.Run("other user", func(t *testing.T) {
+		env := newArchiveEnv(t)
+		id := env.addOrder(domain.OrderCompleted, 0)
+
+		err := env.svc.DeleteOrder(env.other, id)
+		assert.ErrorIs(t, err, domain.ErrPermissionDenied)
+	})
+}
+
+func TestOrderService_RestoreOrder(t *testing.T) {
+	t.Run("restores archived order", func(t *testing.T) {
+		env := newArchiveEnv(t)
+		id := env.addOrder(domain.OrderCompleted, time.Hour)
+
+		got, err := env.svc.RestoreOrder(env.admin, id)
+		require.NoError(t, err)
+		assert.False(t, got.IsDeleted())
+		assert.Equal(t, uuid.Nil, got.DeletedBy)
+
+		require.Len(t, env.repo.history[id], 1)
+		assert.Equal(t, domain.ChangeRestored, env.repo.history[id][0].Type)
+
+		_, err = env.svc.GetById(env.owner, id)
+		assert.NoError(t, err)
+	})
+
+	t.Run("refuses order that is not archived", func(t *testing.T) {
+		env := newArchiveEnv(t)
+		id := env.addOrder(domain.OrderCompleted, 0)
+
+		_, err := env.svc.RestoreOrder(env.admin, id)
+		assert.ErrorIs(t, err, domain.ErrOrderNotRestorable)
+	})
+
+	t.Run("refuses anonymized order", func(t *testing.T) {
+		env := newArchiveEnv(t)
+		id := env.addOrder(domain.OrderCompleted, time.Hour)
+		o := env.repo.orders[id]
+		o.AnonymizedAt = env.now
+		env.repo.orders[id] = o
+
+		_, err := env.svc.RestoreOrder(env.admin, id)
+		assert.ErrorIs(t, err, domain.ErrOrderNotRestorable)
+	})
+}
+
+func TestRetentionService_EraseArchived(t *testing.T) {
+	ctx := context.Background()
+	period := 30 * 24 * time.Hour
+
+	t.Run("anonymizes orders archived longer than period", func(t *testing.T) {
+		env := newArchiveEnv(t)
+
+		due := env.addOrder(domain.OrderCompleted, period+time.Hour)
+		recent := env.addOrder(domain.OrderCompleted, time.Hour)
+		active := env.addOrder(domain.OrderCompleted, 0)
+
+		n, err := env.retention(period, false).EraseArchived(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 1, n)
+
+		assert.Equal(t, uuid.Nil, env.repo.orders[due].UserID)
+		assert.Empty(t, env.repo.orders[due].Description)
+		assert.NotEqual(t, uuid.Nil, env.repo.orders[recent].UserID)
+		assert.NotEqual(t, uuid.Nil, env.repo.orders[active].UserID)
+
+		// Anonymized orders are not erased again.
+		n, err = env.retention(period, false).EraseArchived(ctx)
+		require.NoError(t, err)
+		assert.Zero(t, n)
+	})
+
+	t.Run("purges orders", func(t *testing.T) {
+		env := newArchiveEnv(t)
+
+		due := env.addOrder(domain.OrderCompleted, period+time.Hour)
+		recent := env.addOrder(domain.OrderCompleted, time.Hour)
+
+		n, err := env.retention(period, true).EraseArchived(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 1, n)
+
+		assert.NotContains(t, env.repo.orders, due)
+		assert.Contains(t, env.repo.orders, recent)
+	})
+
+	t.Run("erases backlog in batches", func(t *testing.T) {
+		env := newArchiveEnv(t)
+
+		for i := range retentionBatchSize*2 + 5 {
+			env.addOrder(domain.OrderCompleted, period+time.Duration(i+1)*time.Second)
+		}
+
+		n, err := env.retention(period, true).EraseArchived(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, retentionBatchSize*2+5, n)
+		assert.Empty(t, env.repo.orders)
+		assert.Len(t, env.repo.batches, 3)
+	})
+
+	t.Run("repository error", func(t *testing.T) {
+		env := newArchiveEnv(t)
+		env.repo.err = errors.New("connection refused")
+
+		_, err := env.retention(period, false).EraseArchived(ctx)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,136 @@
+package service
+
+import (
+	"context"
+
+	"github.com/dzhordano/ecom-thing/services/order/internal/application/dto"
+	"github.com/dzhordano/ecom-thing/services/order/internal/application/interfaces"
+	"github.com/dzhordano/ecom-thing/services/order/internal/domain"
+	"github.com/dzhordano/ecom-thing/services/order/internal/domain/repository"
+	"github.com/dzhordano/ecom-thing/services/order/pkg/logger"
+	"github.com/google/uuid"
+)
+
+type ReturnService struct {
+	log     logger.Logger
+	orders  repository.OrderRepository
+	returns repository.ReturnRepository
+}
+
+func NewReturnService(l logger.Logger, orders repository.OrderRepository, returns repository.ReturnRepository) interfaces.ReturnService {
+	return &ReturnService{
+		log:     l,
+		orders:  orders,
+		returns: returns,
+	}
+}
+
+// RequestReturn implements interfaces.ReturnService.
+func (s *ReturnService) RequestReturn(ctx context.Context, info dto.RequestReturnRequest) (*domain.Return, error) {
+	p, err := principalFromCtx(ctx)
+	if err != nil {
+		s.log.Error("failed to request return", "error", err, "order_id", info.OrderID.String())
+		return nil, err
+	}
+
+	ret, err := s.returns.Create(ctx, info.OrderID.String(), func(order *domain.Order, returns []*domain.Return) (*domain.Return, error) {
+		if !p.CanAccess(order.UserID) {
+			return nil, domain.NewAppError(domain.ErrPermissionDenied, "order belongs to another user")
+		}
+
+		ret, err := domain.NewReturn(order, returns, info.Items, info.Reason)
+		if err != nil {
+			return nil, domain.NewAppError(err, err.Error())
+		}
+
+		return ret, nil
+	})
+	if err != nil {
+		s.log.Error("failed to request return", "error", err, "order_id", info.OrderID.String())
+		return nil, callbackError(err, "failed to request return")
+	}
+
+	s.log.Debug("return requested", "return_id", ret.ID.String(), "order_id", ret.OrderID.String(),
+		"total", ret.Total().String())
+
+	return ret, nil
+}
+
+// GetReturn implements interfaces.ReturnService.
+func (s *ReturnService) GetReturn(ctx context.Context, returnId uuid.UUID) (*domain.Return, error) {
+	p, err := principalFromCtx(ctx)
+	if err != nil {
+		s.log.Error("failed to get return", "error", err, "return_id", returnId.String())
+		return nil, err
+	}
+
+	ret, err := s.returns.GetById(ctx, returnId.String())
+	if err != nil {
+		s.log.Error("failed to get return", "error", err, "return_id", returnId.String())
+		return nil, domain.NewAppError(err, "failed to get return")
+	}
+
+	if !p.CanAccess(ret.UserID) {
+		s.log.Error("failed to get return", "error", domain.ErrPermissionDenied, "return_id", returnId.String())
+		return nil, domain.NewAppError(domain.ErrPermissionDenied, "return belongs to another user")
+	}
+
+	return ret, nil
+}
+
+// ListOrderReturns implements interfaces.ReturnService.
+func (s *ReturnService) ListOrderReturns(ctx context.Context, orderId uuid.UUID) ([]*domain.Return, error) {
+	p, err := principalFromCtx(ctx)
+	if err != nil {
+		s.log.Error("failed to list returns", "error", err, "order_id", orderId.String())
+		return nil, err
+	}
+
+	order, err := s.orders.GetById(ctx, orderId.String())
+	if err != nil {
+		s.log.Error("failed to list returns", "error", err, "order_id", orderId.String())
+		return nil, domain.NewAppError(err, "failed to get order")
+	}
+
+	if !p.CanAccess(order.UserID) {
+		s.log.Error("failed to list returns", "error", domain.ErrPermissionDenied, "order_id", orderId.String())
+		return nil, domain.NewAppError(domain.ErrPermissionDenied, "order belongs to another user")
+	}
+
+	if order.IsDeleted() && !p.IsPrivileged() {
+		s.log.Error("failed to list returns", "error", domain.ErrOrderNotFound, "order_id", orderId.String())
+		return nil, domain.NewAppError(domain.ErrOrderNotFound, "failed to get order")
+	}
+
+	returns, err := s.returns.ListByOrder(ctx, orderId.String())
+	if err != nil {
+		s.log.Error("failed to list returns", "error", err, "order_id", orderId.String())
+		return nil, domain.NewAppError(err, "failed to list returns")
+	}
+
+	return returns, nil
+}
+
+// UpdateReturnStatus implements interfaces.ReturnService. Access is restricted to staff by policy.
+func (s *ReturnService) UpdateReturnStatus(ctx context.Context, returnId uuid.UUID, status string) (*domain.Return, error) {
+	next, err := domain.NewReturnStatus(status)
+	if err != nil {
+		s.log.Error("failed to update return status", "error", err, "return_id", returnId.String())
+		return nil, domain.NewAppError(err, err.Error())
+	}
+
+	ret, err := s.returns.Update(ctx, returnId.String(), func(r *domain.Return) error {
+		if err := r.TransitionTo(next); err != nil {
+			return domain.NewAppError(err, err.Error())
+		}
+		return nil
+	})
+	if err != nil {
+		s.log.Error("failed to update return status", "error", err, "return_id", returnId.String())
+		return nil, callbackError(err, "failed to update return status")
+	}
+
+	s.log.Debug("return status changed", "return_id", ret.ID.String(), "status", ret.Status.String())
+
+	return ret, nil
+}
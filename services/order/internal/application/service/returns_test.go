@@ -0,0 +1,337 @@
+package service
+
+import (
+	"context"
+	"path/filepath"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/dzhordano/ecom-thing/services/order/internal/application/dto"
+	"github.com/dzhordano/ecom-thing/services/order/internal/domain"
+	"github.com/dzhordano/ecom-thing/services/order/internal/domain/repository"
+	"github.com/dzhordano/ecom-thing/services/order/pkg/logger"
+	"github.com/dzhordano/ecom-thing/services/order/pkg/money"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// adjustingOrderRepository applies callbacks to copies and keeps results only if they succeed, like transaction would.
+// Events are recorded instead of being written to outbox.
+type adjustingOrderRepository struct {
+	memOrderRepository
+
+	events []string
+}
+
+func (r *adjustingOrderRepository) CancelItems(ctx context.Context, orderId string, cancel repository.CancelItemsFunc) (*domain.Order, error) {
+	order, err := r.GetById(ctx, orderId)
+	if err != nil {
+		return nil, err
+	}
+
+	c, _, err := cancel(order)
+	if err != nil {
+		return nil, err
+	}
+
+	r.orders[order.ID] = *order.Clone()
+	r.events = append(r.events, "items-released:"+c.ID.String())
+	if c.Paid {
+		r.events = append(r.events, "refund-requested:"+c.Total().String())
+	}
+
+	return order, nil
+}
+
+type memReturnRepository struct {
+	orders  *adjustingOrderRepository
+	returns map[uuid.UUID]domain.Return
+}
+
+func (r *memReturnRepository) Create(ctx context.Context, orderId string, create repository.CreateReturnFunc) (*domain.Return, error) {
+	order, err := r.orders.GetById(ctx, orderId)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := r.ListByOrder(ctx, orderId)
+	if err != nil {
+		return nil, err
+	}
+
+	ret, err := create(order, existing)
+	if err != nil {
+		return nil, err
+	}
+
+	r.returns[ret.ID] = *ret
+	return ret, nil
+}
+
+func (r *memReturnRepository) GetById(_ context.Context, returnId string) (*domain.Return, error) {
+	ret, ok := r.returns[uuid.MustParse(returnId)]
+	if !ok {
+		return nil, domain.ErrReturnNotFound
+	}
+	return &ret, nil
+}
+
+func (r *memReturnRepository) ListByOrder(_ context.Context, orderId string) ([]*domain.Return, error) {
+	var returns []*domain.Return
+	for _, ret := range r.returns {
+		if ret.OrderID.String() == orderId {
+			returns = append(returns, &ret)
+		}
+	}
+	sort.Slice(returns, func(i, j int) bool { return returns[i].CreatedAt.Before(returns[j].CreatedAt) })
+	return returns, nil
+}
+
+func (r *memReturnRepository) Update(ctx context.Context, returnId string, update func(r *domain.Return) error) (*domain.Return, error) {
+	ret, err := r.GetById(ctx, returnId)
+	if err != nil {
+		return nil, err
+	}
+
+	before := ret.Status
+	if err := update(ret); err != nil {
+		return nil, err
+	}
+
+	if before != ret.Status {
+		switch ret.Status {
+		case domain.ReturnReceived:
+			r.orders.events = append(r.orders.events, "items-returned:"+ret.ID.String())
+		case domain.ReturnRefunded:
+			r.orders.events = append(r.orders.events, "refund-requested:"+ret.Total().String())
+		}
+	}
+
+	r.returns[ret.ID] = *ret
+	return ret, nil
+}
+
+type adjustmentEnv struct {
+	orders  *adjustingOrderRepository
+	returns *memReturnRepository
+	svc     *OrderService
+	rs      *ReturnService
+
+	owner context.Context
+	other context.Context
+	admin context.Context
+}
+
+func newAdjustmentEnv(t *testing.T) *adjustmentEnv {
+	t.Helper()
+
+	principal := func(role domain.Role) context.Context {
+		return domain.ContextWithPrincipal(context.Background(), domain.Principal{UserID: uuid.New(), Roles: []domain.Role{role}})
+	}
+
+	e := &adjustmentEnv{
+		orders: &adjustingOrderRepository{memOrderRepository: memOrderRepository{orders: map[uuid.UUID]domain.Order{}}},
+		owner:  principal(domain.RoleUser),
+		other:  principal(domain.RoleUser),
+		admin:  principal(domain.RoleAdmin),
+	}
+	e.returns = &memReturnRepository{orders: e.orders, returns: map[uuid.UUID]domain.Return{}}
+
+	log := logger.MustInit(logger.LevelError, filepath.Join(t.TempDir(), "adjustment-test.log"), "json", false)
+	e.svc = NewOrderService(log, nil, nil, nil, nil, e.orders, nil, nopCheckout{}, nil, time.Hour, time.Minute).(*OrderService)
+	e.rs = NewReturnService(log, e.orders, e.returns).(*ReturnService)
+
+	return e
+}
+
+// addOrder stores order of owner with two books and a pen.
+func (e *adjustmentEnv) addOrder(status domain.Status) *domain.Order {
+	p, _ := domain.PrincipalFromContext(e.owner)
+
+	o := domain.Order{
+		ID:       uuid.New(),
+		UserID:   p.UserID,
+		Status:   status,
+		Currency: domain.USD,
+		Items: domain.Items{
+			{ProductID: uuid.New(), Quantity: 2, UnitPrice: money.New(1000, "USD"), Discount: money.New(0, "USD")},
+			{ProductID: uuid.New(), Quantity: 1, UnitPrice: money.New(200, "USD"), Discount: money.New(0, "USD")},
+		},
+	}
+	e.orders.orders[o.ID] = o
+
+	return &o
+}
+
+func TestOrderService_CancelOrderItems(t *testing.T) {
+	t.Run("cancels items and requests refund", func(t *testing.T) {
+		env := newAdjustmentEnv(t)
+		o := env.addOrder(domain.OrderPaid)
+
+		got, err := env.svc.CancelOrderItems(env.owner, o.ID, []domain.Item{{ProductID: o.Items[0].ProductID, Quantity: 1}})
+		require.NoError(t, err)
+
+		assert.Equal(t, money.New(1200, "USD"), got.TotalPrice())
+		assert.Equal(t, uint64(1), env.orders.orders[o.ID].Items[0].Quantity)
+		require.Len(t, env.orders.events, 2)
+		assert.Equal(t, "refund-requested:"+money.New(1000, "USD").String(), env.orders.events[1])
+	})
+
+	t.Run("pending order only releases stock", func(t *testing.T) {
+		env := newAdjustmentEnv(t)
+		o := env.addOrder(domain.OrderPending)
+
+		got, err := env.svc.CancelOrderItems(env.owner, o.ID, []domain.Item{{ProductID: o.Items[0].ProductID, Quantity: 1}})
+		require.NoError(t, err)
+
+		assert.Equal(t, money.New(1200, "USD"), got.TotalPrice())
+		assert.Equal(t, uint64(1), env.orders.orders[o.ID].Items[0].Quantity)
+		require.Len(t, env.orders.events, 1)
+		assert.Contains(t, env.orders.events[0], "items-released:")
+	})
+
+	t.Run("other user", func(t *testing.T) {
+		env := newAdjustmentEnv(t)
+		o := env.addOrder(domain.OrderPaid)
+
+		_, err := env.svc.CancelOrderItems(env.other, o.ID, []domain.Item{{ProductID: o.Items[0].ProductID, Quantity: 1}})
+		assert.ErrorIs(t, err, domain.ErrPermissionDenied)
+		assert.Empty(t, env.orders.events)
+	})
+
+	t.Run("shipped order", func(t *testing.T) {
+		env := newAdjustmentEnv(t)
+		o := env.addOrder(domain.OrderShipped)
+
+		_, err := env.svc.CancelOrderItems(env.owner, o.ID, []domain.Item{{ProductID: o.Items[0].ProductID, Quantity: 1}})
+		assert.ErrorIs(t, err, domain.ErrItemsNotCancellable)
+		assert.Equal(t, uint64(2), env.orders.orders[o.ID].Items[0].Quantity)
+	})
+}
+
+// cancelCheckout records orders aborted and cancelled through checkout.
+type cancelCheckout struct {
+	nopCheckout
+
+	aborted []uuid.UUID
+	paid    []uuid.UUID
+}
+
+func (c *cancelCheckout) Abort(_ context.Context, orderId uuid.UUID, _ string) error {
+	c.aborted = append(c.aborted, orderId)
+	return nil
+}
+
+func (c *cancelCheckout) CancelPaid(_ context.Context, orderId uuid.UUID) error {
+	c.paid = append(c.paid, orderId)
+	return nil
+}
+
+func TestOrderService_CancelOrder(t *testing.T) {
+	t.Run("pending order", func(t *testing.T) {
+		env := newAdjustmentEnv(t)
+		checkout := &cancelCheckout{}
+		env.svc.checkout = checkout
+		o := env.addOrder(domain.OrderPending)
+
+		require.NoError(t, env.svc.CancelOrder(env.owner, o.ID))
+		assert.Equal(t, domain.OrderCancelled, env.orders.orders[o.ID].Status)
+		assert.Equal(t, []uuid.UUID{o.ID}, checkout.aborted)
+		assert.Empty(t, checkout.paid)
+	})
+
+	t.Run("paid order is cancelled by checkout", func(t *testing.T) {
+		env := newAdjustmentEnv(t)
+		checkout := &cancelCheckout{}
+		env.svc.checkout = checkout
+		o := env.addOrder(domain.OrderPaid)
+
+		require.NoError(t, env.svc.CancelOrder(env.owner, o.ID))
+		// Checkout cancels order along with refunding it.
+		assert.Equal(t, domain.OrderPaid, env.orders.orders[o.ID].Status)
+		assert.Equal(t, []uuid.UUID{o.ID}, checkout.paid)
+		assert.Empty(t, checkout.aborted)
+	})
+
+	t.Run("other user", func(t *testing.T) {
+		env := newAdjustmentEnv(t)
+		checkout := &cancelCheckout{}
+		env.svc.checkout = checkout
+		o := env.addOrder(domain.OrderPaid)
+
+		assert.ErrorIs(t, env.svc.CancelOrder(env.other, o.ID), domain.ErrPermissionDenied)
+		assert.Empty(t, checkout.paid)
+	})
+}
+
+func TestReturnService(t *testing.T) {
+	t.Run("return lifecycle", func(t *testing.T) {
+		env := newAdjustmentEnv(t)
+		o := env.addOrder(domain.OrderDelivered)
+
+		ret, err := env.rs.RequestReturn(env.owner, dto.RequestReturnRequest{
+			OrderID: o.ID,
+			Items:   []domain.Item{{ProductID: o.Items[1].ProductID, Quantity: 1}},
+			Reason:  "broken",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, money.New(200, "USD"), ret.Total())
+
+		for _, status := range []domain.ReturnStatus{domain.ReturnApproved, domain.ReturnReceived, domain.ReturnRefunded} {
+			ret, err = env.rs.UpdateReturnStatus(env.admin, ret.ID, status.String())
+			require.NoError(t, err)
+			assert.Equal(t, status, ret.Status)
+		}
+
+		assert.Equal(t, []string{
+			"items-returned:" + ret.ID.String(),
+			"refund-requested:" + money.New(200, "USD").String(),
+		}, env.orders.events)
+
+		_, err = env.rs.UpdateReturnStatus(env.admin, ret.ID, domain.ReturnRejected.String())
+		assert.ErrorIs(t, err, domain.ErrInvalidReturnTransition)
+
+		// Refunded line can't be returned again.
+		_, err = env.rs.RequestReturn(env.owner, dto.RequestReturnRequest{
+			OrderID: o.ID,
+			Items:   []domain.Item{{ProductID: o.Items[1].ProductID, Quantity: 1}},
+		})
+		assert.ErrorIs(t, err, domain.ErrInvalidArgument)
+	})
+
+	t.Run("access", func(t *testing.T) {
+		env := newAdjustmentEnv(t)
+		o := env.addOrder(domain.OrderDelivered)
+
+		_, err := env.rs.RequestReturn(env.other, dto.RequestReturnRequest{
+			OrderID: o.ID,
+			Items:   []domain.Item{{ProductID: o.Items[0].ProductID, Quantity: 1}},
+		})
+		assert.ErrorIs(t, err, domain.ErrPermissionDenied)
+
+		ret, err := env.rs.RequestReturn(env.owner, dto.RequestReturnRequest{
+			OrderID: o.ID,
+			Items:   []domain.Item{{ProductID: o.Items[0].ProductID, Quantity: 1}},
+		})
+		require.NoError(t, err)
+
+		_, err = env.rs.GetReturn(env.other, ret.ID)
+		assert.ErrorIs(t, err, domain.ErrPermissionDenied)
+
+		_, err = env.rs.ListOrderReturns(env.other, o.ID)
+		assert.ErrorIs(t, err, domain.ErrPermissionDenied)
+
+		returns, err := env.rs.ListOrderReturns(env.admin, o.ID)
+		require.NoError(t, err)
+		assert.Len(t, returns, 1)
+	})
+
+	t.Run("unknown status", func(t *testing.T) {
+		env := newAdjustmentEnv(t)
+
+		_, err := env.rs.UpdateReturnStatus(env.admin, uuid.New(), "lost")
+		assert.ErrorIs(t, err, domain.ErrInvalidReturnStatus)
+	})
+}
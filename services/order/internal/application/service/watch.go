@@ -0,0 +1,123 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/dzhordano/ecom-thing/services/order/internal/application/interfaces"
+	"github.com/dzhordano/ecom-thing/services/order/internal/domain"
+	"github.com/dzhordano/ecom-thing/services/order/internal/domain/repository"
+	"github.com/dzhordano/ecom-thing/services/order/pkg/logger"
+	"github.com/google/uuid"
+)
+
+type WatchService struct {
+	log     logger.Logger
+	orders  repository.OrderRepository
+	updates interfaces.OrderBroadcaster
+	// How often watched order is re-read. Broadcaster only knows changes made by this replica.
+	resync time.Duration
+}
+
+func NewWatchService(l logger.Logger, orders repository.OrderRepository, updates interfaces.OrderBroadcaster, resync time.Duration) interfaces.OrderWatcher {
+	return &WatchService{
+		log:     l,
+		orders:  orders,
+		updates: updates,
+		resync:  resync,
+	}
+}
+
+// WatchOrder implements interfaces.OrderWatcher.
+//
+// Updates older than the last sent one are dropped, so client never sees order going back in time.
+func (w *WatchService) WatchOrder(ctx context.Context, orderId uuid.UUID) (<-chan *domain.Order, error) {
+	p, err := principalFromCtx(ctx)
+	if err != nil {
+		w.log.Error("failed to watch order", "error", err, "order_id", orderId.String())
+		return nil, err
+	}
+
+	// Subscribing before reading order ensures no change made in between is missed.
+	updates, unsubscribe := w.updates.Subscribe(orderId)
+
+	order, err := w.orders.GetById(ctx, orderId.String())
+	if err != nil {
+		unsubscribe()
+		w.log.Error("failed to watch order", "error", err, "order_id", orderId.String())
+		return nil, domain.NewAppError(err, "failed to get order")
+	}
+
+	if !p.CanAccess(order.UserID) {
+		unsubscribe()
+		w.log.Error("failed to watch order", "error", domain.ErrPermissionDenied, "order_id", orderId.String())
+		return nil, domain.NewAppError(domain.ErrPermissionDenied, "order belongs to another user")
+	}
+
+	if order.IsDeleted() && !p.IsPrivileged() {
+		unsubscribe()
+		w.log.Error("failed to watch order", "error", domain.ErrOrderNotFound, "order_id", orderId.String())
+		return nil, domain.NewAppError(domain.ErrOrderNotFound, "failed to get order")
+	}
+
+	out := make(chan *domain.Order)
+	go func() {
+		defer close(out)
+		defer unsubscribe()
+
+		w.stream(ctx, order, updates, out)
+	}()
+
+	return out, nil
+}
+
+func (w *WatchService) stream(ctx context.Context, last *domain.Order, updates <-chan *domain.Order, out chan<- *domain.Order) {
+	send := func(order *domain.Order) bool {
+		select {
+		case out <- order:
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}
+
+	if !send(last) {
+		return
+	}
+
+	ticker := time.NewTicker(w.resync)
+	defer ticker.Stop()
+
+	for {
+		var order *domain.Order
+		select {
+		case <-ctx.Done():
+			return
+		case u, ok := <-updates:
+			if !ok {
+				return
+			}
+			order = u
+		case <-ticker.C:
+			o, err := w.orders.GetById(ctx, last.ID.String())
+			if errors.Is(err, domain.ErrOrderNotFound) {
+				return
+			}
+			if err != nil {
+				w.log.Error("failed to resync watched order", "error", err, "order_id", last.ID.String())
+				continue
+			}
+			order = o
+		}
+
+		if !order.UpdatedAt.After(last.UpdatedAt) {
+			continue
+		}
+
+		last = order
+		if !send(order) {
+			return
+		}
+	}
+}
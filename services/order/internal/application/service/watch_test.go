@@ -0,0 +1,154 @@
+package service
+
+import (
+	"context"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/dzhordano/ecom-thing/services/order/internal/domain"
+	"github.com/dzhordano/ecom-thing/services/order/internal/infrastructure/broadcast"
+	"github.com/dzhordano/ecom-thing/services/order/pkg/logger"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// syncOrderRepository guards memOrderRepository, watch resyncs read it from its own goroutine.
+type syncOrderRepository struct {
+	mu sync.Mutex
+	memOrderRepository
+}
+
+func (r *syncOrderRepository) GetById(ctx context.Context, orderId string) (*domain.Order, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.memOrderRepository.GetById(ctx, orderId)
+}
+
+func (r *syncOrderRepository) Update(ctx context.Context, order *domain.Order, entry *domain.HistoryEntry) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.memOrderRepository.Update(ctx, order, entry)
+}
+
+func (r *syncOrderRepository) remove(id uuid.UUID) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.orders, id)
+}
+
+func TestWatchService_WatchOrder(t *testing.T) {
+	owner := domain.Principal{UserID: uuid.New(), Roles: []domain.Role{domain.RoleUser}}
+	ownerCtx := domain.ContextWithPrincipal(context.Background(), owner)
+
+	setup := func(t *testing.T, resync time.Duration) (*syncOrderRepository, *broadcast.Broadcaster, *WatchService, *domain.Order) {
+		t.Helper()
+
+		order := domain.Order{
+			ID:        uuid.New(),
+			UserID:    owner.UserID,
+			Status:    domain.OrderPending,
+			UpdatedAt: time.Now().UTC(),
+		}
+		orders := &syncOrderRepository{memOrderRepository: memOrderRepository{orders: map[uuid.UUID]domain.Order{order.ID: order}}}
+
+		b := broadcast.NewBroadcaster()
+		log := logger.MustInit(logger.LevelError, filepath.Join(t.TempDir(), "watch-test.log"), "json", false)
+		svc := NewWatchService(log, orders, b, resync).(*WatchService)
+
+		return orders, b, svc, &order
+	}
+
+	next := func(t *testing.T, updates <-chan *domain.Order) *domain.Order {
+		t.Helper()
+		select {
+		case o, ok := <-updates:
+			require.True(t, ok, "watch ended")
+			return o
+		case <-time.After(time.Second):
+			t.Fatal("no update")
+			return nil
+		}
+	}
+
+	ended := func(t *testing.T, updates <-chan *domain.Order) {
+		t.Helper()
+		select {
+		case _, ok := <-updates:
+			assert.False(t, ok, "watch not ended")
+		case <-time.After(time.Second):
+			t.Fatal("watch not ended")
+		}
+	}
+
+	t.Run("sends current order and its changes", func(t *testing.T) {
+		orders, b, svc, order := setup(t, time.Hour)
+		repo := broadcast.NewOrderRepository(orders, b)
+
+		ctx, cancel := context.WithCancel(ownerCtx)
+		updates, err := svc.WatchOrder(ctx, order.ID)
+		require.NoError(t, err)
+
+		assert.Equal(t, domain.OrderPending, next(t, updates).Status)
+
+		paid := order.Clone()
+		require.NoError(t, paid.MarkPaid())
+		require.NoError(t, repo.Update(ctx, paid, nil))
+		assert.Equal(t, domain.OrderPaid, next(t, updates).Status)
+
+		// Update older than what client already has is dropped.
+		b.Publish(order)
+		shipped := paid.Clone()
+		require.NoError(t, shipped.Ship())
+		require.NoError(t, repo.Update(ctx, shipped, nil))
+		assert.Equal(t, domain.OrderShipped, next(t, updates).Status)
+
+		cancel()
+		ended(t, updates)
+	})
+
+	t.Run("resyncs changes made elsewhere", func(t *testing.T) {
+		orders, _, svc, order := setup(t, 10*time.Millisecond)
+
+		updates, err := svc.WatchOrder(ownerCtx, order.ID)
+		require.NoError(t, err)
+		next(t, updates)
+
+		// Saved by another replica, broadcaster of this one doesn't know.
+		paid := order.Clone()
+		require.NoError(t, paid.MarkPaid())
+		require.NoError(t, orders.Update(context.Background(), paid, nil))
+		assert.Equal(t, domain.OrderPaid, next(t, updates).Status)
+
+		orders.remove(order.ID)
+		ended(t, updates)
+	})
+
+	t.Run("ends on shutdown", func(t *testing.T) {
+		_, b, svc, order := setup(t, time.Hour)
+
+		updates, err := svc.WatchOrder(ownerCtx, order.ID)
+		require.NoError(t, err)
+		next(t, updates)
+
+		b.Close()
+		ended(t, updates)
+	})
+
+	t.Run("other user", func(t *testing.T) {
+		_, _, svc, order := setup(t, time.Hour)
+
+		other := domain.ContextWithPrincipal(context.Background(), domain.Principal{UserID: uuid.New(), Roles: []domain.Role{domain.RoleUser}})
+		_, err := svc.WatchOrder(other, order.ID)
+		assert.ErrorIs(t, err, domain.ErrPermissionDenied)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		_, _, svc, _ := setup(t, time.Hour)
+
+		_, err := svc.WatchOrder(ownerCtx, uuid.New())
+		assert.ErrorIs(t, err, domain.ErrOrderNotFound)
+	})
+}
@@ -1,6 +1,7 @@
 package config
 
 import (
+	"errors"
 	"log"
 	"net"
 	"time"
@@ -15,11 +16,18 @@ type Config struct {
 	GRPC             GRPCServiceConfig   `env-prefix:"GRPC_"`
 	GRPCProduct      GRPCProductConfig   `env-prefix:"GRPC_PRODUCT_"`
 	GRPCInventory    GRPCInventoryConfig `env-prefix:"GRPC_INVENTORY_"`
+	ProductCache     ProductCacheConfig
 	PG               PostgresConfig
 	RateLimiter      RateLimiterConfig
 	CircuitBreaker   CircuitBreakerConfig
 	Kafka            KafkaConfig
 	Tracing          TracingConfig
+	Auth             AuthConfig
+	Checkout         CheckoutConfig
+	Idempotency      IdempotencyConfig
+	Expiry           ExpiryConfig
+	Retention        RetentionConfig
+	Watch            WatchConfig
 	ProfilingEnabled bool `env:"PROFILING_ENABLED" env-default:"false"`
 }
 
@@ -47,6 +55,18 @@ type GRPCProductConfig struct {
 	GRPCServiceConfig
 }
 
+// ProductCacheConfig describes how products looked up for pricing are cached.
+type ProductCacheConfig struct {
+	// Most products kept, least recently used ones are evicted first. Zero disables cache.
+	Size int `env:"PRODUCT_CACHE_SIZE" env-default:"10000"`
+	// How long product is served from cache before it's looked up again.
+	TTL time.Duration `env:"PRODUCT_CACHE_TTL" env-default:"1m"`
+	// How long past TTL product may still be served while product service is unavailable.
+	MaxStale time.Duration `env:"PRODUCT_CACHE_MAX_STALE" env-default:"15m"`
+	// Topic product changes are consumed from. Every replica reads all of them to invalidate its own cache.
+	Topic string `env:"PRODUCT_CACHE_TOPIC" env-default:"product-events"`
+}
+
 type GRPCInventoryConfig struct {
 	GRPCServiceConfig
 }
@@ -85,7 +105,7 @@ type KafkaConfig struct {
 	// The group id to use when consuming messages.
 	GroupID string `env:"KAFKA_GROUP_ID" env-default:"order-service"`
 	// Topics to consume messages from.
-	TopicsToConsume []string `env:"KAFKA_TOPICS_CONSUME" env-default:"payment-events"`
+	TopicsToConsume []string `env:"KAFKA_TOPICS_CONSUME" env-default:"payment-events,inventory-events"`
 	// Topics to produce messages to.
 	TopicsToProduce []string `env:"KAFKA_TOPICS_PRODUCE" env-default:"order-events"`
 }
@@ -94,6 +114,68 @@ type TracingConfig struct {
 	URL string `env:"JAEGER_EXP_URL" env-default:"http://localhost:14268/api/traces"`
 }
 
+// AuthConfig describes how bearer tokens are verified.
+//
+// Exactly one of them has to be set: if JWKSFile is set, tokens are verified against its public keys.
+// Otherwise, HMACSecret is used.
+type AuthConfig struct {
+	HMACSecret string `env:"AUTH_HMAC_SECRET"`
+	JWKSFile   string `env:"AUTH_JWKS_FILE"`
+}
+
+// Validate checks that tokens are verified with either JWKS file or secret, not both or none.
+func (a AuthConfig) Validate() error {
+	switch {
+	case a.JWKSFile != "" && a.HMACSecret != "":
+		return errors.New("only one of AUTH_JWKS_FILE and AUTH_HMAC_SECRET may be set")
+	case a.JWKSFile == "" && a.HMACSecret == "":
+		return errors.New("either AUTH_JWKS_FILE or AUTH_HMAC_SECRET must be set")
+	}
+
+	return nil
+}
+
+// CheckoutConfig describes checkout saga timings.
+type CheckoutConfig struct {
+	// How long to wait for inventory to reserve stock before order is cancelled.
+	StockTimeout time.Duration `env:"CHECKOUT_STOCK_TIMEOUT" env-default:"1m"`
+	// How long to wait for payment result before order is cancelled.
+	PaymentTimeout time.Duration `env:"CHECKOUT_PAYMENT_TIMEOUT" env-default:"15m"`
+	// How often commands of a stalled step are sent again.
+	RetryInterval time.Duration `env:"CHECKOUT_RETRY_INTERVAL" env-default:"30s"`
+	// How often due sagas are looked up.
+	PollInterval time.Duration `env:"CHECKOUT_POLL_INTERVAL" env-default:"5s"`
+}
+
+// IdempotencyConfig describes how idempotency keys of create requests are kept.
+type IdempotencyConfig struct {
+	// How long key is remembered after first request.
+	KeyTTL time.Duration `env:"IDEMPOTENCY_KEY_TTL" env-default:"24h"`
+	// How long request holds key in progress. Retry takes key over after it, keep it longer than request may take.
+	KeyLease time.Duration `env:"IDEMPOTENCY_KEY_LEASE" env-default:"1m"`
+	// How often expired keys are deleted.
+	CleanupInterval time.Duration `env:"IDEMPOTENCY_CLEANUP_INTERVAL" env-default:"1h"`
+}
+
+// ExpiryConfig describes how unpaid pending orders are cancelled.
+type ExpiryConfig struct {
+	// How long order may stay pending. Keep it longer than checkout timeouts combined,
+	// so only orders checkout failed to finish are expired.
+	PaymentWindow time.Duration `env:"ORDER_PAYMENT_WINDOW" env-default:"30m"`
+	// How often pending orders are checked.
+	Interval time.Duration `env:"ORDER_EXPIRY_INTERVAL" env-default:"1m"`
+}
+
+// RetentionConfig describes how archived (deleted) orders are erased.
+type RetentionConfig struct {
+	// How long order stays archived and can be restored.
+	Period time.Duration `env:"ORDER_RETENTION_PERIOD" env-default:"2160h"`
+	// Whether orders are deleted with their history. Otherwise, personal data is erased and amounts are kept.
+	Purge bool `env:"ORDER_RETENTION_PURGE" env-default:"false"`
+	// How often archived orders are checked.
+	Interval time.Duration `env:"ORDER_RETENTION_INTERVAL" env-default:"1h"`
+}
+
 // MustNew Reads .env file and returns Config.
 func MustNew() *Config {
 	if err := godotenv.Load(); err != nil {
@@ -106,5 +188,17 @@ func MustNew() *Config {
 		log.Fatalf("error reading config: %v", err)
 	}
 
+	if err := cfg.Auth.Validate(); err != nil {
+		log.Fatalf("invalid auth config: %v", err)
+	}
+
 	return &cfg
 }
+
+// WatchConfig describes live order updates streamed to clients.
+type WatchConfig struct {
+	// How long stream may stay silent before heartbeat is sent, keeps proxies from closing idle connections.
+	Heartbeat time.Duration `env:"ORDER_WATCH_HEARTBEAT" env-default:"15s"`
+	// How often watched order is re-read, so changes made by other replicas reach the stream too.
+	Resync time.Duration `env:"ORDER_WATCH_RESYNC" env-default:"10s"`
+}
@@ -0,0 +1,144 @@
+package domain
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+const (
+	MaxAddressFieldLength = 255
+	MaxPostalCodeLength   = 16
+)
+
+// Address is where order is delivered and who receives it.
+type Address struct {
+	// Country is ISO 3166-1 alpha-2 code.
+	Country    string
+	Region     string
+	City       string
+	PostalCode string
+	Street     string
+	Building   string
+	Apartment  string
+	Recipient  string
+	// Phone is recipient phone in E.164 format.
+	Phone string
+}
+
+// addressRule is format of postal codes and phones of one country. Postal code is required if rule has one.
+type addressRule struct {
+	postalCode *regexp.Regexp
+	phone      *regexp.Regexp
+}
+
+var (
+	countryCodeRe = regexp.MustCompile(`^[A-Z]{2}$`)
+	// Any E.164 number, used for countries without rule.
+	phoneRe = regexp.MustCompile(`^\+[1-9]\d{6,14}$`)
+	// Any postal code, used for countries without rule.
+	postalCodeRe = regexp.MustCompile(`^[A-Z0-9][A-Z0-9 -]*$`)
+
+	fiveDigitsRe = regexp.MustCompile(`^\d{5}$`)
+	sixDigitsRe  = regexp.MustCompile(`^\d{6}$`)
+
+	addressRules = map[string]addressRule{
+		"RU": {postalCode: sixDigitsRe, phone: regexp.MustCompile(`^\+7\d{10}$`)},
+		"KZ": {postalCode: sixDigitsRe, phone: regexp.MustCompile(`^\+7\d{10}$`)},
+		"BY": {postalCode: sixDigitsRe, phone: regexp.MustCompile(`^\+375\d{9}$`)},
+		"US": {postalCode: regexp.MustCompile(`^\d{5}(-\d{4})?$`), phone: regexp.MustCompile(`^\+1\d{10}$`)},
+		"DE": {postalCode: fiveDigitsRe, phone: regexp.MustCompile(`^\+49\d{6,13}$`)},
+		"FR": {postalCode: fiveDigitsRe, phone: regexp.MustCompile(`^\+33\d{9}$`)},
+		"ES": {postalCode: fiveDigitsRe, phone: regexp.MustCompile(`^\+34\d{9}$`)},
+		"IT": {postalCode: fiveDigitsRe, phone: regexp.MustCompile(`^\+39\d{6,11}$`)},
+		"NL": {postalCode: regexp.MustCompile(`^\d{4} ?[A-Z]{2}$`), phone: regexp.MustCompile(`^\+31\d{9}$`)},
+	}
+
+	phoneSeparators = strings.NewReplacer(" ", "", "-", "", "(", "", ")", "")
+)
+
+// Normalize returns address with surrounding spaces trimmed, country and postal code upper-cased
+// and phone stripped of separators.
+func (a Address) Normalize() Address {
+	return Address{
+		Country:    strings.ToUpper(strings.TrimSpace(a.Country)),
+		Region:     strings.TrimSpace(a.Region),
+		City:       strings.TrimSpace(a.City),
+		PostalCode: strings.ToUpper(strings.TrimSpace(a.PostalCode)),
+		Street:     strings.TrimSpace(a.Street),
+		Building:   strings.TrimSpace(a.Building),
+		Apartment:  strings.TrimSpace(a.Apartment),
+		Recipient:  strings.TrimSpace(a.Recipient),
+		Phone:      phoneSeparators.Replace(strings.TrimSpace(a.Phone)),
+	}
+}
+
+// Validate checks that address is complete and its postal code and phone are valid in its country.
+// Address should be normalized first.
+func (a Address) Validate() error {
+	var errs []string
+
+	if !countryCodeRe.MatchString(a.Country) {
+		errs = append(errs, "invalid country")
+	}
+
+	required := []struct {
+		name, value string
+	}{
+		{"city", a.City},
+		{"street", a.Street},
+		{"building", a.Building},
+		{"recipient", a.Recipient},
+	}
+	for _, f := range required {
+		if f.value == "" || len(f.value) > MaxAddressFieldLength {
+			errs = append(errs, "invalid "+f.name)
+		}
+	}
+
+	if len(a.Region) > MaxAddressFieldLength {
+		errs = append(errs, "invalid region")
+	}
+
+	if len(a.Apartment) > MaxAddressFieldLength {
+		errs = append(errs, "invalid apartment")
+	}
+
+	rule, ok := addressRules[a.Country]
+	if !ok {
+		rule = addressRule{phone: phoneRe}
+	}
+
+	switch {
+	case len(a.PostalCode) > MaxPostalCodeLength:
+		errs = append(errs, "invalid postal code")
+	case rule.postalCode != nil && !rule.postalCode.MatchString(a.PostalCode):
+		errs = append(errs, "invalid postal code")
+	case rule.postalCode == nil && a.PostalCode != "" && !postalCodeRe.MatchString(a.PostalCode):
+		errs = append(errs, "invalid postal code")
+	}
+
+	if !rule.phone.MatchString(a.Phone) {
+		errs = append(errs, "invalid phone")
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("%w: %s", ErrInvalidDeliveryAddress, strings.Join(errs, ", "))
+	}
+
+	return nil
+}
+
+// String formats address in one line, the way it is shown to couriers and matched by full-text search.
+func (a Address) String() string {
+	street := strings.TrimSpace(a.Street + " " + a.Building)
+
+	var parts []string
+	for _, part := range []string{a.Recipient, a.Phone, street, a.Apartment, a.City, a.Region, a.PostalCode, a.Country} {
+		if part != "" {
+			parts = append(parts, part)
+		}
+	}
+
+	return strings.Join(parts, ", ")
+}
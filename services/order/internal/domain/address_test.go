@@ -0,0 +1,61 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddress_Normalize(t *testing.T) {
+	a := Address{Country: " ru", City: " Moscow ", PostalCode: "nl ", Phone: "+7 (999) 123-45-67"}.Normalize()
+
+	assert.Equal(t, "RU", a.Country)
+	assert.Equal(t, "Moscow", a.City)
+	assert.Equal(t, "NL", a.PostalCode)
+	assert.Equal(t, "+79991234567", a.Phone)
+}
+
+func TestAddress_Validate(t *testing.T) {
+	valid := Address{Country: "RU", City: "Moscow", PostalCode: "101000", Street: "Tverskaya", Building: "1",
+		Recipient: "Ivan Ivanov", Phone: "+79991234567"}
+
+	tests := []struct {
+		name    string
+		modify  func(a *Address)
+		wantErr string
+	}{
+		{name: "valid", modify: func(a *Address) {}},
+		{name: "us zip+4", modify: func(a *Address) { a.Country, a.PostalCode, a.Phone = "US", "10001-1234", "+12125550100" }},
+		{name: "nl postal code", modify: func(a *Address) { a.Country, a.PostalCode, a.Phone = "NL", "1012 AB", "+31201234567" }},
+		{name: "country without rule", modify: func(a *Address) { a.Country, a.PostalCode, a.Phone = "JP", "", "+81312345678" }},
+		{name: "bad country", modify: func(a *Address) { a.Country = "Russia" }, wantErr: "invalid country"},
+		{name: "missing city", modify: func(a *Address) { a.City = "" }, wantErr: "invalid city"},
+		{name: "missing recipient", modify: func(a *Address) { a.Recipient = "" }, wantErr: "invalid recipient"},
+		{name: "ru postal code", modify: func(a *Address) { a.PostalCode = "10100" }, wantErr: "invalid postal code"},
+		{name: "missing postal code", modify: func(a *Address) { a.PostalCode = "" }, wantErr: "invalid postal code"},
+		{name: "phone of other country", modify: func(a *Address) { a.Phone = "+12125550100" }, wantErr: "invalid phone"},
+		{name: "phone not e164", modify: func(a *Address) { a.Country, a.PostalCode, a.Phone = "JP", "", "0312345678" }, wantErr: "invalid phone"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := valid
+			tt.modify(&a)
+
+			err := a.Validate()
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, ErrInvalidDeliveryAddress)
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestAddress_String(t *testing.T) {
+	a := Address{Country: "RU", City: "Moscow", PostalCode: "101000", Street: "Tverskaya", Building: "1", Apartment: "5",
+		Recipient: "Ivan Ivanov", Phone: "+79991234567"}
+
+	assert.Equal(t, "Ivan Ivanov, +79991234567, Tverskaya 1, 5, Moscow, 101000, RU", a.String())
+}
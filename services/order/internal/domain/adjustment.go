@@ -0,0 +1,198 @@
+package domain
+
+import (
+	"encoding/json"
+
+	"github.com/dzhordano/ecom-thing/services/order/pkg/money"
+	"github.com/google/uuid"
+)
+
+// Reasons refunds are requested for.
+const (
+	RefundReasonCancellation    = "items cancelled"
+	RefundReasonReturn          = "items returned"
+	RefundReasonCheckoutAborted = "checkout aborted"
+	RefundReasonOutOfStock      = "items out of stock"
+)
+
+// Cancellation is lines of pending or paid order cancelled before shipping, priced as they were ordered.
+type Cancellation struct {
+	ID       uuid.UUID
+	OrderID  uuid.UUID
+	UserID   uuid.UUID
+	Currency Currency
+	Items    Items
+	// Paid is set when cancelled lines were paid for, only then they are refunded.
+	Paid   bool
+	Reason string
+}
+
+// Total is price of cancelled lines, taxes charged on top of them included. Paid cancellation refunds it.
+func (c *Cancellation) Total() money.Money {
+	return c.Items.Charged(c.Currency)
+}
+
+// StockEvent releases stock of cancelled items.
+func (c *Cancellation) StockEvent() StockAdjustmentEvent {
+	return StockAdjustmentEvent{
+		OrderID:      c.OrderID.String(),
+		AdjustmentID: c.ID.String(),
+		Items:        c.Items,
+	}
+}
+
+// RefundEvent requests refund of cancelled items. Cancellation id is used as refund id.
+func (c *Cancellation) RefundEvent() RefundEvent {
+	return RefundEvent{
+		RefundID: c.ID.String(),
+		OrderID:  c.OrderID.String(),
+		UserID:   c.UserID.String(),
+		Amount:   c.Total().String(),
+		Currency: c.Currency.String(),
+		Reason:   c.Reason,
+	}
+}
+
+// StockChange is stock order takes or gives back when its lines are changed. Locked and released units
+// are applied by inventory as separate adjustments.
+type StockChange struct {
+	OrderID   uuid.UUID
+	LockID    uuid.UUID
+	Locked    Items
+	ReleaseID uuid.UUID
+	Released  Items
+}
+
+// NewStockChange compares quantities of products ordered before and after the change. Lines of the same
+// product are summed, only product ids and quantities of lines are kept.
+func NewStockChange(orderId uuid.UUID, before, after Items) (*StockChange, error) {
+	lockId, err := uuid.NewUUID()
+	if err != nil {
+		return nil, err
+	}
+
+	releaseId, err := uuid.NewUUID()
+	if err != nil {
+		return nil, err
+	}
+
+	c := &StockChange{
+		OrderID:   orderId,
+		LockID:    lockId,
+		ReleaseID: releaseId,
+	}
+
+	quantities := func(items Items) (map[uuid.UUID]uint64, []uuid.UUID) {
+		q := make(map[uuid.UUID]uint64, len(items))
+		var ids []uuid.UUID
+		for _, item := range items {
+			if _, ok := q[item.ProductID]; !ok {
+				ids = append(ids, item.ProductID)
+			}
+			q[item.ProductID] += item.Quantity
+		}
+		return q, ids
+	}
+
+	was, wasIds := quantities(before)
+	is, isIds := quantities(after)
+
+	for _, id := range isIds {
+		if is[id] > was[id] {
+			c.Locked = append(c.Locked, Item{ProductID: id, Quantity: is[id] - was[id]})
+		}
+	}
+
+	for _, id := range wasIds {
+		if was[id] > is[id] {
+			c.Released = append(c.Released, Item{ProductID: id, Quantity: was[id] - is[id]})
+		}
+	}
+
+	return c, nil
+}
+
+// IsEmpty tells if ordered quantities stay the same.
+func (c *StockChange) IsEmpty() bool {
+	return len(c.Locked) == 0 && len(c.Released) == 0
+}
+
+// LockEvent takes stock of added units.
+func (c *StockChange) LockEvent() StockAdjustmentEvent {
+	return StockAdjustmentEvent{
+		OrderID:      c.OrderID.String(),
+		AdjustmentID: c.LockID.String(),
+		Items:        c.Locked,
+	}
+}
+
+// ReleaseEvent gives back stock of removed units.
+func (c *StockChange) ReleaseEvent() StockAdjustmentEvent {
+	return StockAdjustmentEvent{
+		OrderID:      c.OrderID.String(),
+		AdjustmentID: c.ReleaseID.String(),
+		Items:        c.Released,
+	}
+}
+
+// StockAdjustmentEvent tells inventory to take back part of order's stock: lines cancelled or removed before
+// shipping, or returned by customer. Units added to order use it as well, to take more stock.
+// AdjustmentID lets inventory apply it once.
+type StockAdjustmentEvent struct {
+	OrderID      string
+	AdjustmentID string
+	Items        Items
+}
+
+func (e StockAdjustmentEvent) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		OrderID      string          `json:"order_id"`
+		AdjustmentID string          `json:"adjustment_id"`
+		Items        []inventoryItem `json:"items"`
+	}{
+		OrderID:      e.OrderID,
+		AdjustmentID: e.AdjustmentID,
+		Items:        inventoryItems(e.Items),
+	})
+}
+
+// RefundEvent requests refund of what is left of order payment when its checkout is aborted.
+// Order id is used as refund id, so order is refunded this way once.
+func (o *Order) RefundEvent() RefundEvent {
+	return RefundEvent{
+		RefundID: o.ID.String(),
+		OrderID:  o.ID.String(),
+		UserID:   o.UserID.String(),
+		Amount:   o.TotalPrice().String(),
+		Currency: o.Currency.String(),
+		Reason:   RefundReasonCheckoutAborted,
+	}
+}
+
+// RefundEvent asks payment service to return part of order payment. RefundID lets it refund once.
+type RefundEvent struct {
+	RefundID string
+	OrderID  string
+	UserID   string
+	Amount   string
+	Currency string
+	Reason   string
+}
+
+func (e RefundEvent) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		RefundID string `json:"refund_id"`
+		OrderID  string `json:"order_id"`
+		UserID   string `json:"user_id"`
+		Amount   string `json:"amount"`
+		Currency string `json:"currency"`
+		Reason   string `json:"reason"`
+	}{
+		RefundID: e.RefundID,
+		OrderID:  e.OrderID,
+		UserID:   e.UserID,
+		Amount:   e.Amount,
+		Currency: e.Currency,
+		Reason:   e.Reason,
+	})
+}
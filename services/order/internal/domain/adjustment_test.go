@@ -0,0 +1,40 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewStockChange(t *testing.T) {
+	var (
+		orderId = uuid.New()
+		book    = uuid.New()
+		pen     = uuid.New()
+		mug     = uuid.New()
+	)
+
+	before := Items{{ProductID: book, Quantity: 2}, {ProductID: pen, Quantity: 4}}
+
+	t.Run("only delta is locked and released", func(t *testing.T) {
+		c, err := NewStockChange(orderId, before, Items{{ProductID: book, Quantity: 3}, {ProductID: mug, Quantity: 1}})
+		require.NoError(t, err)
+
+		assert.Equal(t, Items{{ProductID: book, Quantity: 1}, {ProductID: mug, Quantity: 1}}, c.Locked)
+		assert.Equal(t, Items{{ProductID: pen, Quantity: 4}}, c.Released)
+		assert.NotEqual(t, c.LockID, c.ReleaseID)
+
+		assert.Equal(t, orderId.String(), c.LockEvent().OrderID)
+		assert.Equal(t, c.LockID.String(), c.LockEvent().AdjustmentID)
+		assert.Equal(t, c.Released, c.ReleaseEvent().Items)
+	})
+
+	t.Run("lines of same product are summed", func(t *testing.T) {
+		c, err := NewStockChange(orderId, before, Items{{ProductID: pen, Quantity: 1}, {ProductID: book, Quantity: 2}, {ProductID: pen, Quantity: 3}})
+		require.NoError(t, err)
+
+		assert.True(t, c.IsEmpty())
+	})
+}
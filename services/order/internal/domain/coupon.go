@@ -0,0 +1,253 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/dzhordano/ecom-thing/services/order/pkg/money"
+	"github.com/google/uuid"
+)
+
+const (
+	MaxCouponCodeLength = 32
+	MaxCategoryLength   = 255
+)
+
+type DiscountType string
+
+const (
+	// DiscountPercent takes percent off every line coupon applies to.
+	DiscountPercent DiscountType = "percent"
+	// DiscountFixed takes fixed amount off lines coupon applies to, split in proportion to line prices.
+	DiscountFixed DiscountType = "fixed"
+)
+
+func (t DiscountType) IsValid() bool {
+	return t == DiscountPercent || t == DiscountFixed
+}
+
+func (t DiscountType) String() string {
+	return string(t)
+}
+
+// Coupon gives discount on orders it is redeemed with.
+type Coupon struct {
+	ID   uint
+	Code string
+	Type DiscountType
+	// Discount is percent for DiscountPercent coupon.
+	Discount float64
+	// Amount is total amount taken off order by DiscountFixed coupon.
+	Amount money.Money
+	// Currency limits coupon to orders in this currency. Required if Amount or MinOrderAmount is set.
+	Currency Currency
+	// MinOrderAmount is minimal order price before discount. Zero means no minimum.
+	MinOrderAmount money.Money
+	// Limits of redemptions in total and per user. Zero means unlimited.
+	MaxRedemptions        uint64
+	MaxRedemptionsPerUser uint64
+	// Redemptions is number of orders coupon was redeemed with.
+	Redemptions uint64
+	// Coupon with restrictions applies only to lines of listed products or products of listed categories.
+	ProductIDs []uuid.UUID
+	Categories []string
+	Active     bool
+	ValidFrom  time.Time
+	ValidTo    time.Time
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+func (c *Coupon) Validate() error {
+	var errs []string
+
+	if c.Code == "" || len(c.Code) > MaxCouponCodeLength || strings.ContainsAny(c.Code, " \t\n") {
+		errs = append(errs, "invalid coupon code")
+	}
+
+	switch c.Type {
+	case DiscountPercent:
+		if c.Discount <= 0 || c.Discount > 100 || !c.Amount.IsZero() {
+			errs = append(errs, ErrInvalidDiscount.Error())
+		}
+	case DiscountFixed:
+		if !c.Amount.IsPositive() || c.Currency == "" || c.Discount != 0 {
+			errs = append(errs, ErrInvalidDiscount.Error())
+		}
+	default:
+		errs = append(errs, "invalid discount type")
+	}
+
+	if c.Currency != "" && !c.Currency.IsValid() {
+		errs = append(errs, ErrInvalidCurrency.Error())
+	}
+
+	if c.MinOrderAmount.IsNegative() || c.MinOrderAmount.IsPositive() && c.Currency == "" {
+		errs = append(errs, "invalid minimum order amount")
+	}
+
+	if !c.ValidTo.After(c.ValidFrom) {
+		errs = append(errs, "invalid coupon validity period")
+	}
+
+	for _, id := range c.ProductIDs {
+		if id == uuid.Nil {
+			errs = append(errs, ErrInvalidUUID.Error())
+			break
+		}
+	}
+
+	for _, category := range c.Categories {
+		if category == "" || len(category) > MaxCategoryLength {
+			errs = append(errs, "invalid category")
+			break
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("%w: %s", ErrInvalidArgument, strings.Join(errs, ", "))
+	}
+
+	return nil
+}
+
+// CheckRedeemable tells if coupon may be redeemed with new order at now.
+// Limits are checked against Redemptions known to coupon, repository enforces them on save.
+func (c *Coupon) CheckRedeemable(now time.Time) error {
+	if !c.Active || now.Before(c.ValidFrom) {
+		return ErrCouponNotActive
+	}
+
+	if now.After(c.ValidTo) {
+		return ErrCouponExpired
+	}
+
+	if c.MaxRedemptions > 0 && c.Redemptions >= c.MaxRedemptions {
+		return ErrCouponRedemptionLimit
+	}
+
+	return nil
+}
+
+// Deactivate stops coupon from being redeemed. Orders it was already redeemed with keep their discounts.
+func (c *Coupon) Deactivate(now time.Time) {
+	if !c.Active {
+		return
+	}
+
+	c.Active = false
+	c.UpdatedAt = now
+}
+
+// AppliesTo tells if coupon gives discount on product of given category.
+func (c *Coupon) AppliesTo(productId uuid.UUID, category string) bool {
+	if len(c.ProductIDs) == 0 && len(c.Categories) == 0 {
+		return true
+	}
+
+	for _, id := range c.ProductIDs {
+		if id == productId {
+			return true
+		}
+	}
+
+	for _, cat := range c.Categories {
+		if cat == category {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Apply returns items with coupon discount set on lines it applies to, other lines get no discount.
+// Categories map product id to its category.
+func (c *Coupon) Apply(items Items, currency Currency, categories map[uuid.UUID]string) (Items, error) {
+	if c.Currency != "" && c.Currency != currency {
+		return nil, fmt.Errorf("%w: coupon is for orders in %s", ErrCouponNotApplicable, c.Currency)
+	}
+
+	result := make(Items, len(items))
+	subtotal := money.New(0, currency.String())
+	eligibleTotal := money.New(0, currency.String())
+	var eligible []int
+
+	for i, item := range items {
+		item.Discount = money.New(0, currency.String())
+		result[i] = item
+
+		gross := item.UnitPrice.Mul(item.Quantity)
+		subtotal = subtotal.Add(gross)
+
+		if c.AppliesTo(item.ProductID, categories[item.ProductID]) {
+			eligible = append(eligible, i)
+			eligibleTotal = eligibleTotal.Add(gross)
+		}
+	}
+
+	if c.MinOrderAmount.IsPositive() && subtotal.Cmp(c.MinOrderAmount) < 0 {
+		return nil, fmt.Errorf("%w: order amount is less than %s", ErrCouponNotApplicable, c.MinOrderAmount.String())
+	}
+
+	if len(eligible) == 0 {
+		return nil, fmt.Errorf("%w: no items coupon applies to", ErrCouponNotApplicable)
+	}
+
+	switch c.Type {
+	case DiscountPercent:
+		for _, i := range eligible {
+			result[i].Discount = result[i].UnitPrice.Mul(result[i].Quantity).Percent(c.Discount)
+		}
+	case DiscountFixed:
+		amount := money.New(c.Amount.Amount, currency.String())
+		if amount.Cmp(eligibleTotal) > 0 {
+			amount = eligibleTotal
+		}
+
+		// Every line but last gets its share rounded, last one takes what is left so shares sum up to amount.
+		left := amount
+		for n, i := range eligible {
+			gross := result[i].UnitPrice.Mul(result[i].Quantity)
+
+			share := amount.MulRatio(gross.Amount, eligibleTotal.Amount)
+			if n == len(eligible)-1 {
+				share = left
+			}
+			if share.Cmp(gross) > 0 {
+				share = gross
+			}
+
+			result[i].Discount = share
+			left = left.Sub(share)
+		}
+	}
+
+	return result, nil
+}
+
+// Discount is total discount given by coupons on order.
+func (o *Order) Discount() money.Money {
+	total := money.New(0, o.Currency.String())
+	for _, item := range o.Items {
+		total = total.Add(item.Discount)
+	}
+	return total
+}
+
+// CouponRedemption is a record of coupon redeemed with order.
+type CouponRedemption struct {
+	CouponID   uint
+	OrderID    uuid.UUID
+	UserID     uuid.UUID
+	Discount   money.Money
+	RedeemedAt time.Time
+}
+
+// CouponUsage describes how coupon was used.
+type CouponUsage struct {
+	Coupon      *Coupon
+	UniqueUsers uint64
+	// Recent redemptions, newest first.
+	Recent []CouponRedemption
+}
@@ -0,0 +1,191 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dzhordano/ecom-thing/services/order/pkg/money"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func validCoupon() *Coupon {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	return &Coupon{
+		Code:       "SPRING25",
+		Type:       DiscountPercent,
+		Discount:   25,
+		Amount:     money.New(0, ""),
+		ProductIDs: []uuid.UUID{},
+		Categories: []string{},
+		Active:     true,
+		ValidFrom:  now,
+		ValidTo:    now.Add(30 * 24 * time.Hour),
+	}
+}
+
+func TestCoupon_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		modify  func(c *Coupon)
+		wantErr bool
+	}{
+		{"valid percent", func(c *Coupon) {}, false},
+		{"valid fixed", func(c *Coupon) {
+			c.Type, c.Discount, c.Amount, c.Currency = DiscountFixed, 0, money.New(500, "USD"), USD
+		}, false},
+		{"empty code", func(c *Coupon) { c.Code = "" }, true},
+		{"code with spaces", func(c *Coupon) { c.Code = "SPRING 25" }, true},
+		{"unknown type", func(c *Coupon) { c.Type = "bogus" }, true},
+		{"percent over 100", func(c *Coupon) { c.Discount = 101 }, true},
+		{"zero percent", func(c *Coupon) { c.Discount = 0 }, true},
+		{"percent with amount", func(c *Coupon) { c.Amount = money.New(100, "USD") }, true},
+		{"fixed without currency", func(c *Coupon) {
+			c.Type, c.Discount, c.Amount = DiscountFixed, 0, money.New(500, "")
+		}, true},
+		{"fixed with percent", func(c *Coupon) {
+			c.Type, c.Amount, c.Currency = DiscountFixed, money.New(500, "USD"), USD
+		}, true},
+		{"min amount without currency", func(c *Coupon) { c.MinOrderAmount = money.New(1000, "") }, true},
+		{"invalid currency", func(c *Coupon) { c.Currency = "XXX" }, true},
+		{"empty period", func(c *Coupon) { c.ValidTo = c.ValidFrom }, true},
+		{"nil product", func(c *Coupon) { c.ProductIDs = []uuid.UUID{uuid.Nil} }, true},
+		{"empty category", func(c *Coupon) { c.Categories = []string{""} }, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := validCoupon()
+			tt.modify(c)
+
+			err := c.Validate()
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidArgument)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCoupon_CheckRedeemable(t *testing.T) {
+	c := validCoupon()
+
+	assert.NoError(t, c.CheckRedeemable(c.ValidFrom.Add(time.Hour)))
+	assert.ErrorIs(t, c.CheckRedeemable(c.ValidFrom.Add(-time.Hour)), ErrCouponNotActive)
+	assert.ErrorIs(t, c.CheckRedeemable(c.ValidTo.Add(time.Hour)), ErrCouponExpired)
+
+	c.MaxRedemptions, c.Redemptions = 2, 2
+	assert.ErrorIs(t, c.CheckRedeemable(c.ValidFrom.Add(time.Hour)), ErrCouponRedemptionLimit)
+
+	c.MaxRedemptions = 0
+	c.Deactivate(c.ValidFrom.Add(time.Hour))
+	assert.False(t, c.Active)
+	assert.ErrorIs(t, c.CheckRedeemable(c.ValidFrom.Add(time.Hour)), ErrCouponNotActive)
+}
+
+func TestCoupon_Apply(t *testing.T) {
+	var (
+		book  = uuid.New()
+		pen   = uuid.New()
+		chair = uuid.New()
+	)
+	items := Items{
+		{ProductID: book, Quantity: 2, UnitPrice: money.New(1000, "USD")},
+		{ProductID: pen, Quantity: 3, UnitPrice: money.New(333, "USD")},
+		{ProductID: chair, Quantity: 1, UnitPrice: money.New(5000, "USD")},
+	}
+	categories := map[uuid.UUID]string{
+		book:  "books",
+		pen:   "stationery",
+		chair: "furniture",
+	}
+
+	tests := []struct {
+		name      string
+		modify    func(c *Coupon)
+		currency  Currency
+		discounts []int64
+		wantErr   error
+	}{
+		{
+			name:      "percent on every line",
+			modify:    func(c *Coupon) { c.Discount = 10 },
+			currency:  USD,
+			discounts: []int64{200, 100, 500},
+		},
+		{
+			name: "percent on category",
+			modify: func(c *Coupon) {
+				c.Categories = []string{"books"}
+			},
+			currency:  USD,
+			discounts: []int64{500, 0, 0},
+		},
+		{
+			name: "fixed split proportionally",
+			modify: func(c *Coupon) {
+				c.Type, c.Discount, c.Amount, c.Currency = DiscountFixed, 0, money.New(1000, "USD"), USD
+			},
+			currency: USD,
+			// Gross 2000, 999 and 5000 of 7999 total, last line takes the rest.
+			discounts: []int64{250, 125, 625},
+		},
+		{
+			name: "fixed capped by eligible lines",
+			modify: func(c *Coupon) {
+				c.Type, c.Discount, c.Amount, c.Currency = DiscountFixed, 0, money.New(5000, "USD"), USD
+				c.ProductIDs = []uuid.UUID{pen}
+			},
+			currency:  USD,
+			discounts: []int64{0, 999, 0},
+		},
+		{
+			name: "other currency",
+			modify: func(c *Coupon) {
+				c.Currency = EUR
+			},
+			currency: USD,
+			wantErr:  ErrCouponNotApplicable,
+		},
+		{
+			name: "below minimal order amount",
+			modify: func(c *Coupon) {
+				c.Currency, c.MinOrderAmount = USD, money.New(10000, "USD")
+			},
+			currency: USD,
+			wantErr:  ErrCouponNotApplicable,
+		},
+		{
+			name: "no eligible lines",
+			modify: func(c *Coupon) {
+				c.ProductIDs = []uuid.UUID{uuid.New()}
+				c.Categories = []string{"toys"}
+			},
+			currency: USD,
+			wantErr:  ErrCouponNotApplicable,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := validCoupon()
+			tt.modify(c)
+
+			got, err := c.Apply(items, tt.currency, categories)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			require.Len(t, got, len(tt.discounts))
+			for i, d := range tt.discounts {
+				assert.Equal(t, d, got[i].Discount.Amount, "line %d", i)
+			}
+			// Source items are left untouched.
+			assert.True(t, items[0].Discount.IsZero())
+		})
+	}
+}
@@ -9,6 +9,8 @@ import (
 
 var (
 	ErrOrderNotFound         = errors.New("order not found")
+	ErrOrderConflict         = errors.New("order was changed concurrently")
+	ErrOrderVersionMismatch  = errors.New("order was changed since given version")
 	ErrInvalidOrderStatus    = errors.New("invalid order status")
 	ErrInvalidCurrency       = errors.New("invalid currency")
 	ErrInvalidPaymentMethod  = errors.New("invalid payment method")
@@ -23,17 +25,51 @@ var (
 	ErrInvalidDeliveryDate    = errors.New("invalid delivery date")
 	ErrInvalidOrderItems      = errors.New("invalid order items")
 
-	ErrOrderAlreadyCompleted = errors.New("order already completed")
-	ErrOrderAlreadyCancelled = errors.New("order already cancelled")
+	ErrInvalidTransition   = errors.New("invalid order status transition")
+	ErrItemsNotCancellable = errors.New("order items can't be cancelled")
+	ErrAllItemsRejected    = errors.New("all order items are rejected")
+	ErrStockLockNotFound   = errors.New("stock lock not found")
+	ErrOrderNotMutable     = errors.New("order fields can't be changed")
+
+	ErrOrderArchived      = errors.New("order is archived")
+	ErrOrderNotArchivable = errors.New("order can't be archived")
+	ErrOrderNotRestorable = errors.New("order can't be restored")
+
+	ErrSagaNotFound        = errors.New("checkout saga not found")
+	ErrSagaConflict        = errors.New("checkout saga was changed concurrently")
+	ErrUnexpectedSagaEvent = errors.New("unexpected checkout event")
+
+	ErrInvalidIdempotencyKey = errors.New("invalid idempotency key")
+	ErrIdempotencyKeyReused  = errors.New("idempotency key was used with another request")
+	ErrRequestInProgress     = errors.New("request with this idempotency key is in progress")
 
 	ErrCouponExpired   = errors.New("coupon expired")
 	ErrCouponNotFound  = errors.New("coupon not found")
 	ErrCouponNotActive = errors.New("coupon not active")
 
+	ErrCouponAlreadyExists   = errors.New("coupon already exists")
+	ErrCouponNotApplicable   = errors.New("coupon is not applicable to order")
+	ErrCouponRedemptionLimit = errors.New("coupon redemption limit reached")
+
 	ErrNotEnoughQuantity = errors.New("not enough quantity")
 
+	ErrShippingRuleNotFound    = errors.New("delivery method is not available")
+	ErrDeliveryDateUnavailable = errors.New("delivery date is not available")
+	ErrDeliverySlotUnavailable = errors.New("delivery slot is fully booked")
+
+	ErrTaxTableNotFound = errors.New("tax table not found")
+
+	ErrReturnNotFound          = errors.New("return not found")
+	ErrOrderNotReturnable      = errors.New("order can't be returned")
+	ErrInvalidReturnStatus     = errors.New("invalid return status")
+	ErrInvalidReturnTransition = errors.New("invalid return status transition")
+
+	ErrProductNotFound      = errors.New("product not found")
 	ErrProductUnavailable   = errors.New("product unavailable")
 	ErrInventoryUnavailable = errors.New("inventory unavailable")
+
+	ErrUnauthenticated  = errors.New("unauthenticated")
+	ErrPermissionDenied = errors.New("permission denied")
 )
 
 var CriticalErrors = map[error]struct{}{
@@ -74,26 +110,72 @@ func (e *AppError) GRPCCode() codes.Code {
 	switch {
 	case errors.Is(e.Code, ErrOrderNotFound):
 		return codes.NotFound
+	case errors.Is(e.Code, ErrOrderConflict):
+		return codes.Aborted
+	case errors.Is(e.Code, ErrOrderVersionMismatch):
+		return codes.FailedPrecondition
 	case errors.Is(e.Code, ErrInvalidArgument):
 		return codes.InvalidArgument
 	case errors.Is(e.Code, ErrInvalidUUID):
 		return codes.InvalidArgument
-	case errors.Is(e.Code, ErrOrderAlreadyCompleted):
+	case errors.Is(e.Code, ErrInvalidDeliveryAddress):
 		return codes.InvalidArgument
-	case errors.Is(e.Code, ErrOrderAlreadyCancelled):
+	case errors.Is(e.Code, ErrInvalidTransition):
+		return codes.FailedPrecondition
+	case errors.Is(e.Code, ErrItemsNotCancellable):
+		return codes.FailedPrecondition
+	case errors.Is(e.Code, ErrOrderNotMutable):
+		return codes.FailedPrecondition
+	case errors.Is(e.Code, ErrOrderArchived):
+		return codes.FailedPrecondition
+	case errors.Is(e.Code, ErrOrderNotArchivable):
+		return codes.FailedPrecondition
+	case errors.Is(e.Code, ErrOrderNotRestorable):
+		return codes.FailedPrecondition
+	case errors.Is(e.Code, ErrInvalidIdempotencyKey):
 		return codes.InvalidArgument
+	case errors.Is(e.Code, ErrIdempotencyKeyReused):
+		return codes.AlreadyExists
+	case errors.Is(e.Code, ErrRequestInProgress):
+		return codes.Aborted
 	case errors.Is(e.Code, ErrCouponExpired):
 		return codes.InvalidArgument
 	case errors.Is(e.Code, ErrCouponNotActive):
 		return codes.InvalidArgument
 	case errors.Is(e.Code, ErrCouponNotFound):
 		return codes.NotFound
+	case errors.Is(e.Code, ErrCouponAlreadyExists):
+		return codes.AlreadyExists
+	case errors.Is(e.Code, ErrCouponNotApplicable):
+		return codes.InvalidArgument
+	case errors.Is(e.Code, ErrCouponRedemptionLimit):
+		return codes.FailedPrecondition
 	case errors.Is(e.Code, ErrNotEnoughQuantity):
 		return codes.InvalidArgument
+	case errors.Is(e.Code, ErrShippingRuleNotFound):
+		return codes.InvalidArgument
+	case errors.Is(e.Code, ErrDeliveryDateUnavailable):
+		return codes.InvalidArgument
+	case errors.Is(e.Code, ErrDeliverySlotUnavailable):
+		return codes.FailedPrecondition
+	case errors.Is(e.Code, ErrReturnNotFound):
+		return codes.NotFound
+	case errors.Is(e.Code, ErrOrderNotReturnable):
+		return codes.FailedPrecondition
+	case errors.Is(e.Code, ErrInvalidReturnStatus):
+		return codes.InvalidArgument
+	case errors.Is(e.Code, ErrInvalidReturnTransition):
+		return codes.FailedPrecondition
+	case errors.Is(e.Code, ErrProductNotFound):
+		return codes.NotFound
 	case errors.Is(e.Code, ErrProductUnavailable):
 		return codes.NotFound
 	case errors.Is(e.Code, ErrInventoryUnavailable):
 		return codes.NotFound
+	case errors.Is(e.Code, ErrUnauthenticated):
+		return codes.Unauthenticated
+	case errors.Is(e.Code, ErrPermissionDenied):
+		return codes.PermissionDenied
 	default:
 		return codes.Internal
 	}
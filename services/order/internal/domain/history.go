@@ -0,0 +1,173 @@
+package domain
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/dzhordano/ecom-thing/services/order/pkg/money"
+	"github.com/google/uuid"
+)
+
+type ActorType string
+
+const (
+	ActorUser   ActorType = "user"   // Order owner acting through API.
+	ActorAdmin  ActorType = "admin"  // Staff acting through API.
+	ActorSystem ActorType = "system" // Service itself, e.g. kafka consumer handling payment event.
+)
+
+// Actor is who made a change. ID is user id for users and admins,
+// and id of source event (if known) for system.
+type Actor struct {
+	Type ActorType
+	ID   string
+}
+
+type eventIdCtxKey struct{}
+
+// ContextWithEventID stores id of event being handled, so changes made while handling it are attributed to it.
+func ContextWithEventID(ctx context.Context, eventId string) context.Context {
+	return context.WithValue(ctx, eventIdCtxKey{}, eventId)
+}
+
+// ActorFromContext resolves actor from principal (and event id for system calls) stored in context.
+func ActorFromContext(ctx context.Context) Actor {
+	p, _ := PrincipalFromContext(ctx)
+
+	switch {
+	case p.HasRole(RoleSystem):
+		eventId, _ := ctx.Value(eventIdCtxKey{}).(string)
+		return Actor{Type: ActorSystem, ID: eventId}
+	case p.HasRole(RoleAdmin):
+		return Actor{Type: ActorAdmin, ID: p.UserID.String()}
+	default:
+		return Actor{Type: ActorUser, ID: p.UserID.String()}
+	}
+}
+
+type ChangeType string
+
+const (
+	ChangeCreated       ChangeType = "created"
+	ChangeStatusChanged ChangeType = "status_changed"
+	ChangeUpdated       ChangeType = "updated"
+	ChangeDeleted       ChangeType = "deleted"
+	ChangeRestored      ChangeType = "restored"
+)
+
+// FieldChange is a single changed field. Values are kept as strings, empty From means field was set.
+type FieldChange struct {
+	Field string `json:"field"`
+	From  string `json:"from,omitempty"`
+	To    string `json:"to,omitempty"`
+}
+
+// HistoryEntry is a record of order mutation.
+type HistoryEntry struct {
+	ID        int64
+	OrderID   uuid.UUID
+	Actor     Actor
+	Type      ChangeType
+	Diff      []FieldChange
+	CreatedAt time.Time
+}
+
+// NewHistoryEntry describes how order changed from before to after on behalf of actor from context.
+// Nil before means order was just created, nil after means it was deleted for good.
+// Archival and restore of order are told apart from other updates.
+func NewHistoryEntry(ctx context.Context, before, after *Order) *HistoryEntry {
+	e := &HistoryEntry{
+		Actor:     ActorFromContext(ctx),
+		CreatedAt: time.Now().UTC(),
+	}
+
+	switch {
+	case before == nil:
+		e.OrderID = after.ID
+		e.Type = ChangeCreated
+		e.Diff = DiffOrders(&Order{}, after)
+	case after == nil:
+		e.OrderID = before.ID
+		e.Type = ChangeDeleted
+	default:
+		e.OrderID = after.ID
+		e.Type = ChangeUpdated
+		e.Diff = DiffOrders(before, after)
+		switch {
+		case !before.IsDeleted() && after.IsDeleted():
+			e.Type = ChangeDeleted
+		case before.IsDeleted() && !after.IsDeleted():
+			e.Type = ChangeRestored
+		case before.Status != after.Status:
+			e.Type = ChangeStatusChanged
+		}
+	}
+
+	return e
+}
+
+// DiffOrders lists fields that differ between orders. Timestamps are not compared.
+func DiffOrders(before, after *Order) []FieldChange {
+	var diff []FieldChange
+
+	add := func(field, from, to string) {
+		if from != to {
+			diff = append(diff, FieldChange{Field: field, From: from, To: to})
+		}
+	}
+
+	add("description", before.Description, after.Description)
+	add("status", before.Status.String(), after.Status.String())
+	add("currency", before.Currency.String(), after.Currency.String())
+	add("total_price", formatPrice(before.TotalPrice()), formatPrice(after.TotalPrice()))
+	add("shipping_fee", formatPrice(before.ShippingFee), formatPrice(after.ShippingFee))
+	add("payment_method", before.PaymentMethod.String(), after.PaymentMethod.String())
+	add("delivery_method", before.DeliveryMethod.String(), after.DeliveryMethod.String())
+	add("delivery_address", before.DeliveryAddress.String(), after.DeliveryAddress.String())
+	add("delivery_date", formatTime(before.DeliveryDate), formatTime(after.DeliveryDate))
+	add("items", formatItems(before.Items), formatItems(after.Items))
+	add("deleted_at", formatTime(before.DeletedAt), formatTime(after.DeletedAt))
+
+	return diff
+}
+
+// Clone returns copy of order that does not share items with original.
+func (o *Order) Clone() *Order {
+	c := *o
+	c.Items = append(Items(nil), o.Items...)
+	return &c
+}
+
+func formatPrice(p money.Money) string {
+	if p.IsZero() {
+		return ""
+	}
+	return p.String()
+}
+
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+func formatItems(items Items) string {
+	if len(items) == 0 {
+		return ""
+	}
+
+	type item struct {
+		ProductID string `json:"product_id"`
+		Quantity  uint64 `json:"quantity"`
+	}
+
+	out := make([]item, 0, len(items))
+	for _, i := range items {
+		out = append(out, item{ProductID: i.ProductID.String(), Quantity: i.Quantity})
+	}
+
+	b, _ := json.Marshal(out)
+	return string(b)
+}
@@ -0,0 +1,67 @@
+package domain
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/dzhordano/ecom-thing/services/order/pkg/money"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewHistoryEntry(t *testing.T) {
+	userId := uuid.New()
+	userCtx := ContextWithPrincipal(context.Background(), Principal{UserID: userId, Roles: []Role{RoleUser}})
+
+	order := &Order{
+		ID:              uuid.New(),
+		UserID:          userId,
+		Status:          OrderPending,
+		Currency:        RUB,
+		PaymentMethod:   BankCard,
+		DeliveryMethod:  Pickup,
+		DeliveryAddress: Address{Country: "RU", City: "Moscow", Street: "tt st.", Building: "1", Recipient: "Ivan"},
+		DeliveryDate:    time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		Items:           Items{NewItem(uuid.New(), 2, "tt", money.New(500, "RUB"), 0)},
+	}
+
+	created := NewHistoryEntry(userCtx, nil, order)
+	assert.Equal(t, ChangeCreated, created.Type)
+	assert.Equal(t, Actor{Type: ActorUser, ID: userId.String()}, created.Actor)
+	assert.Contains(t, created.Diff, FieldChange{Field: "status", To: "pending"})
+	assert.Contains(t, created.Diff, FieldChange{Field: "total_price", To: "10.00"})
+
+	before := order.Clone()
+	order.DeliveryAddress.Street = "new st."
+	updated := NewHistoryEntry(userCtx, before, order)
+	assert.Equal(t, ChangeUpdated, updated.Type)
+	assert.Equal(t, []FieldChange{{Field: "delivery_address", From: "Ivan, tt st. 1, Moscow, RU", To: "Ivan, new st. 1, Moscow, RU"}}, updated.Diff)
+
+	systemCtx := ContextWithEventID(ContextWithPrincipal(context.Background(), SystemPrincipal()), "event-id")
+	before = order.Clone()
+	assert.NoError(t, order.MarkPaid())
+	paid := NewHistoryEntry(systemCtx, before, order)
+	assert.Equal(t, ChangeStatusChanged, paid.Type)
+	assert.Equal(t, Actor{Type: ActorSystem, ID: "event-id"}, paid.Actor)
+	assert.Equal(t, []FieldChange{{Field: "status", From: "pending", To: "paid"}}, paid.Diff)
+
+	deleted := NewHistoryEntry(userCtx, order, nil)
+	assert.Equal(t, ChangeDeleted, deleted.Type)
+	assert.Equal(t, order.ID, deleted.OrderID)
+	assert.Empty(t, deleted.Diff)
+
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	order.Status = OrderCompleted
+	before = order.Clone()
+	assert.NoError(t, order.Archive(userId, now))
+	archived := NewHistoryEntry(userCtx, before, order)
+	assert.Equal(t, ChangeDeleted, archived.Type)
+	assert.Equal(t, []FieldChange{{Field: "deleted_at", From: "", To: formatTime(now)}}, archived.Diff)
+
+	before = order.Clone()
+	assert.NoError(t, order.Restore(now))
+	restored := NewHistoryEntry(userCtx, before, order)
+	assert.Equal(t, ChangeRestored, restored.Type)
+	assert.Equal(t, []FieldChange{{Field: "deleted_at", From: formatTime(now), To: ""}}, restored.Diff)
+}
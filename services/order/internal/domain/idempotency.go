@@ -0,0 +1,57 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const MaxIdempotencyKeyLength = 255
+
+// IdempotencyKey remembers request that was made with a client supplied key,
+// so retries of the same request are answered with the original result.
+type IdempotencyKey struct {
+	Key       string
+	UserID    uuid.UUID
+	Operation string
+	// RequestHash is fingerprint of request payload, same key with another payload is rejected.
+	RequestHash string
+	// ResourceID is id of resource created by request. Empty while request is in progress.
+	ResourceID string
+	// Response is result of request as it was returned first, retries are answered with it.
+	// Keys completed before responses were stored have none.
+	Response []byte
+	// LockID identifies request holding key in progress until LockedUntil. Retry takes over key whose lease
+	// ended, so request that crashed doesn't block it till key expires. Request that lost key can't complete it.
+	LockID      uuid.UUID
+	LockedUntil time.Time
+	CreatedAt   time.Time
+	ExpiresAt   time.Time
+}
+
+// NewIdempotencyKey returns key remembered for ttl and held in progress for lease.
+func NewIdempotencyKey(key string, userId uuid.UUID, operation, requestHash string, now time.Time, ttl, lease time.Duration) (*IdempotencyKey, error) {
+	if key == "" || len(key) > MaxIdempotencyKeyLength {
+		return nil, ErrInvalidIdempotencyKey
+	}
+
+	return &IdempotencyKey{
+		Key:         key,
+		UserID:      userId,
+		Operation:   operation,
+		RequestHash: requestHash,
+		LockID:      uuid.New(),
+		LockedUntil: now.Add(lease),
+		CreatedAt:   now,
+		ExpiresAt:   now.Add(ttl),
+	}, nil
+}
+
+func (k *IdempotencyKey) IsCompleted() bool {
+	return k.ResourceID != ""
+}
+
+func (k *IdempotencyKey) Complete(resourceId string, response []byte) {
+	k.ResourceID = resourceId
+	k.Response = response
+}
@@ -1,19 +1,17 @@
 package domain
 
 import (
-	"database/sql/driver"
 	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
 
+	"github.com/dzhordano/ecom-thing/services/order/pkg/money"
 	"github.com/google/uuid"
 )
 
 const (
 	MaxDescriptionLength = 255
-
-	MinAddressLength = 5
 )
 
 type Order struct {
@@ -22,18 +20,32 @@ type Order struct {
 	Description     string
 	Status          Status
 	Currency        Currency
-	TotalPrice      float64
 	PaymentMethod   PaymentMethod
 	DeliveryMethod  DeliveryMethod
-	DeliveryAddress string
+	DeliveryAddress Address
 	DeliveryDate    time.Time
 	Items           Items
-	CreatedAt       time.Time
-	UpdatedAt       time.Time
+	// ShippingFee is charged for delivery on top of lines, in order currency.
+	ShippingFee money.Money
+	// TaxRegion is region taxes of lines were computed for.
+	TaxRegion TaxRegion
+	// Coupon is code of coupon redeemed with order, empty if there is none.
+	Coupon string
+	// Version is bumped by every saved change, so order read before it can't overwrite it.
+	Version int64
+	// DeletedAt is when order was archived and DeletedBy is who did it, both are zero for active order.
+	// Archived order is kept for accounting, but hidden from its owner and can't be changed until restored.
+	DeletedAt time.Time
+	DeletedBy uuid.UUID
+	// AnonymizedAt is when personal data of archived order was erased by retention, such order can't be restored.
+	AnonymizedAt time.Time
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
 }
 
-func NewOrder(userId uuid.UUID, description, status, currency string, totalPrice, totalDiscount float64,
-	paymentMethod, deliveryMethod, deliveryAddress string, deliveryDate time.Time,
+// NewOrder creates order from priced items. See NewItem.
+func NewOrder(userId uuid.UUID, description, status, currency string,
+	paymentMethod, deliveryMethod string, deliveryAddress Address, deliveryDate time.Time,
 	items Items) (*Order, error) {
 
 	orderId, err := uuid.NewUUID()
@@ -47,12 +59,12 @@ func NewOrder(userId uuid.UUID, description, status, currency string, totalPrice
 		Description:     description,
 		Status:          Status(status),
 		Currency:        Currency(currency),
-		TotalPrice:      ApplyDiscountTo(totalPrice, totalDiscount),
 		PaymentMethod:   PaymentMethod(paymentMethod),
 		DeliveryMethod:  DeliveryMethod(deliveryMethod),
-		DeliveryAddress: deliveryAddress,
+		DeliveryAddress: deliveryAddress.Normalize(),
 		DeliveryDate:    deliveryDate,
 		Items:           items,
+		ShippingFee:     money.New(0, currency),
 		CreatedAt:       time.Now().UTC(),
 		UpdatedAt:       time.Now().UTC(),
 	}
@@ -65,13 +77,23 @@ func NewOrder(userId uuid.UUID, description, status, currency string, totalPrice
 }
 
 func (o *Order) Validate() error {
+	return o.validate(true)
+}
+
+// ValidateChanges validates order updated from before. Untouched delivery address is not rechecked:
+// orders placed before addresses were structured keep free-form one in street until they are given a new one.
+func (o *Order) ValidateChanges(before *Order) error {
+	return o.validate(o.DeliveryAddress != before.DeliveryAddress)
+}
+
+func (o *Order) validate(address bool) error {
 	var errs []string
 
 	if len(o.Description) > MaxDescriptionLength {
 		errs = append(errs, ErrInvalidDescription.Error())
 	}
 
-	if o.TotalPrice < 0 {
+	if o.TotalPrice().IsNegative() || o.ShippingFee.IsNegative() {
 		errs = append(errs, ErrInvalidPrice.Error())
 	}
 
@@ -91,9 +113,10 @@ func (o *Order) Validate() error {
 		errs = append(errs, ErrInvalidDeliveryMethod.Error())
 	}
 
-	// TODO Нужен REGEX или что-то такое. Мб вообще проверять на существование такой улицы...
-	if len(o.DeliveryAddress) < MinAddressLength {
-		errs = append(errs, ErrInvalidDeliveryAddress.Error())
+	if address {
+		if err := o.DeliveryAddress.Validate(); err != nil {
+			errs = append(errs, err.Error())
+		}
 	}
 
 	if o.DeliveryDate.Before(time.Now()) {
@@ -104,6 +127,15 @@ func (o *Order) Validate() error {
 		errs = append(errs, ErrInvalidOrderItems.Error())
 	}
 
+	for _, item := range o.Items {
+		if item.Quantity == 0 || item.UnitPrice.IsNegative() || item.Discount.IsNegative() || item.Total().IsNegative() ||
+			item.Tax.IsNegative() || item.TaxRate < 0 ||
+			item.UnitPrice.Currency != o.Currency.String() {
+			errs = append(errs, ErrInvalidOrderItems.Error())
+			break
+		}
+	}
+
 	if len(errs) > 0 {
 		return fmt.Errorf("%w: %s", ErrInvalidArgument, strings.Join(errs, ", "))
 	}
@@ -111,20 +143,321 @@ func (o *Order) Validate() error {
 	return nil
 }
 
+// Subtotal is sum of line totals.
+func (o *Order) Subtotal() money.Money {
+	return o.Items.Total(o.Currency)
+}
+
+// Tax is sum of line taxes, both included in prices and charged on top of them.
+func (o *Order) Tax() money.Money {
+	return o.Items.Tax(o.Currency)
+}
+
+// TaxIncluded tells if line taxes are part of line prices. Lines of one order are taxed the same way.
+func (o *Order) TaxIncluded() bool {
+	return len(o.Items) > 0 && o.Items[0].TaxIncluded
+}
+
+// TotalPrice is what order is charged: lines with taxes not included in prices, and shipping.
+// Order has no total of its own.
+func (o *Order) TotalPrice() money.Money {
+	return o.Items.Charged(o.Currency).Add(o.ShippingFee)
+}
+
+// MarkPaid moves pending order to paid.
+func (o *Order) MarkPaid() error {
+	return o.transitionTo(OrderPaid)
+}
+
+// Ship moves paid order to shipped.
+func (o *Order) Ship() error {
+	return o.transitionTo(OrderShipped)
+}
+
+// Deliver moves shipped order to delivered.
+func (o *Order) Deliver() error {
+	return o.transitionTo(OrderDelivered)
+}
+
+// Complete moves delivered order to completed.
+func (o *Order) Complete() error {
+	return o.transitionTo(OrderCompleted)
+}
+
+// Cancel cancels order that was not shipped yet.
+func (o *Order) Cancel() error {
+	return o.transitionTo(OrderCancelled)
+}
+
+// CancelItems cancels given quantities of pending or paid order lines. Cancelled units are taken from the end of the line.
+// At least one unit has to remain, whole order is cancelled with Cancel.
+//
+// Only cancellation of paid order has to be refunded, pending one just gives back its stock.
+func (o *Order) CancelItems(items Items) (*Cancellation, error) {
+	if o.Status != OrderPending && o.Status != OrderPaid {
+		return nil, fmt.Errorf("%w: items of %s order can't be cancelled", ErrItemsNotCancellable, o.Status)
+	}
+
+	quantities, err := o.quantities(items)
+	if err != nil {
+		return nil, err
+	}
+
+	kept, cancelled := o.takeItems(quantities)
+	if len(kept) == 0 {
+		return nil, fmt.Errorf("%w: all items are cancelled, cancel order instead", ErrInvalidArgument)
+	}
+
+	id, err := uuid.NewUUID()
+	if err != nil {
+		return nil, err
+	}
+
+	o.Items = kept
+	o.UpdatedAt = time.Now().UTC()
+
+	return &Cancellation{
+		ID:       id,
+		OrderID:  o.ID,
+		UserID:   o.UserID,
+		Currency: o.Currency,
+		Items:    cancelled,
+		Paid:     o.Status == OrderPaid,
+		Reason:   RefundReasonCancellation,
+	}, nil
+}
+
+// RejectItems takes units inventory failed to lock off pending or paid order. Units are taken from the end of the line,
+// ones already removed by later changes are skipped, so returned cancellation may have no items. Lock id is used
+// as cancellation id, so paid order is refunded for rejected units once.
+//
+// Order left without units can't be kept, ErrAllItemsRejected is returned for it and order is not changed.
+func (o *Order) RejectItems(lockId uuid.UUID, items Items) (*Cancellation, error) {
+	if o.Status != OrderPending && o.Status != OrderPaid {
+		return nil, fmt.Errorf("%w: items of %s order can't be rejected", ErrItemsNotCancellable, o.Status)
+	}
+
+	quantities := make(map[uuid.UUID]uint64, len(items))
+	for _, item := range items {
+		quantities[item.ProductID] += item.Quantity
+	}
+
+	kept, rejected := o.takeItems(quantities)
+	if len(kept) == 0 {
+		return nil, ErrAllItemsRejected
+	}
+
+	if len(rejected) > 0 {
+		o.Items = kept
+		o.UpdatedAt = time.Now().UTC()
+	}
+
+	return &Cancellation{
+		ID:       lockId,
+		OrderID:  o.ID,
+		UserID:   o.UserID,
+		Currency: o.Currency,
+		Items:    rejected,
+		Paid:     o.Status == OrderPaid,
+		Reason:   RefundReasonOutOfStock,
+	}, nil
+}
+
+// takeItems splits order lines into kept ones and up to given quantities of products taken off them.
+// Units are taken from the end of the line. Order itself is not changed.
+func (o *Order) takeItems(quantities map[uuid.UUID]uint64) (kept, taken Items) {
+	kept = make(Items, 0, len(o.Items))
+	for _, line := range o.Items {
+		n := min(quantities[line.ProductID], line.Quantity)
+		quantities[line.ProductID] -= n
+		if n == 0 {
+			kept = append(kept, line)
+			continue
+		}
+
+		taken = append(taken, line.Part(line.Quantity-n, n))
+		if n < line.Quantity {
+			kept = append(kept, line.WithQuantity(line.Quantity-n))
+		}
+	}
+
+	return kept, taken
+}
+
+// quantities sums requested quantities per product and checks they don't exceed ordered ones.
+func (o *Order) quantities(items Items) (map[uuid.UUID]uint64, error) {
+	if len(items) == 0 {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidArgument, ErrInvalidOrderItems)
+	}
+
+	ordered := make(map[uuid.UUID]uint64, len(o.Items))
+	for _, line := range o.Items {
+		ordered[line.ProductID] += line.Quantity
+	}
+
+	requested := make(map[uuid.UUID]uint64, len(items))
+	for _, item := range items {
+		if item.Quantity == 0 {
+			return nil, fmt.Errorf("%w: %s: zero quantity of %s", ErrInvalidArgument, ErrInvalidOrderItems, item.ProductID)
+		}
+		requested[item.ProductID] += item.Quantity
+	}
+
+	for id, q := range requested {
+		if q > ordered[id] {
+			return nil, fmt.Errorf("%w: %s: only %d of %s ordered", ErrInvalidArgument, ErrInvalidOrderItems, ordered[id], id)
+		}
+	}
+
+	return requested, nil
+}
+
+// Fields of order update may change, named as in order history.
+const (
+	FieldDescription     = "description"
+	FieldPaymentMethod   = "payment_method"
+	FieldDeliveryMethod  = "delivery_method"
+	FieldDeliveryAddress = "delivery_address"
+	FieldDeliveryDate    = "delivery_date"
+	FieldItems           = "items"
+)
+
+// mutableFields lists fields that can be changed in each status. Lines and payment are settled once order
+// is paid, but it can be redirected until it is shipped. After that only description can be changed.
+var mutableFields = map[Status][]string{
+	OrderPending: {FieldDescription, FieldPaymentMethod, FieldDeliveryMethod, FieldDeliveryAddress, FieldDeliveryDate,
+		FieldItems},
+	OrderPaid:      {FieldDescription, FieldDeliveryAddress, FieldDeliveryDate},
+	OrderShipped:   {FieldDescription},
+	OrderDelivered: {FieldDescription},
+	OrderCompleted: {FieldDescription},
+	OrderCancelled: {FieldDescription},
+	OrderRefunded:  {FieldDescription},
+}
+
+// derivedFields follow other fields and are never changed on their own.
+var derivedFields = map[string]bool{
+	"total_price":  true,
+	"shipping_fee": true,
+}
+
+// CheckChanges checks that order differs from before only in fields status of before allows to change.
+func (o *Order) CheckChanges(before *Order) error {
+	if before.IsDeleted() {
+		return ErrOrderArchived
+	}
+
+	var fields []string
+	for _, change := range DiffOrders(before, o) {
+		if !derivedFields[change.Field] && !before.Status.CanChange(change.Field) {
+			fields = append(fields, change.Field)
+		}
+	}
+
+	if len(fields) > 0 {
+		return fmt.Errorf("%w: %s of %s order", ErrOrderNotMutable, strings.Join(fields, ", "), before.Status)
+	}
+
+	return nil
+}
+
+// Refund marks paid order as refunded.
+func (o *Order) Refund() error {
+	return o.transitionTo(OrderRefunded)
+}
+
+// archivableStatuses are final for payment and stock: nothing is charged, reserved or shipped anymore.
+var archivableStatuses = map[Status]bool{
+	OrderCompleted: true,
+	OrderCancelled: true,
+	OrderRefunded:  true,
+}
+
+// IsDeleted reports whether order is archived.
+func (o *Order) IsDeleted() bool {
+	return !o.DeletedAt.IsZero()
+}
+
+// Archive soft deletes order on behalf of user by. Only orders in final statuses are archived,
+// checkout and returns still in progress are checked when order is saved.
+func (o *Order) Archive(by uuid.UUID, now time.Time) error {
+	if o.IsDeleted() {
+		return fmt.Errorf("%w: order is archived already", ErrOrderNotArchivable)
+	}
+
+	if !archivableStatuses[o.Status] {
+		return fmt.Errorf("%w: order is %s", ErrOrderNotArchivable, o.Status)
+	}
+
+	o.DeletedAt = now
+	o.DeletedBy = by
+	o.UpdatedAt = now
+
+	return nil
+}
+
+// Restore brings archived order back. Anonymized order has nothing left to restore.
+func (o *Order) Restore(now time.Time) error {
+	if !o.IsDeleted() {
+		return fmt.Errorf("%w: order is not archived", ErrOrderNotRestorable)
+	}
+
+	if !o.AnonymizedAt.IsZero() {
+		return fmt.Errorf("%w: order is anonymized", ErrOrderNotRestorable)
+	}
+
+	o.DeletedAt = time.Time{}
+	o.DeletedBy = uuid.Nil
+	o.UpdatedAt = now
+
+	return nil
+}
+
+func (o *Order) transitionTo(next Status) error {
+	if o.IsDeleted() {
+		return ErrOrderArchived
+	}
+
+	if !o.Status.CanTransitionTo(next) {
+		return fmt.Errorf("%w: %s -> %s", ErrInvalidTransition, o.Status, next)
+	}
+
+	o.Status = next
+	o.UpdatedAt = time.Now().UTC()
+
+	return nil
+}
+
 type Status string
 
 const (
 	OrderPending   Status = "pending"   // Order was created but not paid.
-	OrderPaid      Status = "paid"      // Order is paid but not yet delivered.
+	OrderPaid      Status = "paid"      // Order is paid but not yet shipped.
+	OrderShipped   Status = "shipped"   // Order was handed over to delivery.
+	OrderDelivered Status = "delivered" // Order was delivered but not yet confirmed by user.
 	OrderCompleted Status = "completed" // Order was delivered and marked as completed.
 	OrderCancelled Status = "cancelled" // Order was cancelled either by user or by some reason.
+	OrderRefunded  Status = "refunded"  // Payment was returned to user.
 )
 
 var validStatuses = map[Status]bool{
 	OrderPending:   true,
 	OrderPaid:      true,
+	OrderShipped:   true,
+	OrderDelivered: true,
 	OrderCompleted: true,
 	OrderCancelled: true,
+	OrderRefunded:  true,
+}
+
+// transitions lists statuses order is allowed to move to from each status.
+// Cancelled and refunded orders are final.
+var transitions = map[Status][]Status{
+	OrderPending:   {OrderPaid, OrderCancelled},
+	OrderPaid:      {OrderShipped, OrderCancelled, OrderRefunded},
+	OrderShipped:   {OrderDelivered},
+	OrderDelivered: {OrderCompleted, OrderRefunded},
+	OrderCompleted: {OrderRefunded},
 }
 
 // NewStatus создает статус с валидацией.
@@ -145,30 +478,153 @@ func (s Status) String() string {
 	return string(s)
 }
 
+// CanTransitionTo reports whether order in status s may be moved to next.
+func (s Status) CanTransitionTo(next Status) bool {
+	for _, t := range transitions[s] {
+		if t == next {
+			return true
+		}
+	}
+	return false
+}
+
+// CanChange reports whether field of order in status s may be changed by update.
+func (s Status) CanChange(field string) bool {
+	for _, f := range mutableFields[s] {
+		if f == field {
+			return true
+		}
+	}
+	return false
+}
+
+// Item is an order line.
+//
+// Everything except ProductID and Quantity is a snapshot of product taken when order was placed,
+// so receipts and refunds don't depend on current product prices.
 type Item struct {
 	ProductID uuid.UUID
 	Quantity  uint64
+	Name      string
+	UnitPrice money.Money
+	// Discount is amount taken off the line, not percentage.
+	Discount money.Money
+	// TaxRate is percent of tax charged for the line, Tax is its amount. Tax is part of line total
+	// if TaxIncluded, otherwise it is charged on top of it. See WithTax.
+	TaxRate     float64
+	Tax         money.Money
+	TaxIncluded bool
 }
 
-func (i Item) Value() (driver.Value, error) {
-	return fmt.Sprintf("\"(%s,%d)\"", i.ProductID, i.Quantity), nil
+// NewItem snapshots product price for order line. Discount is percentage applied to the whole line,
+// rounded to minor units once per line.
+func NewItem(productId uuid.UUID, quantity uint64, name string, unitPrice money.Money, discount float64) Item {
+	return Item{
+		ProductID: productId,
+		Quantity:  quantity,
+		Name:      name,
+		UnitPrice: unitPrice,
+		Discount:  unitPrice.Mul(quantity).Percent(discount),
+	}
+}
+
+// Total is line price after discount.
+func (i Item) Total() money.Money {
+	return i.UnitPrice.Mul(i.Quantity).Sub(i.Discount)
+}
+
+// Charged is what line costs to client: its total and tax unless tax is included in it.
+func (i Item) Charged() money.Money {
+	if i.TaxIncluded {
+		return i.Total()
+	}
+	return i.Total().Add(i.Tax)
+}
+
+// WithTax taxes line at rate percent. Tax is computed from line total after discount, once per line,
+// and rounded half away from zero to minor units. Included tax is the part of total that is tax:
+// total * rate / (100 + rate).
+func (i Item) WithTax(rate float64, included bool) Item {
+	i.TaxRate = rate
+	i.TaxIncluded = included
+
+	if !included {
+		i.Tax = i.Total().Percent(rate)
+		return i
+	}
+
+	bp := money.FromFloat(rate, "").Amount // Basis points, same scale as Percent uses.
+	i.Tax = i.Total().MulRatio(bp, 100*100+bp)
+	return i
+}
+
+// WithQuantity changes quantity of line keeping its price. Discount and tax are scaled proportionally.
+func (i Item) WithQuantity(quantity uint64) Item {
+	if i.Quantity != 0 {
+		i.Discount = i.Discount.MulRatio(int64(quantity), int64(i.Quantity))
+		i.Tax = i.Tax.MulRatio(int64(quantity), int64(i.Quantity))
+	}
+	i.Quantity = quantity
+	return i
+}
+
+// Part is quantity units of line that follow its first from units. Discount and tax of consecutive parts
+// add up to those of the whole line, so no cent is lost to rounding when line is split.
+func (i Item) Part(from, quantity uint64) Item {
+	part := i.WithQuantity(from + quantity)
+	head := i.WithQuantity(from)
+	part.Discount = part.Discount.Sub(head.Discount)
+	part.Tax = part.Tax.Sub(head.Tax)
+	part.Quantity = quantity
+	return part
 }
 
 type Items []Item
 
-func (items Items) Value() (driver.Value, error) {
-	parts := make([]string, len(items))
-	for i, item := range items {
-		v, err := item.Value()
-		if err != nil {
-			return nil, err
-		}
-		parts[i] = v.(string)
+// Total is sum of line totals in given currency.
+func (items Items) Total(currency Currency) money.Money {
+	total := money.New(0, currency.String())
+	for _, item := range items {
+		total = total.Add(item.Total())
 	}
+	return total
+}
+
+// Charged is sum of what lines cost to client in given currency, see Item.Charged.
+func (items Items) Charged(currency Currency) money.Money {
+	total := money.New(0, currency.String())
+	for _, item := range items {
+		total = total.Add(item.Charged())
+	}
+	return total
+}
+
+// Tax is sum of line taxes in given currency, included ones too.
+func (items Items) Tax(currency Currency) money.Money {
+	total := money.New(0, currency.String())
+	for _, item := range items {
+		total = total.Add(item.Tax)
+	}
+	return total
+}
 
-	s := strings.Join(parts, ",")
+// Equal reports whether lines are the same, in the same order. Amounts are compared in minor units,
+// line currency is that of its unit price.
+func (items Items) Equal(other Items) bool {
+	if len(items) != len(other) {
+		return false
+	}
+
+	for i, a := range items {
+		b := other[i]
+		if a.ProductID != b.ProductID || a.Quantity != b.Quantity || a.Name != b.Name ||
+			a.UnitPrice != b.UnitPrice || a.Discount.Amount != b.Discount.Amount ||
+			a.TaxRate != b.TaxRate || a.Tax.Amount != b.Tax.Amount || a.TaxIncluded != b.TaxIncluded {
+			return false
+		}
+	}
 
-	return fmt.Sprintf("{%s}", s), nil
+	return true
 }
 
 type Currency string
@@ -262,23 +718,21 @@ func (d DeliveryMethod) String() string {
 	return string(d)
 }
 
-func ApplyDiscountTo(price, discount float64) float64 {
-	return price - (price * discount / 100)
-}
-
-type Coupon struct {
-	ID        uint
-	Code      string
-	Discount  float64
-	ValidFrom time.Time
-	ValidTo   time.Time
+// ApplyDiscountTo takes discount percent off price.
+func ApplyDiscountTo(price money.Money, discount float64) money.Money {
+	return price.Sub(price.Percent(discount))
 }
 
+// OrderEvent describes order to payment service. TotalPrice is the amount charged,
+// tax and shipping fee are its breakdown.
 type OrderEvent struct {
 	OrderID       string
 	UserID        string
 	Currency      string
 	TotalPrice    string
+	Tax           string
+	TaxIncluded   bool
+	ShippingFee   string
 	PaymentMethod string
 	Description   string
 }
@@ -293,7 +747,10 @@ func (o *Order) OrderEvent() OrderEvent {
 		OrderID:       o.ID.String(),
 		UserID:        o.UserID.String(),
 		Currency:      o.Currency.String(),
-		TotalPrice:    fmt.Sprintf("%.2f", o.TotalPrice),
+		TotalPrice:    o.TotalPrice().String(),
+		Tax:           o.Tax().String(),
+		TaxIncluded:   o.TaxIncluded(),
+		ShippingFee:   o.ShippingFee.String(),
 		PaymentMethod: o.PaymentMethod.String(),
 		Description:   o.Description,
 	}
@@ -305,6 +762,9 @@ func (e OrderEvent) MarshalJSON() ([]byte, error) {
 		UserID        string `json:"user_id"`
 		Currency      string `json:"currency"`
 		TotalPrice    string `json:"total_price"`
+		Tax           string `json:"tax"`
+		TaxIncluded   bool   `json:"tax_included"`
+		ShippingFee   string `json:"shipping_fee"`
 		PaymentMethod string `json:"payment_method"`
 		Description   string `json:"description"`
 	}{
@@ -312,6 +772,9 @@ func (e OrderEvent) MarshalJSON() ([]byte, error) {
 		UserID:        e.UserID,
 		Currency:      e.Currency,
 		TotalPrice:    e.TotalPrice,
+		Tax:           e.Tax,
+		TaxIncluded:   e.TaxIncluded,
+		ShippingFee:   e.ShippingFee,
 		PaymentMethod: e.PaymentMethod,
 		Description:   e.Description,
 	})
@@ -326,10 +789,24 @@ func (o *Order) InventoryEvent() InventoryEvent {
 
 func (e InventoryEvent) MarshalJSON() ([]byte, error) {
 	return json.Marshal(struct {
-		OrderID string `json:"order_id"`
-		Items   Items  `json:"items"`
+		OrderID string          `json:"order_id"`
+		Items   []inventoryItem `json:"items"`
 	}{
 		OrderID: e.OrderID,
-		Items:   e.Items,
+		Items:   inventoryItems(e.Items),
 	})
 }
+
+// inventoryItem is order line as inventory sees it. It only needs quantities, price snapshot is left out.
+type inventoryItem struct {
+	ProductID uuid.UUID
+	Quantity  uint64
+}
+
+func inventoryItems(items Items) []inventoryItem {
+	out := make([]inventoryItem, 0, len(items))
+	for _, i := range items {
+		out = append(out, inventoryItem{ProductID: i.ProductID, Quantity: i.Quantity})
+	}
+	return out
+}
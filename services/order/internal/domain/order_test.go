@@ -0,0 +1,386 @@
+package domain
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/dzhordano/ecom-thing/services/order/pkg/money"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrder_Transitions(t *testing.T) {
+	type transition func(o *Order) error
+
+	var (
+		markPaid transition = (*Order).MarkPaid
+		ship     transition = (*Order).Ship
+		deliver  transition = (*Order).Deliver
+		complete transition = (*Order).Complete
+		cancel   transition = (*Order).Cancel
+		refund   transition = (*Order).Refund
+	)
+
+	tests := []struct {
+		name     string
+		from     Status
+		apply    transition
+		expected Status
+		wantErr  bool
+	}{
+		{"pending -> paid", OrderPending, markPaid, OrderPaid, false},
+		{"pending -> cancelled", OrderPending, cancel, OrderCancelled, false},
+		{"pending -> shipped", OrderPending, ship, OrderPending, true},
+		{"pending -> completed", OrderPending, complete, OrderPending, true},
+		{"pending -> refunded", OrderPending, refund, OrderPending, true},
+		{"paid -> shipped", OrderPaid, ship, OrderShipped, false},
+		{"paid -> cancelled", OrderPaid, cancel, OrderCancelled, false},
+		{"paid -> refunded", OrderPaid, refund, OrderRefunded, false},
+		{"paid -> paid", OrderPaid, markPaid, OrderPaid, true},
+		{"shipped -> delivered", OrderShipped, deliver, OrderDelivered, false},
+		{"shipped -> cancelled", OrderShipped, cancel, OrderShipped, true},
+		{"delivered -> completed", OrderDelivered, complete, OrderCompleted, false},
+		{"delivered -> refunded", OrderDelivered, refund, OrderRefunded, false},
+		{"completed -> refunded", OrderCompleted, refund, OrderRefunded, false},
+		{"completed -> cancelled", OrderCompleted, cancel, OrderCompleted, true},
+		{"cancelled -> paid", OrderCancelled, markPaid, OrderCancelled, true},
+		{"refunded -> shipped", OrderRefunded, ship, OrderRefunded, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &Order{Status: tt.from}
+
+			err := tt.apply(o)
+
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidTransition)
+				assert.True(t, o.UpdatedAt.IsZero())
+			} else {
+				assert.NoError(t, err)
+				assert.False(t, o.UpdatedAt.IsZero())
+			}
+			assert.Equal(t, tt.expected, o.Status)
+		})
+	}
+}
+
+func TestOrder_TotalPrice(t *testing.T) {
+	o := &Order{Currency: RUB, Items: Items{
+		NewItem(uuid.New(), 3, "a", money.New(33, "RUB"), 10),
+		NewItem(uuid.New(), 1, "b", money.New(2000, "RUB"), 0),
+	}}
+
+	// 10% of 0.99 is 0.099, rounded once per line.
+	assert.Equal(t, money.New(10, "RUB"), o.Items[0].Discount)
+	assert.Equal(t, money.New(89, "RUB"), o.Items[0].Total())
+	assert.Equal(t, money.New(2089, "RUB"), o.TotalPrice())
+	assert.Equal(t, money.New(0, "RUB"), (&Order{Currency: RUB}).TotalPrice())
+}
+
+func TestItem_WithTax(t *testing.T) {
+	item := NewItem(uuid.New(), 3, "a", money.New(333, "USD"), 0)
+
+	// 8.25% of 9.99 is 0.824175, 20/120 of 9.99 is 1.665.
+	onTop := item.WithTax(8.25, false)
+	assert.Equal(t, money.New(82, "USD"), onTop.Tax)
+	assert.Equal(t, money.New(1081, "USD"), onTop.Charged())
+
+	included := item.WithTax(20, true)
+	assert.Equal(t, money.New(167, "USD"), included.Tax)
+	assert.Equal(t, money.New(999, "USD"), included.Charged())
+
+	o := &Order{Currency: USD, Items: Items{onTop, included}, ShippingFee: money.New(500, "USD")}
+	assert.Equal(t, money.New(249, "USD"), o.Tax())
+	assert.Equal(t, money.New(1081+999+500, "USD"), o.TotalPrice())
+}
+
+func TestItem_WithQuantity(t *testing.T) {
+	item := NewItem(uuid.New(), 3, "a", money.New(100, "RUB"), 10)
+	assert.Equal(t, money.New(30, "RUB"), item.Discount)
+
+	item = item.WithQuantity(2)
+	assert.Equal(t, uint64(2), item.Quantity)
+	assert.Equal(t, money.New(20, "RUB"), item.Discount)
+	assert.Equal(t, money.New(180, "RUB"), item.Total())
+}
+
+func TestItem_Part(t *testing.T) {
+	// 100 off 3 units doesn't split evenly, parts still add up to it.
+	item := Item{ProductID: uuid.New(), Quantity: 3, UnitPrice: money.New(1000, "USD"), Discount: money.New(100, "USD")}
+
+	item = item.WithTax(20, false)
+
+	discount, tax := money.New(0, "USD"), money.New(0, "USD")
+	for from := uint64(0); from < item.Quantity; from++ {
+		part := item.Part(from, 1)
+		assert.Equal(t, uint64(1), part.Quantity)
+		discount = discount.Add(part.Discount)
+		tax = tax.Add(part.Tax)
+	}
+	assert.Equal(t, item.Discount, discount)
+	assert.Equal(t, item.Tax, tax)
+}
+
+func TestItems_Equal(t *testing.T) {
+	items := Items{NewItem(uuid.New(), 3, "a", money.New(100, "RUB"), 10).WithTax(20, true)}
+
+	// Stored lines have currency on every amount, zero ones too.
+	stored := slices.Clone(items)
+	stored[0].Discount.Currency, stored[0].Tax.Currency = "RUB", "RUB"
+	assert.True(t, items.Equal(stored))
+
+	changed := slices.Clone(items)
+	changed[0] = changed[0].WithQuantity(2)
+	assert.False(t, items.Equal(changed))
+
+	assert.False(t, items.Equal(append(slices.Clone(items), items[0])))
+	assert.True(t, Items{}.Equal(nil))
+}
+
+func TestOrder_CancelItems(t *testing.T) {
+	var (
+		book = uuid.New()
+		pen  = uuid.New()
+	)
+
+	newOrder := func(status Status) *Order {
+		return &Order{
+			ID:       uuid.New(),
+			UserID:   uuid.New(),
+			Status:   status,
+			Currency: USD,
+			Items: Items{
+				{ProductID: book, Quantity: 3, UnitPrice: money.New(1000, "USD"), Discount: money.New(100, "USD")},
+				{ProductID: pen, Quantity: 1, UnitPrice: money.New(200, "USD"), Discount: money.New(0, "USD")},
+			},
+		}
+	}
+
+	t.Run("cancels part of line", func(t *testing.T) {
+		o := newOrder(OrderPaid)
+		before := o.TotalPrice()
+
+		c, err := o.CancelItems(Items{{ProductID: book, Quantity: 2}})
+		require.NoError(t, err)
+
+		assert.Equal(t, o.ID, c.OrderID)
+		assert.True(t, c.Paid)
+		require.Len(t, c.Items, 1)
+		assert.Equal(t, uint64(2), c.Items[0].Quantity)
+		assert.Equal(t, money.New(1933, "USD"), c.Total())
+
+		require.Len(t, o.Items, 2)
+		assert.Equal(t, uint64(1), o.Items[0].Quantity)
+		// Nothing is lost to rounding between what is kept and what is refunded.
+		assert.Equal(t, before, o.TotalPrice().Add(c.Total()))
+	})
+
+	t.Run("drops fully cancelled line", func(t *testing.T) {
+		o := newOrder(OrderPaid)
+
+		c, err := o.CancelItems(Items{{ProductID: pen, Quantity: 1}})
+		require.NoError(t, err)
+
+		assert.Equal(t, money.New(200, "USD"), c.Total())
+		require.Len(t, o.Items, 1)
+		assert.Equal(t, book, o.Items[0].ProductID)
+	})
+
+	t.Run("pending order is not refunded", func(t *testing.T) {
+		o := newOrder(OrderPending)
+
+		c, err := o.CancelItems(Items{{ProductID: book, Quantity: 1}})
+		require.NoError(t, err)
+
+		assert.False(t, c.Paid)
+		require.Len(t, c.Items, 1)
+		assert.Equal(t, uint64(1), c.Items[0].Quantity)
+		assert.Equal(t, uint64(2), o.Items[0].Quantity)
+		assert.Equal(t, uint64(1), c.StockEvent().Items[0].Quantity)
+	})
+
+	tests := []struct {
+		name    string
+		status  Status
+		items   Items
+		wantErr error
+	}{
+		{"cancelled order", OrderCancelled, Items{{ProductID: book, Quantity: 1}}, ErrItemsNotCancellable},
+		{"shipped order", OrderShipped, Items{{ProductID: book, Quantity: 1}}, ErrItemsNotCancellable},
+		{"no items", OrderPaid, Items{}, ErrInvalidArgument},
+		{"zero quantity", OrderPaid, Items{{ProductID: book, Quantity: 0}}, ErrInvalidArgument},
+		{"more than ordered", OrderPaid, Items{{ProductID: book, Quantity: 2}, {ProductID: book, Quantity: 2}}, ErrInvalidArgument},
+		{"not in order", OrderPaid, Items{{ProductID: uuid.New(), Quantity: 1}}, ErrInvalidArgument},
+		{"everything", OrderPaid, Items{{ProductID: book, Quantity: 3}, {ProductID: pen, Quantity: 1}}, ErrInvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := newOrder(tt.status)
+
+			_, err := o.CancelItems(tt.items)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, newOrder(tt.status).Items, o.Items)
+		})
+	}
+}
+
+func TestOrder_RejectItems(t *testing.T) {
+	var (
+		book = uuid.New()
+		pen  = uuid.New()
+		lock = uuid.New()
+	)
+
+	newOrder := func(status Status) *Order {
+		return &Order{
+			ID:       uuid.New(),
+			Status:   status,
+			Currency: USD,
+			Items: Items{
+				{ProductID: book, Quantity: 3, UnitPrice: money.New(1000, "USD"), Discount: money.New(0, "USD")},
+				{ProductID: pen, Quantity: 1, UnitPrice: money.New(200, "USD"), Discount: money.New(0, "USD")},
+			},
+		}
+	}
+
+	t.Run("takes off rejected units", func(t *testing.T) {
+		o := newOrder(OrderPaid)
+
+		c, err := o.RejectItems(lock, Items{{ProductID: book, Quantity: 2}})
+		require.NoError(t, err)
+
+		assert.Equal(t, lock, c.ID)
+		assert.True(t, c.Paid)
+		assert.Equal(t, RefundReasonOutOfStock, c.RefundEvent().Reason)
+		assert.Equal(t, money.New(2000, "USD"), c.Total())
+		assert.Equal(t, uint64(1), o.Items[0].Quantity)
+	})
+
+	t.Run("units already gone are skipped", func(t *testing.T) {
+		o := newOrder(OrderPending)
+
+		c, err := o.RejectItems(lock, Items{{ProductID: uuid.New(), Quantity: 1}})
+		require.NoError(t, err)
+
+		assert.Empty(t, c.Items)
+		assert.Equal(t, newOrder(OrderPending).Items, o.Items)
+	})
+
+	t.Run("nothing left", func(t *testing.T) {
+		o := newOrder(OrderPending)
+
+		_, err := o.RejectItems(lock, Items{{ProductID: book, Quantity: 3}, {ProductID: pen, Quantity: 1}})
+		assert.ErrorIs(t, err, ErrAllItemsRejected)
+		assert.Equal(t, newOrder(OrderPending).Items, o.Items)
+	})
+
+	t.Run("shipped order", func(t *testing.T) {
+		_, err := newOrder(OrderShipped).RejectItems(lock, Items{{ProductID: book, Quantity: 1}})
+		assert.ErrorIs(t, err, ErrItemsNotCancellable)
+	})
+}
+
+func TestOrder_Archive(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	by := uuid.New()
+
+	for _, status := range []Status{OrderPending, OrderPaid, OrderShipped, OrderDelivered} {
+		o := &Order{Status: status}
+		assert.ErrorIs(t, o.Archive(by, now), ErrOrderNotArchivable, status)
+		assert.False(t, o.IsDeleted())
+	}
+
+	o := &Order{Status: OrderCompleted}
+	require.NoError(t, o.Archive(by, now))
+	assert.True(t, o.IsDeleted())
+	assert.Equal(t, by, o.DeletedBy)
+	assert.Equal(t, now, o.UpdatedAt)
+
+	assert.ErrorIs(t, o.Archive(by, now), ErrOrderNotArchivable)
+	assert.ErrorIs(t, o.Refund(), ErrOrderArchived)
+	assert.ErrorIs(t, o.Clone().CheckChanges(o), ErrOrderArchived)
+
+	require.NoError(t, o.Restore(now.Add(time.Hour)))
+	assert.False(t, o.IsDeleted())
+	assert.Equal(t, uuid.Nil, o.DeletedBy)
+	assert.ErrorIs(t, o.Restore(now), ErrOrderNotRestorable)
+
+	require.NoError(t, o.Archive(by, now))
+	o.AnonymizedAt = now
+	assert.ErrorIs(t, o.Restore(now), ErrOrderNotRestorable)
+	assert.True(t, o.IsDeleted())
+}
+
+func TestOrder_ValidateChanges(t *testing.T) {
+	o := &Order{
+		Status:         OrderPending,
+		Currency:       USD,
+		PaymentMethod:  BankCard,
+		DeliveryMethod: Standard,
+		// Legacy free-form address.
+		DeliveryAddress: Address{Street: "Boston, 1 Main St"},
+		DeliveryDate:    time.Now().Add(24 * time.Hour),
+		Items:           Items{{ProductID: uuid.New(), Quantity: 1, UnitPrice: money.New(1000, "USD"), Discount: money.New(0, "USD")}},
+		ShippingFee:     money.New(0, "USD"),
+	}
+
+	assert.ErrorContains(t, o.Validate(), ErrInvalidDeliveryAddress.Error())
+	assert.NoError(t, o.ValidateChanges(o.Clone()))
+
+	before := o.Clone()
+	o.DeliveryAddress.City = "Boston"
+	assert.ErrorContains(t, o.ValidateChanges(before), ErrInvalidDeliveryAddress.Error())
+}
+
+func TestOrder_CheckChanges(t *testing.T) {
+	book := uuid.New()
+
+	tests := []struct {
+		name    string
+		status  Status
+		change  func(o *Order)
+		wantErr string
+	}{
+		{name: "pending items", status: OrderPending, change: func(o *Order) { o.Items[0].Quantity = 2 }},
+		{name: "pending payment method", status: OrderPending, change: func(o *Order) { o.PaymentMethod = BankCard }},
+		{name: "paid address", status: OrderPaid, change: func(o *Order) { o.DeliveryAddress.City = "Boston" }},
+		{name: "paid items", status: OrderPaid, change: func(o *Order) { o.Items[0].Quantity = 2 }, wantErr: "items of paid order"},
+		{name: "paid payment method", status: OrderPaid, change: func(o *Order) { o.PaymentMethod = BankCard }, wantErr: "payment_method"},
+		{name: "shipped description", status: OrderShipped, change: func(o *Order) { o.Description = "Gift" }},
+		{name: "shipped address", status: OrderShipped, change: func(o *Order) { o.DeliveryAddress.City = "Boston" }, wantErr: "delivery_address"},
+		{name: "status", status: OrderPending, change: func(o *Order) { o.Status = OrderPaid }, wantErr: "status"},
+		{name: "shipping fee follows items", status: OrderPending, change: func(o *Order) { o.ShippingFee = money.New(500, "USD") }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			before := &Order{
+				Status:          tt.status,
+				Currency:        USD,
+				PaymentMethod:   Cash,
+				DeliveryAddress: Address{City: "New York"},
+				ShippingFee:     money.New(0, "USD"),
+				Items:           Items{{ProductID: book, Quantity: 1, UnitPrice: money.New(1000, "USD")}},
+			}
+			after := before.Clone()
+			tt.change(after)
+
+			err := after.CheckChanges(before)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, ErrOrderNotMutable)
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestApplyDiscountTo(t *testing.T) {
+	assert.Equal(t, money.New(8500, "USD"), ApplyDiscountTo(money.New(10000, "USD"), 15))
+	assert.Equal(t, money.New(89, "USD"), ApplyDiscountTo(money.New(99, "USD"), 10))
+}
@@ -0,0 +1,218 @@
+package domain
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/dzhordano/ecom-thing/services/order/pkg/money"
+	"github.com/google/uuid"
+)
+
+// SortKey is order column pages are sorted by. Order id breaks ties, so sort is total.
+type SortKey string
+
+const (
+	SortByCreatedAt    SortKey = "created_at"
+	SortByTotalPrice   SortKey = "total_price"
+	SortByDeliveryDate SortKey = "delivery_date"
+	SortByRelevance    SortKey = "relevance" // Text rank of search query, see SearchParams.Query.
+)
+
+func (k SortKey) IsValid() bool {
+	switch k {
+	case SortByCreatedAt, SortByTotalPrice, SortByDeliveryDate, SortByRelevance:
+		return true
+	}
+	return false
+}
+
+type SortOrder string
+
+const (
+	SortAsc  SortOrder = "asc"
+	SortDesc SortOrder = "desc"
+)
+
+func (o SortOrder) IsValid() bool {
+	return o == SortAsc || o == SortDesc
+}
+
+var errInvalidPageToken = errors.New("invalid page token")
+
+// PageParams select a page of orders. Newest orders come first by default.
+//
+// Offset is kept for clients that don't use page tokens yet, it is ignored when PageToken is set.
+type PageParams struct {
+	Limit     uint64
+	Offset    uint64
+	SortBy    SortKey
+	SortOrder SortOrder
+	PageToken string // Returned as OrderPage.NextPageToken by previous page.
+	WithTotal bool   // Count orders matching filters, regardless of paging.
+}
+
+func NewPageParams(filters map[string]any) PageParams {
+	p := PageParams{
+		Limit:     DefaultLimit,
+		Offset:    DefaultOffset,
+		SortBy:    SortByCreatedAt,
+		SortOrder: SortDesc,
+	}
+
+	l, ok := filters["limit"].(*uint64)
+	if ok && l != nil && *l > 0 {
+		p.Limit = min(*l, MaxLimit)
+	}
+
+	o, ok := filters["offset"].(*uint64)
+	if ok && o != nil {
+		p.Offset = *o
+	}
+
+	sb, ok := filters["sortBy"].(*string)
+	if ok && sb != nil {
+		p.SortBy = SortKey(*sb)
+	}
+
+	so, ok := filters["sortOrder"].(*string)
+	if ok && so != nil {
+		p.SortOrder = SortOrder(*so)
+	}
+
+	t, ok := filters["pageToken"].(string)
+	if ok {
+		p.PageToken = t
+	}
+
+	wt, ok := filters["withTotal"].(bool)
+	if ok {
+		p.WithTotal = wt
+	}
+
+	return p
+}
+
+// Cursor decodes PageToken. Nil cursor means first page.
+func (p PageParams) Cursor() (*PageCursor, error) {
+	if p.PageToken == "" {
+		return nil, nil
+	}
+
+	c, err := DecodePageCursor(p.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	// Token is only valid for the sort it was issued for, otherwise next page would skip or repeat orders.
+	if c.SortBy != p.SortBy || c.SortOrder != p.SortOrder {
+		return nil, errInvalidPageToken
+	}
+
+	return &c, nil
+}
+
+func (p PageParams) validate() []string {
+	var errs []string
+
+	if !p.SortBy.IsValid() {
+		errs = append(errs, "invalid sort key")
+	}
+
+	if !p.SortOrder.IsValid() {
+		errs = append(errs, "invalid sort order")
+	}
+
+	if _, err := p.Cursor(); err != nil {
+		errs = append(errs, errInvalidPageToken.Error())
+	}
+
+	return errs
+}
+
+// PageCursor is position of the last order of a page: its sort key value and id.
+type PageCursor struct {
+	SortBy    SortKey   `json:"s"`
+	SortOrder SortOrder `json:"o"`
+	Value     string    `json:"v"`
+	ID        uuid.UUID `json:"id"`
+}
+
+func NewPageCursor(hit SearchHit, by SortKey, dir SortOrder) PageCursor {
+	o := hit.Order
+	c := PageCursor{SortBy: by, SortOrder: dir, ID: o.ID}
+
+	switch by {
+	case SortByRelevance:
+		c.Value = strconv.FormatFloat(float64(hit.Rank), 'g', -1, 32)
+	case SortByTotalPrice:
+		c.Value = strconv.FormatInt(o.TotalPrice().Amount, 10)
+	case SortByDeliveryDate:
+		c.Value = o.DeliveryDate.UTC().Format(time.RFC3339Nano)
+	default:
+		c.Value = o.CreatedAt.UTC().Format(time.RFC3339Nano)
+	}
+
+	return c
+}
+
+// Encode returns opaque page token. Clients must not rely on its contents.
+func (c PageCursor) Encode() string {
+	b, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func DecodePageCursor(token string) (PageCursor, error) {
+	var c PageCursor
+
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return c, errInvalidPageToken
+	}
+
+	if err := json.Unmarshal(b, &c); err != nil || !c.SortBy.IsValid() || !c.SortOrder.IsValid() {
+		return c, errInvalidPageToken
+	}
+
+	if _, err := c.SortValue(); err != nil {
+		return c, errInvalidPageToken
+	}
+
+	return c, nil
+}
+
+// SortValue is cursor value typed as column it is compared to:
+// money.Money for total price, float32 for relevance, time otherwise.
+func (c PageCursor) SortValue() (any, error) {
+	switch c.SortBy {
+	case SortByTotalPrice:
+		amount, err := strconv.ParseInt(c.Value, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		return money.New(amount, ""), nil
+	case SortByRelevance:
+		rank, err := strconv.ParseFloat(c.Value, 32)
+		if err != nil {
+			return nil, err
+		}
+		return float32(rank), nil
+	}
+
+	return time.Parse(time.RFC3339Nano, c.Value)
+}
+
+// SearchHit is an order found by search. Rank is relevance of order to search query, zero without one.
+type SearchHit struct {
+	Order *Order
+	Rank  float32
+}
+
+// OrderPage is a page of orders. Empty NextPageToken means there are no more orders.
+type OrderPage struct {
+	Orders        []*Order
+	NextPageToken string
+	Total         *uint64 // Set when requested with PageParams.WithTotal.
+}
@@ -0,0 +1,103 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dzhordano/ecom-thing/services/order/pkg/money"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewPageParams(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		p := NewPageParams(map[string]any{})
+
+		assert.Equal(t, PageParams{Limit: DefaultLimit, SortBy: SortByCreatedAt, SortOrder: SortDesc}, p)
+	})
+
+	t.Run("limit is capped", func(t *testing.T) {
+		l := uint64(MaxLimit + 1)
+		p := NewPageParams(map[string]any{"limit": &l})
+
+		assert.Equal(t, uint64(MaxLimit), p.Limit)
+	})
+}
+
+func TestPageCursor(t *testing.T) {
+	order := &Order{
+		ID:           uuid.New(),
+		Currency:     USD,
+		DeliveryDate: time.Date(2026, 10, 20, 12, 0, 0, 123000, time.UTC),
+		CreatedAt:    time.Date(2026, 10, 17, 9, 30, 0, 456000, time.UTC),
+		Items:        Items{{ProductID: uuid.New(), Quantity: 2, UnitPrice: money.New(1050, "USD")}},
+	}
+	hit := SearchHit{Order: order, Rank: 0.0607927}
+
+	tests := []struct {
+		sortBy SortKey
+		value  any
+	}{
+		{SortByCreatedAt, order.CreatedAt},
+		{SortByDeliveryDate, order.DeliveryDate},
+		{SortByTotalPrice, money.New(2100, "")},
+		{SortByRelevance, hit.Rank},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.sortBy), func(t *testing.T) {
+			params := PageParams{
+				SortBy:    tt.sortBy,
+				SortOrder: SortAsc,
+				PageToken: NewPageCursor(hit, tt.sortBy, SortAsc).Encode(),
+			}
+
+			c, err := params.Cursor()
+			require.NoError(t, err)
+			assert.Equal(t, order.ID, c.ID)
+
+			v, err := c.SortValue()
+			require.NoError(t, err)
+			assert.Equal(t, tt.value, v)
+
+			// Same token with other sort would continue from wrong position.
+			params.SortOrder = SortDesc
+			_, err = params.Cursor()
+			assert.Error(t, err)
+		})
+	}
+
+	t.Run("invalid token", func(t *testing.T) {
+		for _, token := range []string{"not base64!", "e30", NewPageCursor(hit, "price", SortAsc).Encode()} {
+			params := PageParams{SortBy: SortByCreatedAt, SortOrder: SortDesc, PageToken: token}
+			assert.NotEmpty(t, params.validate(), token)
+		}
+	})
+}
+
+func TestNewSearchParams_Sort(t *testing.T) {
+	query := "moscow"
+	filters := map[string]any{
+		"query": &query, "description": (*string)(nil), "status": (*string)(nil), "currency": (*string)(nil),
+		"minPrice": (*int64)(nil), "maxPrice": (*int64)(nil), "paymentMethod": (*string)(nil), "deliveryMethod": (*string)(nil),
+		"deliveryCountry": (*string)(nil), "deliveryCity": (*string)(nil), "deliveryPostalCode": (*string)(nil),
+		"minItemsAmount": (*uint64)(nil), "maxItemsAmount": (*uint64)(nil),
+		"productId": (*uuid.UUID)(nil), "minItemQuantity": (*uint64)(nil), "maxItemQuantity": (*uint64)(nil),
+	}
+
+	p := NewSearchParams(filters)
+	assert.Equal(t, SortByRelevance, p.SortBy)
+	assert.NoError(t, p.Validate())
+
+	sortBy := string(SortByCreatedAt)
+	filters["sortBy"] = &sortBy
+	p = NewSearchParams(filters)
+	assert.Equal(t, SortByCreatedAt, p.SortBy)
+
+	// Nothing to rank without query.
+	sortBy = string(SortByRelevance)
+	filters["query"] = (*string)(nil)
+	p = NewSearchParams(filters)
+	assert.ErrorIs(t, p.Validate(), ErrInvalidArgument)
+}
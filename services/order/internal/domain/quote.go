@@ -0,0 +1,118 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dzhordano/ecom-thing/services/order/pkg/money"
+	"github.com/google/uuid"
+)
+
+// QuoteLine is order line priced by quote.
+type QuoteLine struct {
+	Item
+	Category string
+	// Available is false if product is inactive or out of stock.
+	Available bool
+}
+
+// QuoteWarning is a problem found while quoting order. Order with warnings would be rejected.
+type QuoteWarning struct {
+	// ProductID is set if warning is about one line only.
+	ProductID uuid.UUID
+	Err       error
+}
+
+func (w QuoteWarning) Error() string {
+	if w.ProductID == uuid.Nil {
+		return w.Err.Error()
+	}
+	return w.Err.Error() + ": " + w.ProductID.String()
+}
+
+// Quote is price of order computed without placing it.
+//
+// Placed order is charged the same Total.
+type Quote struct {
+	Currency       Currency
+	DeliveryMethod DeliveryMethod
+	TaxRegion      TaxRegion
+	// Coupon is code of coupon applied to lines, empty if there is none or it is not applicable.
+	Coupon   string
+	Lines    []QuoteLine
+	Shipping money.Money
+	Warnings []QuoteWarning
+}
+
+// NewQuote starts quote of order in currency delivered with method. Lines are added by pricing.
+func NewQuote(currency, deliveryMethod string) (*Quote, error) {
+	q := &Quote{
+		Currency:       Currency(currency),
+		DeliveryMethod: DeliveryMethod(deliveryMethod),
+		TaxRegion:      TaxRegionOf(Currency(currency)),
+	}
+
+	var errs []string
+
+	if !q.Currency.IsValid() {
+		errs = append(errs, ErrInvalidCurrency.Error())
+	}
+
+	if !q.DeliveryMethod.IsValid() {
+		errs = append(errs, ErrInvalidDeliveryMethod.Error())
+	}
+
+	if len(errs) > 0 {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidArgument, strings.Join(errs, ", "))
+	}
+
+	q.Shipping = money.New(0, q.Currency.String())
+
+	return q, nil
+}
+
+// Items returns priced lines as order items.
+func (q *Quote) Items() Items {
+	items := make(Items, 0, len(q.Lines))
+	for _, line := range q.Lines {
+		items = append(items, line.Item)
+	}
+	return items
+}
+
+// Subtotal is sum of line totals after discount.
+func (q *Quote) Subtotal() money.Money {
+	return q.Items().Total(q.Currency)
+}
+
+// Discount is sum of line discounts.
+func (q *Quote) Discount() money.Money {
+	total := money.New(0, q.Currency.String())
+	for _, line := range q.Lines {
+		total = total.Add(line.Discount)
+	}
+	return total
+}
+
+// Tax is sum of line taxes, included in prices or not.
+func (q *Quote) Tax() money.Money {
+	return q.Items().Tax(q.Currency)
+}
+
+// Total is what client would pay: lines, taxes not included in their prices and shipping.
+func (q *Quote) Total() money.Money {
+	return q.Items().Charged(q.Currency).Add(q.Shipping)
+}
+
+// Warn records problem of line with given product, or of the whole order if productId is uuid.Nil.
+func (q *Quote) Warn(productId uuid.UUID, err error) {
+	q.Warnings = append(q.Warnings, QuoteWarning{ProductID: productId, Err: err})
+}
+
+// Err returns error of the first warning, nil if order can be placed.
+func (q *Quote) Err() error {
+	if len(q.Warnings) == 0 {
+		return nil
+	}
+	return q.Warnings[0].Err
+}
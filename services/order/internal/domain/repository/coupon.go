@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/dzhordano/ecom-thing/services/order/internal/domain"
+)
+
+// CouponRepository persists coupons. Redemptions are written by OrderRepository.Save
+// together with the order they are redeemed with.
+type CouponRepository interface {
+	// Save returns domain.ErrCouponAlreadyExists if coupon with same code exists.
+	Save(ctx context.Context, coupon *domain.Coupon) error
+	GetByCode(ctx context.Context, code string) (*domain.Coupon, error)
+	Update(ctx context.Context, coupon *domain.Coupon) error
+	List(ctx context.Context, activeOnly bool, limit, offset uint64) ([]*domain.Coupon, error)
+	// GetUsage returns coupon with its limit most recent redemptions.
+	GetUsage(ctx context.Context, code string, limit uint64) (*domain.CouponUsage, error)
+}
@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/dzhordano/ecom-thing/services/order/internal/domain"
+)
+
+// IdempotencyRepository stores idempotency keys. Key is unique per user and operation.
+// Key is completed in the same transaction as resource its request creates, see OrderRepository.SaveWithKey.
+type IdempotencyRepository interface {
+	// Acquire saves key as in progress. If unexpired key is already stored, it is returned
+	// and nothing is saved. Expired key is replaced, so is key of the same request left in progress after its lease.
+	Acquire(ctx context.Context, key *domain.IdempotencyKey) (*domain.IdempotencyKey, error)
+	// Release deletes key that is still held in progress by request, so request can be retried with it.
+	Release(ctx context.Context, key *domain.IdempotencyKey) error
+	// DeleteExpired deletes keys expired by now and returns their count.
+	DeleteExpired(ctx context.Context, now time.Time) (int64, error)
+}
@@ -2,19 +2,78 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/dzhordano/ecom-thing/services/order/internal/domain"
+	"github.com/google/uuid"
 )
 
+// OrderRepository persists orders. Entries passed to mutating methods are written to order history
+// in the same transaction; nil entry writes nothing.
+//
+// Save redeems order.Coupon, if set, in the same transaction. Redemption over coupon limits
+// fails with domain.ErrCouponRedemptionLimit and order is not saved.
+//
+// Save and Update book delivery slot of order if its delivery method has limited slot capacity.
+// Order that doesn't fit into its delivery day fails with domain.ErrDeliverySlotUnavailable.
+// Update books slot only if delivery method or day changed.
+//
+// Update saves order only if its version wasn't changed since order was read and bumps it.
+// Otherwise, domain.ErrOrderConflict is returned. Other mutating methods lock order and bump its version too.
 type OrderRepository interface {
-	Save(ctx context.Context, order *domain.Order) error
+	Save(ctx context.Context, order *domain.Order, entry *domain.HistoryEntry) error
+	// SaveWithKey is Save that also completes idempotency key of request creating order, so order is never
+	// saved while its key is left in progress.
+	SaveWithKey(ctx context.Context, order *domain.Order, entry *domain.HistoryEntry, key *domain.IdempotencyKey) error
 	GetById(ctx context.Context, orderId string) (*domain.Order, error)
-	ListByUser(ctx context.Context, userId string) ([]*domain.Order, error)
 
-	Search(ctx context.Context, params domain.SearchParams) ([]*domain.Order, error)
-	Update(ctx context.Context, order *domain.Order) error
-	Delete(ctx context.Context, orderId string) error
+	// Search returns page of orders matching params, sorted as params say. Page token of params must be valid.
+	// Hits are ranked by relevance to params query, if there is one.
+	Search(ctx context.Context, params domain.SearchParams) ([]domain.SearchHit, error)
+	// Count returns number of orders matching params filters, paging is ignored.
+	Count(ctx context.Context, params domain.SearchParams) (uint64, error)
 
-	GetCoupon(ctx context.Context, code string) (*domain.Coupon, error)
-	// CreateCoupon(ctx context.Context, coupon *domain.Coupon) error
+	Update(ctx context.Context, order *domain.Order, entry *domain.HistoryEntry) error
+	// UpdateItems is Update of order whose lines were changed. Events locking and releasing stock of change
+	// are written in the same transaction, empty ones are skipped. Units being locked are kept until
+	// inventory replies, see TakeStockLock.
+	UpdateItems(ctx context.Context, order *domain.Order, change *domain.StockChange, entry *domain.HistoryEntry) error
+	// TakeStockLock removes lock of units added to order by UpdateItems and returns units it was locking.
+	// Lock is taken once, domain.ErrStockLockNotFound is returned for one that is gone.
+	TakeStockLock(ctx context.Context, orderId, lockId string) (domain.Items, error)
+	// Delete archives order, see domain.Order.Archive. It is saved the way Update saves it, but only if its checkout
+	// doesn't hold stock or payment anymore and none of its returns is in progress. Otherwise,
+	// domain.ErrOrderNotArchivable is returned. Archived order is restored with Update.
+	Delete(ctx context.Context, order *domain.Order, entry *domain.HistoryEntry) error
+
+	// GetHistory returns order history oldest first. History of archived orders is kept until they are purged.
+	GetHistory(ctx context.Context, orderId string) ([]*domain.HistoryEntry, error)
+
+	// ExpirePending applies expire to up to limit pending orders created before given time, oldest first,
+	// and returns expired ones. Orders locked by another caller are skipped, so concurrent callers never expire same order.
+	// Order, its unfinished saga, history entry and stock release and order cancel events are written together.
+	// Order that fails to expire is left pending and its error is returned in failed by order id, the rest are expired.
+	// Orders with skipped ids are not looked at, so ones that failed earlier don't take up the batch.
+	ExpirePending(ctx context.Context, createdBefore time.Time, limit uint64, skip []uuid.UUID,
+		expire ExpireFunc) (expired []*domain.Order, failed map[uuid.UUID]error, err error)
+
+	// CancelItems locks order, applies cancel to it and returns saved order. Order, history entry and events
+	// releasing stock and, for paid order, requesting refund of cancelled items are written in one transaction.
+	CancelItems(ctx context.Context, orderId string, cancel CancelItemsFunc) (*domain.Order, error)
+
+	// AnonymizeArchived erases personal data of up to limit orders archived before given time and returns
+	// how many were anonymized. Owner, description and address are blanked in orders, their returns and history,
+	// amounts and lines are kept for accounting. Coupon redemptions are kept too, they count against limits.
+	AnonymizeArchived(ctx context.Context, deletedBefore time.Time, limit uint64) (int64, error)
+	// PurgeArchived deletes up to limit orders archived before given time along with their lines, returns,
+	// history and saga, and returns how many were deleted.
+	//
+	// Both skip orders locked by another caller, so concurrent callers never process same order.
+	PurgeArchived(ctx context.Context, deletedBefore time.Time, limit uint64) (int64, error)
 }
+
+// ExpireFunc changes pending order and its unfinished checkout saga, if there is one, before they are saved.
+type ExpireFunc func(order *domain.Order, saga *domain.CheckoutSaga) (*domain.HistoryEntry, error)
+
+// CancelItemsFunc cancels lines of locked order. Returned cancellation describes what was taken off it.
+type CancelItemsFunc func(order *domain.Order) (*domain.Cancellation, *domain.HistoryEntry, error)
@@ -0,0 +1,24 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/dzhordano/ecom-thing/services/order/internal/domain"
+)
+
+// ReturnRepository persists order returns. Mutating methods lock what they change,
+// so concurrent requests for the same order or return are applied one by one.
+type ReturnRepository interface {
+	// Create locks order and saves return made by create from it and its existing returns.
+	// Returns domain.ErrOrderNotFound if there is no such order.
+	Create(ctx context.Context, orderId string, create CreateReturnFunc) (*domain.Return, error)
+	GetById(ctx context.Context, returnId string) (*domain.Return, error)
+	// ListByOrder returns returns of order oldest first.
+	ListByOrder(ctx context.Context, orderId string) ([]*domain.Return, error)
+	// Update locks return, applies update to it and saves it. Return reaching received status puts its items
+	// back to stock and one reaching refunded status requests refund, events are written in the same transaction.
+	Update(ctx context.Context, returnId string, update func(r *domain.Return) error) (*domain.Return, error)
+}
+
+// CreateReturnFunc makes return of locked order. Returns lists returns already made for the order.
+type CreateReturnFunc func(order *domain.Order, returns []*domain.Return) (*domain.Return, error)
@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/dzhordano/ecom-thing/services/order/internal/domain"
+)
+
+// SagaRepository persists checkout sagas, one per order.
+type SagaRepository interface {
+	Save(ctx context.Context, saga *domain.CheckoutSaga) error
+	Get(ctx context.Context, orderId string) (*domain.CheckoutSaga, error)
+	// Update saves saga if it wasn't changed since it was read and bumps its version.
+	// Otherwise, domain.ErrSagaConflict is returned.
+	Update(ctx context.Context, saga *domain.CheckoutSaga) error
+	// ListDue returns unfinished sagas with RetryAt not after now, oldest first.
+	ListDue(ctx context.Context, now time.Time, limit uint64) ([]*domain.CheckoutSaga, error)
+}
@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/dzhordano/ecom-thing/services/order/internal/domain"
+)
+
+// ShippingRuleRepository stores shipping rules, one per delivery method.
+type ShippingRuleRepository interface {
+	// Get returns domain.ErrShippingRuleNotFound if method has no rule.
+	Get(ctx context.Context, method domain.DeliveryMethod) (*domain.ShippingRule, error)
+}
@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/dzhordano/ecom-thing/services/order/internal/domain"
+)
+
+// TaxRepository stores tax tables, one per region.
+type TaxRepository interface {
+	// GetTable returns domain.ErrTaxTableNotFound if region has no table.
+	GetTable(ctx context.Context, region domain.TaxRegion) (*domain.TaxTable, error)
+}
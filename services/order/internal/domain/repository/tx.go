@@ -0,0 +1,10 @@
+package repository
+
+import "context"
+
+// Transactor runs fn in one transaction. Repositories and outbox commands called with ctx passed to fn
+// take part in it, so their writes are committed or rolled back together.
+// Called within transaction already, fn joins it.
+type Transactor interface {
+	WithinTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
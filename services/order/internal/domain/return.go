@@ -0,0 +1,184 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/dzhordano/ecom-thing/services/order/pkg/money"
+	"github.com/google/uuid"
+)
+
+const MaxReturnReasonLength = 255
+
+type ReturnStatus string
+
+const (
+	ReturnRequested ReturnStatus = "requested" // Customer asked to return items.
+	ReturnApproved  ReturnStatus = "approved"  // Staff agreed to take items back.
+	ReturnReceived  ReturnStatus = "received"  // Items are back in stock.
+	ReturnRefunded  ReturnStatus = "refunded"  // Refund was requested from payment service.
+	ReturnRejected  ReturnStatus = "rejected"  // Staff declined return, items are returnable again.
+)
+
+var validReturnStatuses = map[ReturnStatus]bool{
+	ReturnRequested: true,
+	ReturnApproved:  true,
+	ReturnReceived:  true,
+	ReturnRefunded:  true,
+	ReturnRejected:  true,
+}
+
+// returnTransitions lists statuses return is allowed to move to from each status.
+// Refunded and rejected returns are final.
+var returnTransitions = map[ReturnStatus][]ReturnStatus{
+	ReturnRequested: {ReturnApproved, ReturnRejected},
+	ReturnApproved:  {ReturnReceived, ReturnRejected},
+	ReturnReceived:  {ReturnRefunded},
+}
+
+func NewReturnStatus(status string) (ReturnStatus, error) {
+	s := ReturnStatus(status)
+	if !validReturnStatuses[s] {
+		return "", ErrInvalidReturnStatus
+	}
+	return s, nil
+}
+
+func (s ReturnStatus) String() string {
+	return string(s)
+}
+
+// CanTransitionTo reports whether return in status s may be moved to next.
+func (s ReturnStatus) CanTransitionTo(next ReturnStatus) bool {
+	for _, t := range returnTransitions[s] {
+		if t == next {
+			return true
+		}
+	}
+	return false
+}
+
+// Return (RMA) is customer's request to send back lines of delivered order.
+//
+// Items are parts of order lines priced as they were paid, so Total is amount refunded.
+type Return struct {
+	ID        uuid.UUID
+	OrderID   uuid.UUID
+	UserID    uuid.UUID
+	Currency  Currency
+	Items     Items
+	Reason    string
+	Status    ReturnStatus
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// NewReturn requests return of items of delivered or completed order. Returns already requested for
+// the order, except rejected ones, limit quantities left to return.
+func NewReturn(order *Order, previous []*Return, items Items, reason string) (*Return, error) {
+	if order.IsDeleted() {
+		return nil, ErrOrderArchived
+	}
+
+	if order.Status != OrderDelivered && order.Status != OrderCompleted {
+		return nil, fmt.Errorf("%w: order is %s", ErrOrderNotReturnable, order.Status)
+	}
+
+	if len(reason) > MaxReturnReasonLength {
+		return nil, fmt.Errorf("%w: reason is too long", ErrInvalidArgument)
+	}
+
+	// Returned units are counted from the start of the line, each return takes the ones after previous.
+	returned := make(map[uuid.UUID]uint64, len(order.Items))
+	for _, r := range previous {
+		if r.Status == ReturnRejected {
+			continue
+		}
+		for _, item := range r.Items {
+			returned[item.ProductID] += item.Quantity
+		}
+	}
+
+	used := make([]uint64, len(order.Items))
+	left := &Order{Items: make(Items, 0, len(order.Items))}
+	for i, line := range order.Items {
+		used[i] = min(returned[line.ProductID], line.Quantity)
+		returned[line.ProductID] -= used[i]
+		if used[i] < line.Quantity {
+			left.Items = append(left.Items, line.WithQuantity(line.Quantity-used[i]))
+		}
+	}
+
+	requested, err := left.quantities(items)
+	if err != nil {
+		return nil, err
+	}
+
+	lines := make(Items, 0, len(requested))
+	for i, line := range order.Items {
+		n := min(requested[line.ProductID], line.Quantity-used[i])
+		if n == 0 {
+			continue
+		}
+		requested[line.ProductID] -= n
+		lines = append(lines, line.Part(used[i], n))
+	}
+
+	id, err := uuid.NewUUID()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+
+	return &Return{
+		ID:        id,
+		OrderID:   order.ID,
+		UserID:    order.UserID,
+		Currency:  order.Currency,
+		Items:     lines,
+		Reason:    strings.TrimSpace(reason),
+		Status:    ReturnRequested,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}, nil
+}
+
+// Total is amount to refund, taxes charged on top of lines included.
+func (r *Return) Total() money.Money {
+	return r.Items.Charged(r.Currency)
+}
+
+// TransitionTo moves return to next status following return lifecycle.
+func (r *Return) TransitionTo(next ReturnStatus) error {
+	if !r.Status.CanTransitionTo(next) {
+		return fmt.Errorf("%w: %s -> %s", ErrInvalidReturnTransition, r.Status, next)
+	}
+
+	r.Status = next
+	r.UpdatedAt = time.Now().UTC()
+
+	return nil
+}
+
+// StockEvent puts returned items back to stock.
+func (r *Return) StockEvent() StockAdjustmentEvent {
+	return StockAdjustmentEvent{
+		OrderID:      r.OrderID.String(),
+		AdjustmentID: r.ID.String(),
+		Items:        r.Items,
+	}
+}
+
+// RefundEvent requests refund of returned items. Return id is used as refund id.
+func (r *Return) RefundEvent() RefundEvent {
+	return RefundEvent{
+		RefundID: r.ID.String(),
+		OrderID:  r.OrderID.String(),
+		UserID:   r.UserID.String(),
+		Amount:   r.Total().String(),
+		Currency: r.Currency.String(),
+		Reason:   RefundReasonReturn,
+	}
+}
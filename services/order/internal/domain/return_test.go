@@ -0,0 +1,108 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/dzhordano/ecom-thing/services/order/pkg/money"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewReturn(t *testing.T) {
+	var (
+		book = uuid.New()
+		pen  = uuid.New()
+	)
+
+	order := &Order{
+		ID:       uuid.New(),
+		UserID:   uuid.New(),
+		Status:   OrderDelivered,
+		Currency: USD,
+		Items: Items{
+			{ProductID: book, Quantity: 3, UnitPrice: money.New(1000, "USD"), Discount: money.New(100, "USD")},
+			{ProductID: pen, Quantity: 2, UnitPrice: money.New(200, "USD"), Discount: money.New(0, "USD")},
+		},
+	}
+
+	t.Run("returns are limited by earlier ones", func(t *testing.T) {
+		first, err := NewReturn(order, nil, Items{{ProductID: book, Quantity: 1}}, " damaged ")
+		require.NoError(t, err)
+
+		assert.Equal(t, ReturnRequested, first.Status)
+		assert.Equal(t, order.UserID, first.UserID)
+		assert.Equal(t, "damaged", first.Reason)
+
+		second, err := NewReturn(order, []*Return{first}, Items{{ProductID: book, Quantity: 2}, {ProductID: pen, Quantity: 2}}, "")
+		require.NoError(t, err)
+		require.Len(t, second.Items, 2)
+
+		// Both returns together refund exactly what book line cost.
+		assert.Equal(t, order.Items[0].Total(), first.Total().Add(second.Items[:1].Total(USD)))
+
+		_, err = NewReturn(order, []*Return{first, second}, Items{{ProductID: book, Quantity: 1}}, "")
+		assert.ErrorIs(t, err, ErrInvalidArgument)
+
+		// Rejected returns give items back.
+		first.Status = ReturnRejected
+		_, err = NewReturn(order, []*Return{first, second}, Items{{ProductID: book, Quantity: 1}}, "")
+		assert.NoError(t, err)
+	})
+
+	t.Run("order not delivered", func(t *testing.T) {
+		paid := order.Clone()
+		paid.Status = OrderPaid
+
+		_, err := NewReturn(paid, nil, Items{{ProductID: book, Quantity: 1}}, "")
+		assert.ErrorIs(t, err, ErrOrderNotReturnable)
+	})
+
+	t.Run("reason too long", func(t *testing.T) {
+		_, err := NewReturn(order, nil, Items{{ProductID: book, Quantity: 1}}, strings.Repeat("a", MaxReturnReasonLength+1))
+		assert.ErrorIs(t, err, ErrInvalidArgument)
+	})
+
+	t.Run("product not in order", func(t *testing.T) {
+		_, err := NewReturn(order, nil, Items{{ProductID: uuid.New(), Quantity: 1}}, "")
+		assert.ErrorIs(t, err, ErrInvalidArgument)
+	})
+}
+
+func TestReturn_TransitionTo(t *testing.T) {
+	tests := []struct {
+		from    ReturnStatus
+		to      ReturnStatus
+		wantErr bool
+	}{
+		{ReturnRequested, ReturnApproved, false},
+		{ReturnRequested, ReturnRejected, false},
+		{ReturnRequested, ReturnReceived, true},
+		{ReturnApproved, ReturnReceived, false},
+		{ReturnApproved, ReturnRejected, false},
+		{ReturnApproved, ReturnRefunded, true},
+		{ReturnReceived, ReturnRefunded, false},
+		{ReturnReceived, ReturnRejected, true},
+		{ReturnRefunded, ReturnRequested, true},
+		{ReturnRejected, ReturnApproved, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.from)+" -> "+string(tt.to), func(t *testing.T) {
+			r := &Return{Status: tt.from}
+
+			err := r.TransitionTo(tt.to)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidReturnTransition)
+				assert.Equal(t, tt.from, r.Status)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.to, r.Status)
+			}
+		})
+	}
+
+	_, err := NewReturnStatus("lost")
+	assert.ErrorIs(t, err, ErrInvalidReturnStatus)
+}
@@ -0,0 +1,158 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type SagaState string
+
+const (
+	SagaReservingStock  SagaState = "reserving_stock"  // Waiting for inventory to reserve order items.
+	SagaAwaitingPayment SagaState = "awaiting_payment" // Stock is reserved, waiting for payment result.
+	SagaCommitting      SagaState = "committing"       // Order is paid, reserved stock is to be committed.
+	SagaCompleted       SagaState = "completed"        // Stock is committed, checkout is over.
+	SagaCompensating    SagaState = "compensating"     // Checkout is aborted, compensations are to be sent.
+	SagaRefunding       SagaState = "refunding"        // Compensations are sent, waiting for captured payment to be refunded.
+	SagaCompensated     SagaState = "compensated"      // Compensations are done, checkout is over.
+)
+
+// CheckoutSaga tracks order checkout across inventory and payment services.
+//
+// Each unfinished state has commands to send. They are sent again at RetryAt until saga leaves the state,
+// so receivers must apply them idempotently.
+type CheckoutSaga struct {
+	OrderID uuid.UUID
+	State   SagaState
+
+	// Tell what has to be undone when checkout is aborted.
+	PaymentRequested bool
+	StockCommitted   bool
+	// PaymentCaptured tells payment completed, so aborted checkout is over only once it's refunded.
+	PaymentCaptured bool
+
+	// Reason is why checkout was aborted.
+	Reason string
+
+	// Deadline is when waiting state times out. RetryAt is when commands of current state are sent again.
+	// Both are zero once saga is finished.
+	Deadline time.Time
+	RetryAt  time.Time
+
+	Version   int64
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func NewCheckoutSaga(orderId uuid.UUID, now time.Time) *CheckoutSaga {
+	return &CheckoutSaga{
+		OrderID:   orderId,
+		State:     SagaReservingStock,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
+
+// IsWaiting reports whether saga waits for reply from other service. Such states time out.
+// Refunding saga waits too, but it can't be given up on: refund is requested until it's confirmed.
+func (s *CheckoutSaga) IsWaiting() bool {
+	return s.State == SagaReservingStock || s.State == SagaAwaitingPayment
+}
+
+func (s *CheckoutSaga) IsFinished() bool {
+	return s.State == SagaCompleted || s.State == SagaCompensated
+}
+
+func (s *CheckoutSaga) IsTimedOut(now time.Time) bool {
+	return s.IsWaiting() && !s.Deadline.IsZero() && !now.Before(s.Deadline)
+}
+
+// StockReserved moves saga to payment step.
+func (s *CheckoutSaga) StockReserved() error {
+	if s.State != SagaReservingStock {
+		return s.unexpected("stock reserved")
+	}
+
+	s.State = SagaAwaitingPayment
+	s.PaymentRequested = true
+	return nil
+}
+
+// PaymentCompleted moves saga to stock commit step.
+func (s *CheckoutSaga) PaymentCompleted() error {
+	if s.State != SagaAwaitingPayment {
+		return s.unexpected("payment completed")
+	}
+
+	s.State = SagaCommitting
+	s.StockCommitted = true
+	s.PaymentCaptured = true
+	return nil
+}
+
+// LatePayment records payment that completed after checkout was aborted, so compensation refunds it.
+// Compensated saga is reopened to wait for the refund.
+func (s *CheckoutSaga) LatePayment() error {
+	if s.PaymentCaptured {
+		return s.unexpected("late payment")
+	}
+
+	switch s.State {
+	case SagaCompensating:
+	case SagaCompensated:
+		s.State = SagaRefunding
+	default:
+		return s.unexpected("late payment")
+	}
+
+	s.PaymentCaptured = true
+	return nil
+}
+
+// Refunded finishes compensation once captured payment is refunded.
+func (s *CheckoutSaga) Refunded() error {
+	if s.State != SagaRefunding {
+		return s.unexpected("payment refunded")
+	}
+
+	s.State = SagaCompensated
+	s.Deadline = time.Time{}
+	s.RetryAt = time.Time{}
+	return nil
+}
+
+// Abort starts compensation. Aborting already aborted saga is an error, so compensations are sent by one caller only.
+func (s *CheckoutSaga) Abort(reason string) error {
+	if s.State == SagaCompensating || s.State == SagaRefunding || s.State == SagaCompensated {
+		return s.unexpected("abort")
+	}
+
+	s.State = SagaCompensating
+	s.Reason = reason
+	return nil
+}
+
+// CommandsSent finishes states that only send commands and don't wait for reply.
+// Compensation of captured payment goes on until refund is confirmed.
+func (s *CheckoutSaga) CommandsSent() {
+	switch s.State {
+	case SagaCommitting:
+		s.State = SagaCompleted
+	case SagaCompensating:
+		s.State = SagaCompensated
+		if s.PaymentCaptured {
+			s.State = SagaRefunding
+		}
+	}
+
+	if s.IsFinished() {
+		s.Deadline = time.Time{}
+		s.RetryAt = time.Time{}
+	}
+}
+
+func (s *CheckoutSaga) unexpected(event string) error {
+	return fmt.Errorf("%w: %s in state %s", ErrUnexpectedSagaEvent, event, s.State)
+}
@@ -4,6 +4,9 @@ import (
 	"fmt"
 	"strings"
 	"time"
+
+	"github.com/dzhordano/ecom-thing/services/order/pkg/money"
+	"github.com/google/uuid"
 )
 
 const (
@@ -16,21 +19,27 @@ const (
 )
 
 type SearchParams struct {
-	Query            *string
-	Description      *string
-	Status           *string
-	Currency         *string
-	MinPrice         *float64
-	MaxPrice         *float64
-	PaymentMethod    *string
-	DeliveryMethod   *string
-	DeliveryAddress  *string
-	DeliveryDateFrom time.Time
-	DeliveryDateTo   time.Time
-	MinItemsAmount   *uint64
-	MaxItemsAmount   *uint64
-	Limit            uint64
-	Offset           uint64
+	UserID             *uuid.UUID // Restricts search to orders of given user. Set by service, not by filters.
+	Query              *string    // Web search syntax over description and delivery address. Results are ranked.
+	Description        *string
+	Status             *string
+	Currency           *string
+	MinPrice           *money.Money // Bounds of order total. Currency is not taken into account.
+	MaxPrice           *money.Money
+	PaymentMethod      *string
+	DeliveryMethod     *string
+	DeliveryCountry    *string
+	DeliveryCity       *string // Matched case-insensitively.
+	DeliveryPostalCode *string
+	DeliveryDateFrom   time.Time
+	DeliveryDateTo     time.Time
+	MinItemsAmount     *uint64
+	MaxItemsAmount     *uint64
+	ProductID          *uuid.UUID // Only orders containing the product.
+	MinItemQuantity    *uint64    // Only orders having a line (of ProductID, if set) with at least this quantity.
+	MaxItemQuantity    *uint64    // Same as above, but at most.
+	IncludeDeleted     bool       // Archived orders are matched too. Only staff may see them.
+	PageParams
 }
 
 func NewSearchParams(filters map[string]any) SearchParams {
@@ -56,14 +65,17 @@ func NewSearchParams(filters map[string]any) SearchParams {
 		s.Currency = c
 	}
 
-	mnp := filters["minPrice"].(*float64)
+	// Price bounds come in minor units.
+	mnp := filters["minPrice"].(*int64)
 	if mnp != nil {
-		s.MinPrice = mnp
+		p := money.New(*mnp, "")
+		s.MinPrice = &p
 	}
 
-	mxp := filters["maxPrice"].(*float64)
+	mxp := filters["maxPrice"].(*int64)
 	if mxp != nil {
-		s.MaxPrice = mxp
+		p := money.New(*mxp, "")
+		s.MaxPrice = &p
 	}
 
 	pm := filters["paymentMethod"].(*string)
@@ -76,9 +88,22 @@ func NewSearchParams(filters map[string]any) SearchParams {
 		s.DeliveryMethod = dm
 	}
 
-	da := filters["deliveryAddress"].(*string)
-	if da != nil {
-		s.DeliveryAddress = da
+	dc := filters["deliveryCountry"].(*string)
+	if dc != nil {
+		c := strings.ToUpper(strings.TrimSpace(*dc))
+		s.DeliveryCountry = &c
+	}
+
+	dci := filters["deliveryCity"].(*string)
+	if dci != nil {
+		c := strings.TrimSpace(*dci)
+		s.DeliveryCity = &c
+	}
+
+	dpc := filters["deliveryPostalCode"].(*string)
+	if dpc != nil {
+		pc := strings.ToUpper(strings.TrimSpace(*dpc))
+		s.DeliveryPostalCode = &pc
 	}
 
 	dFrom, ok := filters["deliveryDateFrom"].(time.Time)
@@ -101,24 +126,34 @@ func NewSearchParams(filters map[string]any) SearchParams {
 		s.MaxItemsAmount = mxia
 	}
 
-	l := filters["limit"].(*uint64)
-	if l != nil {
-		s.Limit = min(*l, MaxLimit)
-	} else {
-		s.Limit = DefaultLimit
+	pid := filters["productId"].(*uuid.UUID)
+	if pid != nil {
+		s.ProductID = pid
 	}
 
-	o := filters["offset"].(*uint64)
-	if o != nil {
-		s.Offset = *o
-	} else {
-		s.Offset = DefaultOffset
+	mniq := filters["minItemQuantity"].(*uint64)
+	if mniq != nil {
+		s.MinItemQuantity = mniq
+	}
+
+	mxiq := filters["maxItemQuantity"].(*uint64)
+	if mxiq != nil {
+		s.MaxItemQuantity = mxiq
+	}
+
+	s.IncludeDeleted, _ = filters["includeDeleted"].(bool)
+
+	s.PageParams = NewPageParams(filters)
+
+	// Text matches come best first unless caller asked for another sort.
+	if sb, _ := filters["sortBy"].(*string); s.Query != nil && sb == nil {
+		s.SortBy = SortByRelevance
 	}
 
 	// In case you wanna look:
 	// log.Printf(
-	// 	"search params:\n query=%v\n description%v\n status=%v\n currency=%v\n minPrice=%v\n maxPrice=%v\n deliveryMethod=%v\n paymentMethod=%v\n deliveryAddress=%v\n deliveryDateFrom=%v\n deliveryDateTo=%v\n minItemsAmount=%v\n maxItemsAmount=%v\n limit=%d\n offset=%d\n",
-	// 	*s.Query, *s.Description, *s.Status, *s.Currency, *s.MinPrice, *s.MaxPrice, *s.DeliveryMethod, *s.PaymentMethod, *s.DeliveryAddress, s.DeliveryDateFrom, s.DeliveryDateTo, *s.MinItemsAmount, *s.MaxItemsAmount, s.Limit, s.Offset,
+	// 	"search params:\n query=%v\n description%v\n status=%v\n currency=%v\n minPrice=%v\n maxPrice=%v\n deliveryMethod=%v\n paymentMethod=%v\n deliveryCountry=%v deliveryCity=%v deliveryPostalCode=%v\n deliveryDateFrom=%v\n deliveryDateTo=%v\n minItemsAmount=%v\n maxItemsAmount=%v\n limit=%d\n offset=%d\n",
+	// 	*s.Query, *s.Description, *s.Status, *s.Currency, *s.MinPrice, *s.MaxPrice, *s.DeliveryMethod, *s.PaymentMethod, *s.DeliveryCountry, *s.DeliveryCity, *s.DeliveryPostalCode, s.DeliveryDateFrom, s.DeliveryDateTo, *s.MinItemsAmount, *s.MaxItemsAmount, s.Limit, s.Offset,
 	// )
 
 	return s
@@ -163,9 +198,16 @@ func (o *SearchParams) Validate() error {
 		}
 	}
 
-	// TODO валидировать адрес при создании соответствующего метода.
-	if o.DeliveryAddress != nil && len(*o.DeliveryAddress) < MinAddressLength {
-		errs = append(errs, "invalid delivery address")
+	if o.DeliveryCountry != nil && !countryCodeRe.MatchString(*o.DeliveryCountry) {
+		errs = append(errs, "invalid delivery country")
+	}
+
+	if o.DeliveryCity != nil && (*o.DeliveryCity == "" || len(*o.DeliveryCity) > MaxAddressFieldLength) {
+		errs = append(errs, "invalid delivery city")
+	}
+
+	if o.DeliveryPostalCode != nil && (*o.DeliveryPostalCode == "" || len(*o.DeliveryPostalCode) > MaxPostalCodeLength) {
+		errs = append(errs, "invalid delivery postal code")
 	}
 
 	if !o.DeliveryDateTo.IsZero() {
@@ -174,10 +216,24 @@ func (o *SearchParams) Validate() error {
 		}
 	}
 
-	if o.MinItemsAmount != nil && *o.MinItemsAmount > *o.MaxItemsAmount {
+	if o.MinPrice != nil && o.MaxPrice != nil && o.MinPrice.Cmp(*o.MaxPrice) > 0 {
+		errs = append(errs, "invalid price range")
+	}
+
+	if o.MinItemsAmount != nil && o.MaxItemsAmount != nil && *o.MinItemsAmount > *o.MaxItemsAmount {
 		errs = append(errs, "invalid items amount range")
 	}
 
+	if o.MinItemQuantity != nil && o.MaxItemQuantity != nil && *o.MinItemQuantity > *o.MaxItemQuantity {
+		errs = append(errs, "invalid item quantity range")
+	}
+
+	if o.SortBy == SortByRelevance && o.Query == nil {
+		errs = append(errs, "relevance sort requires query")
+	}
+
+	errs = append(errs, o.PageParams.validate()...)
+
 	if len(errs) > 0 {
 		return fmt.Errorf("%w: %s", ErrInvalidArgument, strings.Join(errs, ", "))
 	}
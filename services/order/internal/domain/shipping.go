@@ -0,0 +1,118 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/dzhordano/ecom-thing/services/order/pkg/money"
+)
+
+const day = 24 * time.Hour
+
+// ShippingRule prices and schedules delivery with one method.
+//
+// Amounts are taken as is in order currency, like product prices. Days are counted in UTC.
+type ShippingRule struct {
+	Method  DeliveryMethod
+	BaseFee money.Money
+	// Tiers replace base fee for orders of many units. The last tier order reaches wins.
+	Tiers []ShippingTier
+	// FreeFrom is subtotal after discount from which delivery is free. Zero means never.
+	FreeFrom money.Money
+	// Delivery date has to be between MinDays and MaxDays days from today. Zero MaxDays means no limit.
+	MinDays uint
+	MaxDays uint
+	// SlotCapacity is number of orders delivered with method a day. Zero means unlimited.
+	SlotCapacity uint64
+}
+
+// ShippingTier is fee of orders with at least MinItems units.
+type ShippingTier struct {
+	MinItems uint64
+	Fee      money.Money
+}
+
+func (r *ShippingRule) Validate() error {
+	var errs []string
+
+	if !r.Method.IsValid() {
+		errs = append(errs, ErrInvalidDeliveryMethod.Error())
+	}
+
+	if r.BaseFee.IsNegative() || r.FreeFrom.IsNegative() {
+		errs = append(errs, ErrInvalidPrice.Error())
+	}
+
+	for i, tier := range r.Tiers {
+		if tier.Fee.IsNegative() || (i > 0 && tier.MinItems <= r.Tiers[i-1].MinItems) {
+			errs = append(errs, "invalid shipping tiers")
+			break
+		}
+	}
+
+	if r.MaxDays != 0 && r.MaxDays < r.MinDays {
+		errs = append(errs, "invalid delivery window")
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("%w: %s", ErrInvalidArgument, strings.Join(errs, ", "))
+	}
+
+	return nil
+}
+
+// Fee returns price of delivering items in currency.
+func (r *ShippingRule) Fee(items Items, currency Currency) money.Money {
+	if r.FreeFrom.IsPositive() && items.Total(currency).Amount >= r.FreeFrom.Amount {
+		return money.New(0, currency.String())
+	}
+
+	var units uint64
+	for _, item := range items {
+		units += item.Quantity
+	}
+
+	fee := r.BaseFee
+	for _, tier := range r.Tiers {
+		if units >= tier.MinItems {
+			fee = tier.Fee
+		}
+	}
+
+	return money.New(fee.Amount, currency.String())
+}
+
+// Window returns first day of delivery for order placed at now and the day after the last one.
+// The latter is zero if there is no limit.
+func (r *ShippingRule) Window(now time.Time) (from, to time.Time) {
+	today := now.UTC().Truncate(day)
+
+	from = today.AddDate(0, 0, int(r.MinDays))
+	if r.MaxDays != 0 {
+		to = today.AddDate(0, 0, int(r.MaxDays)+1)
+	}
+
+	return from, to
+}
+
+// CheckDate tells if order placed at now may be delivered on date.
+func (r *ShippingRule) CheckDate(date, now time.Time) error {
+	from, to := r.Window(now)
+
+	if date.Before(from) {
+		return fmt.Errorf("%w: %s delivery is possible from %s", ErrDeliveryDateUnavailable, r.Method, from.Format(time.DateOnly))
+	}
+
+	if !to.IsZero() && !date.Before(to) {
+		return fmt.Errorf("%w: %s delivery is possible until %s", ErrDeliveryDateUnavailable, r.Method, to.Add(-day).Format(time.DateOnly))
+	}
+
+	return nil
+}
+
+// DeliverySlot returns bounds of the day order is delivered on. Slot capacity is shared by orders of that day.
+func DeliverySlot(date time.Time) (from, to time.Time) {
+	from = date.UTC().Truncate(day)
+	return from, from.Add(day)
+}
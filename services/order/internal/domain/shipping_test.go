@@ -0,0 +1,71 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dzhordano/ecom-thing/services/order/pkg/money"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShippingRule_Fee(t *testing.T) {
+	rule := ShippingRule{
+		Method:  Standard,
+		BaseFee: money.New(500, ""),
+		Tiers: []ShippingTier{
+			{MinItems: 5, Fee: money.New(800, "")},
+			{MinItems: 10, Fee: money.New(1200, "")},
+		},
+		FreeFrom: money.New(10000, ""),
+	}
+
+	lines := func(quantity uint64, price int64) Items {
+		return Items{NewItem(uuid.New(), quantity, "Product", money.New(price, "USD"), 0)}
+	}
+
+	tests := []struct {
+		name  string
+		items Items
+		want  int64
+	}{
+		{"base fee", lines(4, 100), 500},
+		{"first tier", lines(5, 100), 800},
+		{"last reached tier", lines(12, 100), 1200},
+		{"free from threshold", lines(1, 10000), 0},
+		{"just below threshold", lines(1, 9999), 500},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, money.New(tt.want, "USD"), rule.Fee(tt.items, USD))
+		})
+	}
+}
+
+func TestShippingRule_CheckDate(t *testing.T) {
+	rule := ShippingRule{Method: Express, MinDays: 1, MaxDays: 3}
+	now := time.Date(2025, 3, 10, 15, 0, 0, 0, time.UTC)
+
+	assert.ErrorIs(t, rule.CheckDate(now.Add(time.Hour), now), ErrDeliveryDateUnavailable)
+	assert.NoError(t, rule.CheckDate(time.Date(2025, 3, 11, 0, 0, 0, 0, time.UTC), now))
+	assert.NoError(t, rule.CheckDate(time.Date(2025, 3, 13, 23, 59, 0, 0, time.UTC), now))
+	assert.ErrorIs(t, rule.CheckDate(time.Date(2025, 3, 14, 0, 0, 0, 0, time.UTC), now), ErrDeliveryDateUnavailable)
+
+	unlimited := ShippingRule{Method: Standard}
+	assert.NoError(t, unlimited.CheckDate(now.AddDate(1, 0, 0), now))
+}
+
+func TestShippingRule_Validate(t *testing.T) {
+	valid := ShippingRule{Method: Standard, Tiers: []ShippingTier{{MinItems: 2}, {MinItems: 5}}, MinDays: 1, MaxDays: 1}
+	assert.NoError(t, valid.Validate())
+
+	unordered := ShippingRule{Method: Standard, Tiers: []ShippingTier{{MinItems: 5}, {MinItems: 2}}}
+	assert.ErrorIs(t, unordered.Validate(), ErrInvalidArgument)
+
+	window := ShippingRule{Method: Standard, MinDays: 3, MaxDays: 2}
+	assert.ErrorIs(t, window.Validate(), ErrInvalidArgument)
+
+	negative := ShippingRule{Method: Standard, BaseFee: money.New(-1, "")}
+	assert.ErrorIs(t, negative.Validate(), ErrInvalidArgument)
+}
@@ -0,0 +1,86 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+)
+
+const MaxTaxRate = 100
+
+// TaxRegion is region order is delivered to, taxes of its lines depend on it.
+type TaxRegion string
+
+const (
+	RegionRU TaxRegion = "RU"
+	RegionEU TaxRegion = "EU"
+	RegionUS TaxRegion = "US"
+)
+
+// marketRegions maps currency to region of market it is used in.
+var marketRegions = map[Currency]TaxRegion{
+	RUB: RegionRU,
+	EUR: RegionEU,
+	USD: RegionUS,
+}
+
+// TaxRegionOf returns region order in currency is taxed in. Quotes are made before delivery address is known,
+// so market of currency stands for destination.
+func TaxRegionOf(currency Currency) TaxRegion {
+	return marketRegions[currency]
+}
+
+func (r TaxRegion) String() string {
+	return string(r)
+}
+
+// TaxTable is tax rates of one region, in percent, keyed by product category.
+type TaxTable struct {
+	Region TaxRegion
+	// Included is true for VAT regions, where prices already contain tax. Otherwise tax is charged on top of prices.
+	Included bool
+	// DefaultRate applies to categories without rate of their own.
+	DefaultRate float64
+	Rates       map[string]float64
+}
+
+// NoTaxes is table of region that charges no taxes.
+func NoTaxes(region TaxRegion) *TaxTable {
+	return &TaxTable{Region: region}
+}
+
+func (t *TaxTable) Validate() error {
+	var errs []string
+
+	valid := func(rate float64) bool {
+		return rate >= 0 && rate <= MaxTaxRate
+	}
+
+	if !valid(t.DefaultRate) {
+		errs = append(errs, fmt.Sprintf("invalid default tax rate %v", t.DefaultRate))
+	}
+
+	for category, rate := range t.Rates {
+		if !valid(rate) {
+			errs = append(errs, fmt.Sprintf("invalid tax rate %v of %q", rate, category))
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("%w: %s", ErrInvalidArgument, strings.Join(errs, ", "))
+	}
+
+	return nil
+}
+
+// Rate returns tax rate of products of category.
+func (t *TaxTable) Rate(category string) float64 {
+	if rate, ok := t.Rates[category]; ok {
+		return rate
+	}
+	return t.DefaultRate
+}
+
+// Apply taxes line of product of category. See Item.WithTax for rounding.
+func (t *TaxTable) Apply(line Item, category string) Item {
+	return line.WithTax(t.Rate(category), t.Included)
+}
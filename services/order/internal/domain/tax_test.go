@@ -0,0 +1,28 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTaxTable_Rate(t *testing.T) {
+	table := TaxTable{Region: RegionRU, Included: true, DefaultRate: 20, Rates: map[string]float64{"books": 10, "exempt": 0}}
+
+	assert.Equal(t, 10.0, table.Rate("books"))
+	assert.Equal(t, 0.0, table.Rate("exempt"))
+	assert.Equal(t, 20.0, table.Rate("toys"))
+	assert.Equal(t, 0.0, NoTaxes(RegionUS).Rate("toys"))
+}
+
+func TestTaxTable_Validate(t *testing.T) {
+	assert.NoError(t, (&TaxTable{Region: RegionEU, DefaultRate: 20, Rates: map[string]float64{"food": 7}}).Validate())
+	assert.ErrorIs(t, (&TaxTable{Region: RegionEU, DefaultRate: -1}).Validate(), ErrInvalidArgument)
+	assert.ErrorIs(t, (&TaxTable{Region: RegionEU, Rates: map[string]float64{"food": 101}}).Validate(), ErrInvalidArgument)
+}
+
+func TestTaxRegionOf(t *testing.T) {
+	assert.Equal(t, RegionRU, TaxRegionOf(RUB))
+	assert.Equal(t, RegionEU, TaxRegionOf(EUR))
+	assert.Equal(t, RegionUS, TaxRegionOf(USD))
+}
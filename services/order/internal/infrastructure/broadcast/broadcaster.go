@@ -0,0 +1,92 @@
+package broadcast
+
+import (
+	"sync"
+
+	"github.com/dzhordano/ecom-thing/services/order/internal/application/interfaces"
+	"github.com/dzhordano/ecom-thing/services/order/internal/domain"
+	"github.com/google/uuid"
+)
+
+// Broadcaster is in-process interfaces.OrderBroadcaster.
+//
+// Every subscriber has a single slot buffer. Publish never blocks: update that doesn't fit replaces
+// pending one, which is fine as every update carries whole order.
+type Broadcaster struct {
+	mu     sync.Mutex
+	subs   map[uuid.UUID]map[chan *domain.Order]struct{}
+	closed bool
+}
+
+var _ interfaces.OrderBroadcaster = (*Broadcaster)(nil)
+
+func NewBroadcaster() *Broadcaster {
+	return &Broadcaster{
+		subs: make(map[uuid.UUID]map[chan *domain.Order]struct{}),
+	}
+}
+
+// Publish implements interfaces.OrderBroadcaster.
+func (b *Broadcaster) Publish(order *domain.Order) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for ch := range b.subs[order.ID] {
+		// Channels are only sent to under the lock, so after draining the slot is free.
+		select {
+		case ch <- order:
+		default:
+			select {
+			case <-ch:
+			default:
+			}
+			ch <- order
+		}
+	}
+}
+
+// Subscribe implements interfaces.OrderBroadcaster. Subscription to closed broadcaster is closed right away.
+func (b *Broadcaster) Subscribe(orderId uuid.UUID) (<-chan *domain.Order, func()) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	ch := make(chan *domain.Order, 1)
+	if b.closed {
+		close(ch)
+		return ch, func() {}
+	}
+
+	if b.subs[orderId] == nil {
+		b.subs[orderId] = make(map[chan *domain.Order]struct{})
+	}
+	b.subs[orderId][ch] = struct{}{}
+
+	return ch, func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+
+		if _, ok := b.subs[orderId][ch]; !ok {
+			return // Closed by Close already.
+		}
+
+		delete(b.subs[orderId], ch)
+		if len(b.subs[orderId]) == 0 {
+			delete(b.subs, orderId)
+		}
+		close(ch)
+	}
+}
+
+// Close ends all subscriptions, so streams fed by them finish and server can stop gracefully.
+func (b *Broadcaster) Close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.closed = true
+	for orderId, subs := range b.subs {
+		for ch := range subs {
+			close(ch)
+		}
+		delete(b.subs, orderId)
+	}
+}
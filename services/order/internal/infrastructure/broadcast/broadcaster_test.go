@@ -0,0 +1,42 @@
+package broadcast
+
+import (
+	"testing"
+
+	"github.com/dzhordano/ecom-thing/services/order/internal/domain"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBroadcaster(t *testing.T) {
+	b := NewBroadcaster()
+	orderId := uuid.New()
+
+	first, unsubscribe := b.Subscribe(orderId)
+	second, _ := b.Subscribe(orderId)
+	other, _ := b.Subscribe(uuid.New())
+
+	// Nobody reads, later update replaces pending one.
+	b.Publish(&domain.Order{ID: orderId, Status: domain.OrderPending})
+	b.Publish(&domain.Order{ID: orderId, Status: domain.OrderPaid})
+
+	assert.Equal(t, domain.OrderPaid, (<-first).Status)
+	assert.Equal(t, domain.OrderPaid, (<-second).Status)
+	assert.Empty(t, other)
+
+	unsubscribe()
+	_, ok := <-first
+	assert.False(t, ok)
+
+	b.Close()
+	_, ok = <-second
+	assert.False(t, ok)
+	_, ok = <-other
+	assert.False(t, ok)
+
+	// Unsubscribing after close and subscribing to closed broadcaster are safe.
+	unsubscribe()
+	late, _ := b.Subscribe(orderId)
+	_, ok = <-late
+	assert.False(t, ok)
+}
@@ -0,0 +1,101 @@
+package broadcast
+
+import (
+	"context"
+	"time"
+
+	"github.com/dzhordano/ecom-thing/services/order/internal/application/interfaces"
+	"github.com/dzhordano/ecom-thing/services/order/internal/domain"
+	"github.com/dzhordano/ecom-thing/services/order/internal/domain/repository"
+	"github.com/dzhordano/ecom-thing/services/order/internal/infrastructure/repository/pg"
+	"github.com/google/uuid"
+)
+
+// orderRepository publishes orders saved through it. Every change of order goes through repository,
+// whether it comes from API, checkout events consumed from Kafka or background workers.
+type orderRepository struct {
+	repository.OrderRepository
+	b interfaces.OrderBroadcaster
+}
+
+// NewOrderRepository wraps repo, so watchers are notified of orders it saves.
+func NewOrderRepository(repo repository.OrderRepository, b interfaces.OrderBroadcaster) repository.OrderRepository {
+	return &orderRepository{
+		OrderRepository: repo,
+		b:               b,
+	}
+}
+
+func (r *orderRepository) Save(ctx context.Context, order *domain.Order, entry *domain.HistoryEntry) error {
+	if err := r.OrderRepository.Save(ctx, order, entry); err != nil {
+		return err
+	}
+
+	r.publish(ctx, order)
+	return nil
+}
+
+func (r *orderRepository) SaveWithKey(ctx context.Context, order *domain.Order, entry *domain.HistoryEntry, key *domain.IdempotencyKey) error {
+	if err := r.OrderRepository.SaveWithKey(ctx, order, entry, key); err != nil {
+		return err
+	}
+
+	r.publish(ctx, order)
+	return nil
+}
+
+func (r *orderRepository) Update(ctx context.Context, order *domain.Order, entry *domain.HistoryEntry) error {
+	if err := r.OrderRepository.Update(ctx, order, entry); err != nil {
+		return err
+	}
+
+	r.publish(ctx, order)
+	return nil
+}
+
+func (r *orderRepository) UpdateItems(ctx context.Context, order *domain.Order, change *domain.StockChange, entry *domain.HistoryEntry) error {
+	if err := r.OrderRepository.UpdateItems(ctx, order, change, entry); err != nil {
+		return err
+	}
+
+	r.publish(ctx, order)
+	return nil
+}
+
+func (r *orderRepository) Delete(ctx context.Context, order *domain.Order, entry *domain.HistoryEntry) error {
+	if err := r.OrderRepository.Delete(ctx, order, entry); err != nil {
+		return err
+	}
+
+	r.publish(ctx, order)
+	return nil
+}
+
+func (r *orderRepository) ExpirePending(ctx context.Context, createdBefore time.Time, limit uint64, skip []uuid.UUID,
+	expire repository.ExpireFunc) ([]*domain.Order, map[uuid.UUID]error, error) {
+	orders, failed, err := r.OrderRepository.ExpirePending(ctx, createdBefore, limit, skip, expire)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, order := range orders {
+		r.publish(ctx, order)
+	}
+	return orders, failed, nil
+}
+
+func (r *orderRepository) CancelItems(ctx context.Context, orderId string, cancel repository.CancelItemsFunc) (*domain.Order, error) {
+	order, err := r.OrderRepository.CancelItems(ctx, orderId, cancel)
+	if err != nil {
+		return nil, err
+	}
+
+	r.publish(ctx, order)
+	return order, nil
+}
+
+// publish notifies watchers of saved order. Order saved within transaction is published once it commits.
+func (r *orderRepository) publish(ctx context.Context, order *domain.Order) {
+	o := order.Clone()
+	pg.AfterCommit(ctx, func() { r.b.Publish(o) })
+}
@@ -0,0 +1,261 @@
+package cache
+
+import (
+	"container/list"
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/dzhordano/ecom-thing/services/order/internal/application/dto"
+	"github.com/dzhordano/ecom-thing/services/order/internal/application/interfaces"
+	"github.com/dzhordano/ecom-thing/services/order/internal/infrastructure"
+	"github.com/dzhordano/ecom-thing/services/order/pkg/logger"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ProductCache keeps products looked up through product service in memory, least recently used ones are
+// evicted once it's full. Product is served from cache for ttl and is looked up again after that. If product
+// service is unavailable then, product is still served for up to maxStale longer.
+//
+// Changed products are dropped early by Invalidate. Products that don't exist are not cached.
+type ProductCache struct {
+	log      logger.Logger
+	products interfaces.ProductService
+	size     int
+	ttl      time.Duration
+	maxStale time.Duration
+
+	mu      sync.Mutex
+	entries map[uuid.UUID]*list.Element
+	// Most recently used first.
+	lru *list.List
+	// Bumped on every invalidation, so products looked up before it are not cached after it.
+	gen uint64
+
+	now func() time.Time
+}
+
+type entry struct {
+	id        uuid.UUID
+	product   dto.ProductInfo
+	fetchedAt time.Time
+}
+
+// NewProductCache wraps products, so up to size of products it returns are cached.
+func NewProductCache(log logger.Logger, products interfaces.ProductService, size int, ttl, maxStale time.Duration) interfaces.ProductCache {
+	return &ProductCache{
+		log:      log,
+		products: products,
+		size:     size,
+		ttl:      ttl,
+		maxStale: maxStale,
+		entries:  make(map[uuid.UUID]*list.Element, size),
+		lru:      list.New(),
+		now:      time.Now,
+	}
+}
+
+func (c *ProductCache) GetProductInfo(ctx context.Context, productId uuid.UUID) (*dto.ProductInfo, error) {
+	gen := c.generation()
+
+	cached, ok := c.get(productId)
+	if ok && c.fresh(cached) {
+		infrastructure.IncProductCacheCounter.WithLabelValues("hit").Inc()
+		return cached.info(), nil
+	}
+
+	product, err := c.products.GetProductInfo(ctx, productId)
+	switch {
+	case err == nil:
+		c.put(gen, productId, product)
+	case status.Code(err) == codes.NotFound:
+		c.remove(productId)
+	case unavailable(err):
+		if cached, ok := c.get(productId); ok {
+			c.log.Warn("product service unavailable, serving cached product", "error", err, "product_id", productId)
+			c.served(cached)
+			return cached.info(), nil
+		}
+	}
+
+	infrastructure.IncProductCacheCounter.WithLabelValues("miss").Inc()
+	return product, err
+}
+
+func (c *ProductCache) GetProductsInfo(ctx context.Context, productIds []uuid.UUID) (map[uuid.UUID]*dto.ProductInfo, error) {
+	gen := c.generation()
+
+	products := make(map[uuid.UUID]*dto.ProductInfo, len(productIds))
+	seen := make(map[uuid.UUID]bool, len(productIds))
+
+	var missed []uuid.UUID
+	for _, id := range productIds {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+
+		if cached, ok := c.get(id); ok && c.fresh(cached) {
+			products[id] = cached.info()
+		} else {
+			missed = append(missed, id)
+		}
+	}
+
+	infrastructure.IncProductCacheCounter.WithLabelValues("hit").Add(float64(len(products)))
+	if len(missed) == 0 {
+		return products, nil
+	}
+
+	fetched, err := c.products.GetProductsInfo(ctx, missed)
+	if err != nil {
+		if !unavailable(err) {
+			infrastructure.IncProductCacheCounter.WithLabelValues("miss").Add(float64(len(missed)))
+			return nil, err
+		}
+
+		// Either all missed products are served stale or none, order can't be priced partially anyway.
+		stale := make([]entry, 0, len(missed))
+		for _, id := range missed {
+			cached, ok := c.get(id)
+			if !ok {
+				infrastructure.IncProductCacheCounter.WithLabelValues("miss").Add(float64(len(missed)))
+				return nil, err
+			}
+			stale = append(stale, cached)
+		}
+
+		c.log.Warn("product service unavailable, serving cached products", "error", err, "products", len(stale))
+		for _, cached := range stale {
+			c.served(cached)
+			products[cached.id] = cached.info()
+		}
+
+		return products, nil
+	}
+
+	infrastructure.IncProductCacheCounter.WithLabelValues("miss").Add(float64(len(missed)))
+	for _, id := range missed {
+		product, ok := fetched[id]
+		if !ok {
+			c.remove(id)
+			continue
+		}
+
+		c.put(gen, id, product)
+		products[id] = product
+	}
+
+	return products, nil
+}
+
+// Invalidate implements interfaces.ProductCache.
+func (c *ProductCache) Invalidate(productId uuid.UUID) {
+	c.mu.Lock()
+	c.gen++
+	c.mu.Unlock()
+
+	c.remove(productId)
+	infrastructure.IncProductCacheInvalidationsCounter.Inc()
+}
+
+func (c *ProductCache) generation() uint64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.gen
+}
+
+// get returns cached product, fresh or not. Products past max staleness are dropped.
+func (c *ProductCache) get(productId uuid.UUID) (entry, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, ok := c.entries[productId]
+	if !ok {
+		return entry{}, false
+	}
+
+	e := el.Value.(*entry)
+	if c.now().Sub(e.fetchedAt) >= c.ttl+c.maxStale {
+		c.lru.Remove(el)
+		delete(c.entries, productId)
+		return entry{}, false
+	}
+
+	c.lru.MoveToFront(el)
+	return *e, true
+}
+
+// put caches product unless cache was invalidated since generation gen.
+func (c *ProductCache) put(gen uint64, productId uuid.UUID, product *dto.ProductInfo) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.gen != gen {
+		return
+	}
+
+	if el, ok := c.entries[productId]; ok {
+		e := el.Value.(*entry)
+		e.product = *product
+		e.fetchedAt = c.now()
+		c.lru.MoveToFront(el)
+		return
+	}
+
+	c.entries[productId] = c.lru.PushFront(&entry{
+		id:        productId,
+		product:   *product,
+		fetchedAt: c.now(),
+	})
+
+	if c.lru.Len() > c.size {
+		oldest := c.lru.Back()
+		c.lru.Remove(oldest)
+		delete(c.entries, oldest.Value.(*entry).id)
+	}
+}
+
+func (c *ProductCache) remove(productId uuid.UUID) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if el, ok := c.entries[productId]; ok {
+		c.lru.Remove(el)
+		delete(c.entries, productId)
+	}
+}
+
+func (c *ProductCache) fresh(e entry) bool {
+	return c.now().Sub(e.fetchedAt) < c.ttl
+}
+
+// served records stale product was served.
+func (c *ProductCache) served(e entry) {
+	infrastructure.IncProductCacheCounter.WithLabelValues("stale").Inc()
+	infrastructure.HistProductCacheStaleness.Observe(max(c.now().Sub(e.fetchedAt)-c.ttl, 0).Seconds())
+}
+
+// info returns copy of cached product, so callers can't change cache.
+func (e entry) info() *dto.ProductInfo {
+	product := e.product
+	return &product
+}
+
+// unavailable tells whether err means product service couldn't answer, rather than it refused request.
+func unavailable(err error) bool {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted, codes.Aborted:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,341 @@
+package cache
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/dzhordano/ecom-thing/services/order/internal/application/dto"
+	"github.com/dzhordano/ecom-thing/services/order/internal/infrastructure"
+	"github.com/dzhordano/ecom-thing/services/order/pkg/logger"
+	"github.com/dzhordano/ecom-thing/services/order/pkg/money"
+	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	promdto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	ttl      = time.Minute
+	maxStale = 10 * time.Minute
+)
+
+// fakeProducts is product service knowing products it holds.
+type fakeProducts struct {
+	products map[uuid.UUID]*dto.ProductInfo
+	err      error
+	// Ids every call was made with.
+	calls [][]uuid.UUID
+	// Called during every call, before it returns.
+	during func()
+}
+
+func (f *fakeProducts) GetProductInfo(_ context.Context, id uuid.UUID) (*dto.ProductInfo, error) {
+	products, err := f.GetProductsInfo(context.Background(), []uuid.UUID{id})
+	if err != nil {
+		return nil, err
+	}
+
+	p, ok := products[id]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "product not found")
+	}
+	return p, nil
+}
+
+func (f *fakeProducts) GetProductsInfo(_ context.Context, ids []uuid.UUID) (map[uuid.UUID]*dto.ProductInfo, error) {
+	f.calls = append(f.calls, ids)
+	if f.during != nil {
+		f.during()
+	}
+	if f.err != nil {
+		return nil, f.err
+	}
+
+	products := make(map[uuid.UUID]*dto.ProductInfo, len(ids))
+	for _, id := range ids {
+		if p, ok := f.products[id]; ok {
+			copied := *p
+			products[id] = &copied
+		}
+	}
+	return products, nil
+}
+
+// add makes product priced at cents known to f.
+func (f *fakeProducts) add(cents int64) uuid.UUID {
+	id := uuid.New()
+	f.products[id] = &dto.ProductInfo{Name: "Product", Price: money.New(cents, ""), IsActive: true}
+	return id
+}
+
+type cacheEnv struct {
+	products *fakeProducts
+	cache    *ProductCache
+	now      time.Time
+}
+
+func newCacheEnv(t *testing.T, size int) *cacheEnv {
+	t.Helper()
+
+	e := &cacheEnv{
+		products: &fakeProducts{products: map[uuid.UUID]*dto.ProductInfo{}},
+		now:      time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC),
+	}
+
+	log := logger.MustInit(logger.LevelError, filepath.Join(t.TempDir(), "cache-test.log"), "json", false)
+	e.cache = NewProductCache(log, e.products, size, ttl, maxStale).(*ProductCache)
+	e.cache.now = func() time.Time { return e.now }
+
+	return e
+}
+
+// metrics snapshots cache metrics, so test can check how much they changed.
+type metrics struct {
+	hits, misses, stale, invalidations float64
+	staleness                          uint64
+}
+
+func readMetrics(t *testing.T) metrics {
+	t.Helper()
+
+	var hist promdto.Metric
+	require.NoError(t, infrastructure.HistProductCacheStaleness.Write(&hist))
+
+	return metrics{
+		hits:          testutil.ToFloat64(infrastructure.IncProductCacheCounter.WithLabelValues("hit")),
+		misses:        testutil.ToFloat64(infrastructure.IncProductCacheCounter.WithLabelValues("miss")),
+		stale:         testutil.ToFloat64(infrastructure.IncProductCacheCounter.WithLabelValues("stale")),
+		invalidations: testutil.ToFloat64(infrastructure.IncProductCacheInvalidationsCounter),
+		staleness:     hist.GetHistogram().GetSampleCount(),
+	}
+}
+
+func (m metrics) since(before metrics) metrics {
+	return metrics{
+		hits:          m.hits - before.hits,
+		misses:        m.misses - before.misses,
+		stale:         m.stale - before.stale,
+		invalidations: m.invalidations - before.invalidations,
+		staleness:     m.staleness - before.staleness,
+	}
+}
+
+func TestProductCache_GetProductInfo(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("served from cache until ttl", func(t *testing.T) {
+		env := newCacheEnv(t, 10)
+		id := env.products.add(1000)
+		before := readMetrics(t)
+
+		_, err := env.cache.GetProductInfo(ctx, id)
+		require.NoError(t, err)
+
+		env.products.products[id].Price = money.New(1200, "")
+		env.now = env.now.Add(ttl - time.Second)
+
+		got, err := env.cache.GetProductInfo(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, money.New(1000, ""), got.Price)
+		assert.Len(t, env.products.calls, 1)
+
+		env.now = env.now.Add(time.Second)
+
+		got, err = env.cache.GetProductInfo(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, money.New(1200, ""), got.Price)
+		assert.Len(t, env.products.calls, 2)
+
+		assert.Equal(t, metrics{hits: 1, misses: 2}, readMetrics(t).since(before))
+	})
+
+	t.Run("invalidated product is looked up again", func(t *testing.T) {
+		env := newCacheEnv(t, 10)
+		id := env.products.add(1000)
+		before := readMetrics(t)
+
+		_, err := env.cache.GetProductInfo(ctx, id)
+		require.NoError(t, err)
+
+		env.products.products[id].Price = money.New(1200, "")
+		env.cache.Invalidate(id)
+
+		got, err := env.cache.GetProductInfo(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, money.New(1200, ""), got.Price)
+
+		assert.Equal(t, metrics{misses: 2, invalidations: 1}, readMetrics(t).since(before))
+	})
+
+	t.Run("product invalidated during lookup is not cached", func(t *testing.T) {
+		env := newCacheEnv(t, 10)
+		id := env.products.add(1000)
+		env.products.during = func() { env.cache.Invalidate(id) }
+
+		_, err := env.cache.GetProductInfo(ctx, id)
+		require.NoError(t, err)
+
+		env.products.during = nil
+		_, err = env.cache.GetProductInfo(ctx, id)
+		require.NoError(t, err)
+		assert.Len(t, env.products.calls, 2)
+	})
+
+	t.Run("stale product is served while product service is unavailable", func(t *testing.T) {
+		env := newCacheEnv(t, 10)
+		id := env.products.add(1000)
+
+		_, err := env.cache.GetProductInfo(ctx, id)
+		require.NoError(t, err)
+
+		env.products.err = status.Error(codes.Unavailable, "connection refused")
+		env.now = env.now.Add(ttl + maxStale - time.Second)
+		before := readMetrics(t)
+
+		got, err := env.cache.GetProductInfo(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, money.New(1000, ""), got.Price)
+		assert.Equal(t, metrics{stale: 1, staleness: 1}, readMetrics(t).since(before))
+
+		// Too stale to be served.
+		env.now = env.now.Add(time.Second)
+
+		_, err = env.cache.GetProductInfo(ctx, id)
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+	})
+
+	t.Run("stale product is not served on other errors", func(t *testing.T) {
+		env := newCacheEnv(t, 10)
+		id := env.products.add(1000)
+
+		_, err := env.cache.GetProductInfo(ctx, id)
+		require.NoError(t, err)
+
+		env.products.err = status.Error(codes.PermissionDenied, "denied")
+		env.now = env.now.Add(ttl)
+
+		_, err = env.cache.GetProductInfo(ctx, id)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("deleted product is dropped", func(t *testing.T) {
+		env := newCacheEnv(t, 10)
+		id := env.products.add(1000)
+
+		_, err := env.cache.GetProductInfo(ctx, id)
+		require.NoError(t, err)
+
+		delete(env.products.products, id)
+		env.now = env.now.Add(ttl)
+
+		_, err = env.cache.GetProductInfo(ctx, id)
+		assert.Equal(t, codes.NotFound, status.Code(err))
+
+		// Not served even if product service goes away.
+		env.products.err = status.Error(codes.Unavailable, "connection refused")
+
+		_, err = env.cache.GetProductInfo(ctx, id)
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+	})
+
+	t.Run("least recently used product is evicted", func(t *testing.T) {
+		env := newCacheEnv(t, 2)
+		a, b, c := env.products.add(100), env.products.add(200), env.products.add(300)
+
+		for _, id := range []uuid.UUID{a, b, a, c} {
+			_, err := env.cache.GetProductInfo(ctx, id)
+			require.NoError(t, err)
+		}
+		require.Len(t, env.products.calls, 3)
+
+		_, err := env.cache.GetProductInfo(ctx, a)
+		require.NoError(t, err)
+		assert.Len(t, env.products.calls, 3)
+
+		_, err = env.cache.GetProductInfo(ctx, b)
+		require.NoError(t, err)
+		assert.Len(t, env.products.calls, 4)
+	})
+
+	t.Run("cached product can't be changed by caller", func(t *testing.T) {
+		env := newCacheEnv(t, 10)
+		id := env.products.add(1000)
+
+		got, err := env.cache.GetProductInfo(ctx, id)
+		require.NoError(t, err)
+		got.IsActive = false
+
+		got, err = env.cache.GetProductInfo(ctx, id)
+		require.NoError(t, err)
+		assert.True(t, got.IsActive)
+	})
+}
+
+func TestProductCache_GetProductsInfo(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("only missed products are looked up", func(t *testing.T) {
+		env := newCacheEnv(t, 10)
+		cached, other := env.products.add(100), env.products.add(200)
+		unknown := uuid.New()
+
+		_, err := env.cache.GetProductInfo(ctx, cached)
+		require.NoError(t, err)
+		before := readMetrics(t)
+
+		got, err := env.cache.GetProductsInfo(ctx, []uuid.UUID{cached, other, unknown, other})
+		require.NoError(t, err)
+
+		assert.Len(t, got, 2)
+		assert.Equal(t, money.New(100, ""), got[cached].Price)
+		assert.Equal(t, money.New(200, ""), got[other].Price)
+		assert.NotContains(t, got, unknown)
+
+		require.Len(t, env.products.calls, 2)
+		assert.Equal(t, []uuid.UUID{other, unknown}, env.products.calls[1])
+		assert.Equal(t, metrics{hits: 1, misses: 2}, readMetrics(t).since(before))
+
+		// Unknown product is not cached.
+		_, err = env.cache.GetProductsInfo(ctx, []uuid.UUID{cached, other, unknown})
+		require.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{unknown}, env.products.calls[2])
+	})
+
+	t.Run("stale products are served while product service is unavailable", func(t *testing.T) {
+		env := newCacheEnv(t, 10)
+		a, b := env.products.add(100), env.products.add(200)
+
+		_, err := env.cache.GetProductsInfo(ctx, []uuid.UUID{a, b})
+		require.NoError(t, err)
+
+		env.products.err = context.DeadlineExceeded
+		env.now = env.now.Add(ttl)
+		before := readMetrics(t)
+
+		got, err := env.cache.GetProductsInfo(ctx, []uuid.UUID{a, b})
+		require.NoError(t, err)
+		assert.Len(t, got, 2)
+		assert.Equal(t, metrics{stale: 2, staleness: 2}, readMetrics(t).since(before))
+	})
+
+	t.Run("products are not served partially", func(t *testing.T) {
+		env := newCacheEnv(t, 10)
+		a, b := env.products.add(100), env.products.add(200)
+
+		_, err := env.cache.GetProductInfo(ctx, a)
+		require.NoError(t, err)
+
+		env.products.err = status.Error(codes.Unavailable, "connection refused")
+		env.now = env.now.Add(ttl)
+		before := readMetrics(t)
+
+		_, err = env.cache.GetProductsInfo(ctx, []uuid.UUID{a, b})
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+		assert.Equal(t, metrics{misses: 2}, readMetrics(t).since(before))
+	})
+}
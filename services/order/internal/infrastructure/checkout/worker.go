@@ -0,0 +1,42 @@
+package checkout
+
+import (
+	"context"
+	"time"
+
+	"github.com/dzhordano/ecom-thing/services/order/internal/application/interfaces"
+	"github.com/dzhordano/ecom-thing/services/order/pkg/logger"
+)
+
+// Worker periodically drives checkout sagas that are due: timed out ones are aborted,
+// stalled ones get their commands sent again.
+type Worker struct {
+	log      logger.Logger
+	checkout interfaces.Checkout
+	interval time.Duration
+}
+
+func NewWorker(log logger.Logger, checkout interfaces.Checkout, interval time.Duration) *Worker {
+	return &Worker{
+		log:      log,
+		checkout: checkout,
+		interval: interval,
+	}
+}
+
+// Start runs worker until context is cancelled. Meant to be run in a separate goroutine.
+func (w *Worker) Start(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			// Errors are logged by checkout itself.
+			_ = w.checkout.ProcessDue(ctx)
+		case <-ctx.Done():
+			w.log.Info("checkout worker shutting down")
+			return
+		}
+	}
+}
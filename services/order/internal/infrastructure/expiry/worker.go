@@ -0,0 +1,63 @@
+package expiry
+
+import (
+	"context"
+	"time"
+
+	"github.com/dzhordano/ecom-thing/services/order/internal/application/interfaces"
+	"github.com/dzhordano/ecom-thing/services/order/internal/infrastructure"
+	"github.com/dzhordano/ecom-thing/services/order/pkg/logger"
+)
+
+// Worker periodically cancels orders that stayed pending longer than payment window.
+// Every replica may run it, each order is expired by one of them.
+type Worker struct {
+	log      logger.Logger
+	expiry   interfaces.OrderExpiry
+	interval time.Duration
+}
+
+func NewWorker(log logger.Logger, expiry interfaces.OrderExpiry, interval time.Duration) *Worker {
+	return &Worker{
+		log:      log,
+		expiry:   expiry,
+		interval: interval,
+	}
+}
+
+// Start runs worker until context is cancelled. Meant to be run in a separate goroutine.
+func (w *Worker) Start(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			w.run(ctx)
+		case <-ctx.Done():
+			w.log.Info("order expiry worker shutting down")
+			return
+		}
+	}
+}
+
+func (w *Worker) run(ctx context.Context) {
+	start := time.Now()
+
+	// Errors are logged by expiry itself.
+	expired, err := w.expiry.ExpirePending(ctx)
+
+	infrastructure.HistExpiryDuration.Observe(time.Since(start).Seconds())
+	infrastructure.IncExpiredOrdersCounter.Add(float64(expired))
+
+	if err != nil {
+		infrastructure.IncExpiryRunsCounter.WithLabelValues("error").Inc()
+		return
+	}
+
+	infrastructure.IncExpiryRunsCounter.WithLabelValues("ok").Inc()
+
+	if expired > 0 {
+		w.log.Debug("pending orders expired", "count", expired)
+	}
+}
@@ -2,6 +2,7 @@ package product
 
 import (
 	"context"
+	"fmt"
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"go.opentelemetry.io/otel/propagation"
 	tracesdk "go.opentelemetry.io/otel/sdk/trace"
@@ -9,7 +10,9 @@ import (
 	"log"
 	"time"
 
+	"github.com/dzhordano/ecom-thing/services/order/internal/application/dto"
 	"github.com/dzhordano/ecom-thing/services/order/internal/application/interfaces"
+	"github.com/dzhordano/ecom-thing/services/order/pkg/money"
 	api "github.com/dzhordano/ecom-thing/services/order/pkg/third_party/product/v1"
 	"github.com/google/uuid"
 	"google.golang.org/grpc"
@@ -83,20 +86,73 @@ func NewProductClient(addr string, opts ...ClientOption) interfaces.ProductServi
 	}
 }
 
-func (c *productClient) GetProductInfo(ctx context.Context, orderId uuid.UUID) (float64, bool, error) {
+func (c *productClient) GetProductInfo(ctx context.Context, productId uuid.UUID) (*dto.ProductInfo, error) {
 	ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer("order").Start(ctx, "GetProductInfo")
 	defer span.End()
 
 	span.AddEvent("performing rpc")
 
 	resp, err := c.c.GetProduct(ctx, &api.GetProductRequest{
-		Id: orderId.String(),
+		Id: productId.String(),
 	})
 	if err != nil {
-		return 0, false, err
+		return nil, err
 	}
 
 	span.AddEvent("got response")
 
-	return resp.Product.Price, resp.Product.IsActive, nil
+	return productInfo(resp.Product), nil
+}
+
+// maxBatchSize is the most ids product service accepts in one GetProducts call.
+const maxBatchSize = 100
+
+func (c *productClient) GetProductsInfo(ctx context.Context, productIds []uuid.UUID) (map[uuid.UUID]*dto.ProductInfo, error) {
+	ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer("order").Start(ctx, "GetProductsInfo")
+	defer span.End()
+
+	ids := make([]string, 0, len(productIds))
+	seen := make(map[uuid.UUID]bool, len(productIds))
+	for _, id := range productIds {
+		if !seen[id] {
+			seen[id] = true
+			ids = append(ids, id.String())
+		}
+	}
+
+	products := make(map[uuid.UUID]*dto.ProductInfo, len(ids))
+	for start := 0; start < len(ids); start += maxBatchSize {
+		batch := ids[start:min(start+maxBatchSize, len(ids))]
+
+		span.AddEvent("performing rpc")
+
+		resp, err := c.c.GetProducts(ctx, &api.GetProductsRequest{
+			Ids: batch,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		span.AddEvent("got response")
+
+		// Ids listed in resp.MissingIds are simply left out of result.
+		for _, p := range resp.Products {
+			id, err := uuid.Parse(p.Id)
+			if err != nil {
+				return nil, fmt.Errorf("invalid product id %q: %w", p.Id, err)
+			}
+			products[id] = productInfo(p)
+		}
+	}
+
+	return products, nil
+}
+
+func productInfo(p *api.Product) *dto.ProductInfo {
+	return &dto.ProductInfo{
+		Name:     p.Name,
+		Price:    money.New(p.GetPrice().GetAmount(), p.GetPrice().GetCurrency()),
+		Category: p.Category,
+		IsActive: p.IsActive,
+	}
 }
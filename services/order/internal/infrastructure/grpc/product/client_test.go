@@ -0,0 +1,164 @@
+package product
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/dzhordano/ecom-thing/services/order/pkg/money"
+	api "github.com/dzhordano/ecom-thing/services/order/pkg/third_party/product/v1"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// catalogServer serves products it knows of, every call takes latency.
+type catalogServer struct {
+	api.UnimplementedProductServiceServer
+
+	products map[string]*api.Product
+	latency  time.Duration
+	// Sizes of GetProducts batches served.
+	batches []int
+}
+
+func (s *catalogServer) GetProduct(_ context.Context, req *api.GetProductRequest) (*api.GetProductResponse, error) {
+	time.Sleep(s.latency)
+
+	p, ok := s.products[req.Id]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "product not found")
+	}
+	return &api.GetProductResponse{Product: p}, nil
+}
+
+func (s *catalogServer) GetProducts(_ context.Context, req *api.GetProductsRequest) (*api.GetProductsResponse, error) {
+	time.Sleep(s.latency)
+	s.batches = append(s.batches, len(req.Ids))
+
+	resp := &api.GetProductsResponse{}
+	for _, id := range req.Ids {
+		if p, ok := s.products[id]; ok {
+			resp.Products = append(resp.Products, p)
+		} else {
+			resp.MissingIds = append(resp.MissingIds, id)
+		}
+	}
+	return resp, nil
+}
+
+// newCatalog returns server knowing n active products and their ids.
+func newCatalog(n int, latency time.Duration) (*catalogServer, []uuid.UUID) {
+	s := &catalogServer{products: make(map[string]*api.Product, n), latency: latency}
+
+	ids := make([]uuid.UUID, n)
+	for i := range ids {
+		ids[i] = uuid.New()
+		s.products[ids[i].String()] = &api.Product{
+			Id:       ids[i].String(),
+			Name:     fmt.Sprintf("Product %d", i),
+			Category: "books",
+			Price:    &api.Money{Amount: 1050, Currency: "RUB"},
+			IsActive: true,
+		}
+	}
+
+	return s, ids
+}
+
+// newTestClient returns client talking to s over in-memory connection.
+func newTestClient(tb testing.TB, s api.ProductServiceServer) *productClient {
+	tb.Helper()
+
+	lis := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer()
+	api.RegisterProductServiceServer(srv, s)
+	go func() { _ = srv.Serve(lis) }()
+	tb.Cleanup(srv.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(tb, err)
+	tb.Cleanup(func() { _ = conn.Close() })
+
+	return &productClient{c: api.NewProductServiceClient(conn)}
+}
+
+func TestProductClient_GetProductsInfo(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("reports missing and inactive products", func(t *testing.T) {
+		s, ids := newCatalog(2, 0)
+		s.products[ids[1].String()].IsActive = false
+		missing := uuid.New()
+
+		got, err := newTestClient(t, s).GetProductsInfo(ctx, []uuid.UUID{ids[0], ids[1], missing, ids[0]})
+		require.NoError(t, err)
+
+		require.Len(t, got, 2)
+		assert.True(t, got[ids[0]].IsActive)
+		assert.Equal(t, "Product 0", got[ids[0]].Name)
+		assert.Equal(t, money.New(1050, "RUB"), got[ids[0]].Price)
+		assert.False(t, got[ids[1]].IsActive)
+		assert.NotContains(t, got, missing)
+
+		// Duplicates are asked for once.
+		assert.Equal(t, []int{3}, s.batches)
+	})
+
+	t.Run("splits large requests", func(t *testing.T) {
+		s, ids := newCatalog(maxBatchSize*2+1, 0)
+
+		got, err := newTestClient(t, s).GetProductsInfo(ctx, ids)
+		require.NoError(t, err)
+
+		assert.Len(t, got, len(ids))
+		assert.Equal(t, []int{maxBatchSize, maxBatchSize, 1}, s.batches)
+	})
+
+	t.Run("rpc error", func(t *testing.T) {
+		c := newTestClient(t, &api.UnimplementedProductServiceServer{})
+
+		_, err := c.GetProductsInfo(ctx, []uuid.UUID{uuid.New()})
+		assert.Equal(t, codes.Unimplemented, status.Code(err))
+	})
+}
+
+// BenchmarkProductClient compares looking up products of an order one by one and in one batch.
+func BenchmarkProductClient(b *testing.B) {
+	ctx := context.Background()
+
+	for _, latency := range []time.Duration{0, 500 * time.Microsecond} {
+		for _, items := range []int{1, 10, 50} {
+			s, ids := newCatalog(items, latency)
+			c := newTestClient(b, s)
+
+			b.Run(fmt.Sprintf("per_item/latency=%s/items=%d", latency, items), func(b *testing.B) {
+				for b.Loop() {
+					for _, id := range ids {
+						if _, err := c.GetProductInfo(ctx, id); err != nil {
+							b.Fatal(err)
+						}
+					}
+				}
+			})
+
+			b.Run(fmt.Sprintf("batch/latency=%s/items=%d", latency, items), func(b *testing.B) {
+				for b.Loop() {
+					if _, err := c.GetProductsInfo(ctx, ids); err != nil {
+						b.Fatal(err)
+					}
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,46 @@
+package idempotency
+
+import (
+	"context"
+	"time"
+
+	"github.com/dzhordano/ecom-thing/services/order/internal/domain/repository"
+	"github.com/dzhordano/ecom-thing/services/order/pkg/logger"
+)
+
+// Cleaner periodically deletes expired idempotency keys. Expired keys are ignored anyway,
+// this only keeps the table small.
+type Cleaner struct {
+	log      logger.Logger
+	keys     repository.IdempotencyRepository
+	interval time.Duration
+}
+
+func NewCleaner(log logger.Logger, keys repository.IdempotencyRepository, interval time.Duration) *Cleaner {
+	return &Cleaner{
+		log:      log,
+		keys:     keys,
+		interval: interval,
+	}
+}
+
+// Start runs cleaner until context is cancelled. Meant to be run in a separate goroutine.
+func (c *Cleaner) Start(ctx context.Context) {
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			deleted, err := c.keys.DeleteExpired(ctx, time.Now().UTC())
+			if err != nil {
+				c.log.Error("failed to delete expired idempotency keys", "error", err)
+				continue
+			}
+			c.log.Debug("expired idempotency keys deleted", "count", deleted)
+		case <-ctx.Done():
+			c.log.Info("idempotency keys cleaner shutting down")
+			return
+		}
+	}
+}
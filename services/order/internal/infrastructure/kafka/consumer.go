@@ -13,6 +13,7 @@ import (
 	"time"
 
 	"github.com/dzhordano/ecom-thing/services/order/internal/application/interfaces"
+	"github.com/dzhordano/ecom-thing/services/order/internal/domain"
 )
 
 var (
@@ -20,8 +21,13 @@ var (
 
 	// To filter out unnecessary events
 	events = map[string]bool{
-		"cancelled": true,
-		"completed": true,
+		"cancelled":           true,
+		"completed":           true,
+		"quantity-reserved":   true,
+		"quantity-rejected":   true,
+		"items-lock-reserved": true,
+		"items-lock-rejected": true,
+		"refunded":            true,
 	}
 )
 
@@ -34,7 +40,7 @@ const (
 type Consumer struct {
 	brokers      []string
 	topics       []string
-	os           interfaces.OrderService
+	checkout     interfaces.Checkout
 	retryBackoff time.Duration
 	retries      uint
 }
@@ -42,7 +48,7 @@ type Consumer struct {
 // NewConsumerGroup returns new consumer group.
 //
 // If retries amount provided as 0, infinite (max uint) number of retries will be set.
-func NewConsumerGroup(ctx context.Context, brokers, topics []string, os interfaces.OrderService, retryBackoff time.Duration, retries uint) (*Consumer, error) {
+func NewConsumerGroup(ctx context.Context, brokers, topics []string, checkout interfaces.Checkout, retryBackoff time.Duration, retries uint) (*Consumer, error) {
 	if retries == 0 {
 		retries = math.MaxUint
 	}
@@ -77,7 +83,7 @@ func NewConsumerGroup(ctx context.Context, brokers, topics []string, os interfac
 	return &Consumer{
 		brokers:      brokers,
 		topics:       topics,
-		os:           os,
+		checkout:     checkout,
 		retryBackoff: retryBackoff,
 		retries:      retries,
 	}, nil
@@ -200,13 +206,7 @@ func (c *Consumer) CreateTopics(_ context.Context, partitions, replicationFactor
 func (c *Consumer) executeEvent(ctx context.Context, m kafka.Message) error {
 	orderIdValue := string(m.Value)
 
-	var eventType string
-	for _, h := range m.Headers {
-		if string(h.Key) == "event_type" {
-			eventType = string(h.Value)
-			break
-		}
-	}
+	eventType := header(m, "event_type")
 	if !events[eventType] {
 		return ErrInvalidEventType
 	}
@@ -216,18 +216,56 @@ func (c *Consumer) executeEvent(ctx context.Context, m kafka.Message) error {
 		return err
 	}
 
+	ctx = domain.ContextWithEventID(ctx, string(m.Key))
+
 	// FIXME Тут мб константы тоже
 	switch eventType {
 	case "cancelled":
-		if err := c.os.CancelOrder(ctx, orderID); err != nil {
+		err = c.checkout.PaymentFailed(ctx, orderID)
+	case "completed":
+		err = c.checkout.PaymentCompleted(ctx, orderID)
+	case "quantity-reserved":
+		err = c.checkout.StockReserved(ctx, orderID)
+	case "quantity-rejected":
+		err = c.checkout.StockRejected(ctx, orderID)
+	case "items-lock-reserved":
+		var lockID uuid.UUID
+		lockID, err = uuid.Parse(header(m, "adjustment_id"))
+		if err != nil {
 			return err
 		}
-	case "completed":
-		// FIXME Сейчас тут complete, а по факту должен заказ в paid... тупануллллллс
-		if err := c.os.CompleteOrder(ctx, orderID); err != nil {
+		err = c.checkout.StockLockReserved(ctx, orderID, lockID)
+	case "items-lock-rejected":
+		var lockID uuid.UUID
+		lockID, err = uuid.Parse(header(m, "adjustment_id"))
+		if err != nil {
 			return err
 		}
+		err = c.checkout.StockLockRejected(ctx, orderID, lockID)
+	case "refunded":
+		var refundID uuid.UUID
+		refundID, err = uuid.Parse(header(m, "refund_id"))
+		if err != nil {
+			return err
+		}
+		err = c.checkout.PaymentRefunded(ctx, orderID, refundID)
 	}
 
-	return nil
+	// Order isn't checked out by saga (e.g. created before it existed), retrying won't help.
+	if errors.Is(err, domain.ErrSagaNotFound) {
+		log.Printf("skipping %s event for order %s: %v\n", eventType, orderID, err)
+		return nil
+	}
+
+	return err
+}
+
+// header returns value of message header with given key, empty if there is none.
+func header(m kafka.Message, key string) string {
+	for _, h := range m.Headers {
+		if string(h.Key) == key {
+			return string(h.Value)
+		}
+	}
+	return ""
 }
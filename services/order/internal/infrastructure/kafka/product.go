@@ -0,0 +1,149 @@
+package kafka
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/dzhordano/ecom-thing/services/order/internal/application/interfaces"
+	"github.com/google/uuid"
+	"github.com/segmentio/kafka-go"
+)
+
+// ProductEventsConsumer drops changed products from cache. Value of product event is id of product,
+// every event (whatever its type) invalidates it.
+//
+// Unlike checkout events, every replica has to read all product events, as each has its own cache.
+// So consumer is not a member of any group: it reads every partition of topic directly, starting from
+// the latest events, and commits no offsets. Events missed while disconnected are not caught up on,
+// cached products still expire by TTL. Partitions added after start are read once replica restarts.
+type ProductEventsConsumer struct {
+	brokers      []string
+	topic        string
+	cache        interfaces.ProductCache
+	retryBackoff time.Duration
+}
+
+func NewProductEventsConsumer(brokers []string, topic string, cache interfaces.ProductCache, retryBackoff time.Duration) *ProductEventsConsumer {
+	return &ProductEventsConsumer{
+		brokers:      brokers,
+		topic:        topic,
+		cache:        cache,
+		retryBackoff: retryBackoff,
+	}
+}
+
+// Run consumes events until context is cancelled. Meant to be run in a separate goroutine.
+func (c *ProductEventsConsumer) Run(ctx context.Context) {
+	partitions, ok := c.partitions(ctx)
+	if !ok {
+		return
+	}
+
+	var wg sync.WaitGroup
+	for _, p := range partitions {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			c.consume(ctx, p.ID)
+		}()
+	}
+	wg.Wait()
+}
+
+// partitions looks up partitions of topic, retrying until it succeeds or context is cancelled.
+func (c *ProductEventsConsumer) partitions(ctx context.Context) ([]kafka.Partition, bool) {
+	backoff := c.retryBackoff
+	for {
+		var partitions []kafka.Partition
+		var err error
+		for _, broker := range c.brokers {
+			partitions, err = readPartitions(ctx, broker, c.topic)
+			if err == nil && len(partitions) > 0 {
+				return partitions, true
+			}
+		}
+		if err == nil {
+			err = fmt.Errorf("topic %s has no partitions", c.topic)
+		}
+
+		log.Printf("error looking up product events partitions: %v. retrying after: %v\n", err, backoff)
+		select {
+		case <-time.After(backoff):
+		case <-ctx.Done():
+			return nil, false
+		}
+		backoff = min((backoff*150)/100, 30*time.Second)
+	}
+}
+
+func readPartitions(ctx context.Context, broker, topic string) ([]kafka.Partition, error) {
+	conn, err := kafka.DialContext(ctx, "tcp", broker)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			log.Printf("error closing kafka conn: %v\n", err)
+		}
+	}()
+
+	return conn.ReadPartitions(topic)
+}
+
+// consume reads one partition from its latest event on.
+func (c *ProductEventsConsumer) consume(ctx context.Context, partition int) {
+	r := kafka.NewReader(kafka.ReaderConfig{
+		Brokers:   c.brokers,
+		Topic:     c.topic,
+		Partition: partition,
+		MinBytes:  1,
+		MaxBytes:  10e6, // 10 MB
+	})
+	defer func() {
+		if err := r.Close(); err != nil {
+			log.Printf("error closing product events consumer: %v\n", err)
+		}
+	}()
+
+	if err := r.SetOffset(kafka.LastOffset); err != nil {
+		log.Printf("error seeking product events partition %d: %v\n", partition, err)
+		return
+	}
+
+	backoff := c.retryBackoff
+	for {
+		m, err := r.ReadMessage(ctx)
+		if err != nil {
+			if errors.Is(err, io.EOF) || ctx.Err() != nil {
+				return
+			}
+
+			log.Printf("error fetching product events: %v. retrying after: %v\n", err, backoff)
+			select {
+			case <-time.After(backoff):
+			case <-ctx.Done():
+				return
+			}
+			backoff = min((backoff*150)/100, 30*time.Second)
+			continue
+		}
+
+		backoff = c.retryBackoff
+		c.invalidate(m)
+	}
+}
+
+func (c *ProductEventsConsumer) invalidate(m kafka.Message) {
+	productID, err := uuid.Parse(string(m.Value))
+	if err != nil {
+		log.Printf("skipping product event with key %s: invalid product id: %v\n", m.Key, err)
+		return
+	}
+
+	c.cache.Invalidate(productID)
+}
@@ -29,10 +29,88 @@ var (
 		},
 		[]string{"method", "status"},
 	)
+
+	IncExpiredOrdersCounter = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "orders_expired_total",
+			Help: "Total number of pending orders cancelled after payment window",
+		},
+	)
+
+	IncExpiryRunsCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "order_expiry_runs_total",
+			Help: "Total number of pending orders expiry runs",
+		},
+		[]string{"status"},
+	)
+
+	HistExpiryDuration = prometheus.NewHistogram(
+		prometheus.HistogramOpts{
+			Name:    "order_expiry_duration_seconds",
+			Help:    "Pending orders expiry run duration in seconds",
+			Buckets: prometheus.ExponentialBuckets(0.001, 2, 14),
+		},
+	)
+
+	IncErasedOrdersCounter = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "orders_erased_total",
+			Help: "Total number of archived orders anonymized or purged after retention period",
+		},
+	)
+
+	IncRetentionRunsCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "order_retention_runs_total",
+			Help: "Total number of archived orders retention runs",
+		},
+		[]string{"status"},
+	)
+
+	HistRetentionDuration = prometheus.NewHistogram(
+		prometheus.HistogramOpts{
+			Name:    "order_retention_duration_seconds",
+			Help:    "Archived orders retention run duration in seconds",
+			Buckets: prometheus.ExponentialBuckets(0.001, 2, 14),
+		},
+	)
+
+	IncProductCacheCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "product_cache_lookups_total",
+			Help: "Total number of products looked up through cache",
+		},
+		[]string{"result"},
+	)
+
+	HistProductCacheStaleness = prometheus.NewHistogram(
+		prometheus.HistogramOpts{
+			Name:    "product_cache_staleness_seconds",
+			Help:    "How long past TTL cached products were when served while product service was unavailable",
+			Buckets: prometheus.ExponentialBuckets(1, 2, 12),
+		},
+	)
+
+	IncProductCacheInvalidationsCounter = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "product_cache_invalidations_total",
+			Help: "Total number of products dropped from cache on product change events",
+		},
+	)
 )
 
 func InitMetrics() {
 	prometheus.MustRegister(IncRequestCounter)
 	prometheus.MustRegister(IncResponseCounter)
 	prometheus.MustRegister(HistRequestDuration)
+	prometheus.MustRegister(IncExpiredOrdersCounter)
+	prometheus.MustRegister(IncExpiryRunsCounter)
+	prometheus.MustRegister(HistExpiryDuration)
+	prometheus.MustRegister(IncErasedOrdersCounter)
+	prometheus.MustRegister(IncRetentionRunsCounter)
+	prometheus.MustRegister(HistRetentionDuration)
+	prometheus.MustRegister(IncProductCacheCounter)
+	prometheus.MustRegister(HistProductCacheStaleness)
+	prometheus.MustRegister(IncProductCacheInvalidationsCounter)
 }
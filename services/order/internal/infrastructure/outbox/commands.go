@@ -0,0 +1,117 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/dzhordano/ecom-thing/services/order/internal/application/interfaces"
+	"github.com/dzhordano/ecom-thing/services/order/internal/domain"
+	"github.com/dzhordano/ecom-thing/services/order/internal/infrastructure/repository/pg"
+)
+
+var (
+	outboxTable = "outbox"
+
+	kafkaOrderTopic = "order-events"
+
+	kafkaEventOrderCreated       = "order-created"
+	kafkaEventOrderCancelled     = "order-cancelled"
+	kafkaEventQuantityRequested  = "quantity-requested"
+	kafkaEventQuantityReleased   = "quantity-released"
+	kafkaEventQuantitySubtracted = "quantity-subtracted"
+	kafkaEventQuantityReturned   = "quantity-returned"
+	kafkaEventRefundRequested    = "refund-requested"
+)
+
+// ErrOutsideTx is returned for command sent outside of transaction, see repository.Transactor.
+var ErrOutsideTx = errors.New("command must be sent within transaction")
+
+// Commands are written to outbox and delivered at least once.
+// Receivers handle them idempotently per order.
+//
+// Commands are written within transaction ctx is within, together with saga step they belong to.
+type commands struct{}
+
+func (c *commands) insert(ctx context.Context, eventType string, payload any) error {
+	const op = "outbox.insert"
+
+	tx, ok := pg.TxFromContext(ctx)
+	if !ok {
+		return fmt.Errorf("%s: %s: %w", op, eventType, ErrOutsideTx)
+	}
+
+	insertQuery := sq.Insert(outboxTable).
+		Columns("topic", "event_type", "payload", "created_at").
+		Values(kafkaOrderTopic, eventType, payload, time.Now().UTC()).
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := insertQuery.ToSql()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if _, err := tx.Exec(ctx, query, args...); err != nil {
+		return fmt.Errorf("%s: %s: %w", op, eventType, err)
+	}
+
+	return nil
+}
+
+type InventoryCommands struct {
+	commands
+}
+
+func NewInventoryCommands() interfaces.InventoryCommands {
+	return &InventoryCommands{}
+}
+
+// Reserve implements interfaces.InventoryCommands.
+func (c *InventoryCommands) Reserve(ctx context.Context, order *domain.Order) error {
+	return c.insert(ctx, kafkaEventQuantityRequested, order.InventoryEvent())
+}
+
+// Release implements interfaces.InventoryCommands.
+func (c *InventoryCommands) Release(ctx context.Context, order *domain.Order) error {
+	return c.insert(ctx, kafkaEventQuantityReleased, order.InventoryEvent())
+}
+
+// Commit implements interfaces.InventoryCommands.
+func (c *InventoryCommands) Commit(ctx context.Context, order *domain.Order) error {
+	return c.insert(ctx, kafkaEventQuantitySubtracted, order.InventoryEvent())
+}
+
+// Return implements interfaces.InventoryCommands.
+func (c *InventoryCommands) Return(ctx context.Context, order *domain.Order) error {
+	return c.insert(ctx, kafkaEventQuantityReturned, order.InventoryEvent())
+}
+
+type PaymentCommands struct {
+	commands
+}
+
+func NewPaymentCommands() interfaces.PaymentCommands {
+	return &PaymentCommands{}
+}
+
+// Create implements interfaces.PaymentCommands.
+func (c *PaymentCommands) Create(ctx context.Context, order *domain.Order) error {
+	return c.insert(ctx, kafkaEventOrderCreated, order.OrderEvent())
+}
+
+// Cancel implements interfaces.PaymentCommands.
+func (c *PaymentCommands) Cancel(ctx context.Context, order *domain.Order) error {
+	return c.insert(ctx, kafkaEventOrderCancelled, order.OrderEvent())
+}
+
+// Refund implements interfaces.PaymentCommands.
+func (c *PaymentCommands) Refund(ctx context.Context, order *domain.Order) error {
+	return c.insert(ctx, kafkaEventRefundRequested, order.RefundEvent())
+}
+
+// RefundItems implements interfaces.PaymentCommands.
+func (c *PaymentCommands) RefundItems(ctx context.Context, cancellation *domain.Cancellation) error {
+	return c.insert(ctx, kafkaEventRefundRequested, cancellation.RefundEvent())
+}
@@ -0,0 +1,58 @@
+package pricing
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/dzhordano/ecom-thing/services/order/internal/application/interfaces"
+	"github.com/dzhordano/ecom-thing/services/order/internal/domain"
+	"github.com/dzhordano/ecom-thing/services/order/internal/domain/repository"
+	"github.com/dzhordano/ecom-thing/services/order/pkg/money"
+)
+
+// RuleShipping prices and schedules delivery by shipping rules of repository.
+// Rules are read on every call, so changed ones apply to the next order.
+type RuleShipping struct {
+	rules repository.ShippingRuleRepository
+}
+
+var _ interfaces.ShippingCalculator = (*RuleShipping)(nil)
+
+func NewRuleShipping(rules repository.ShippingRuleRepository) *RuleShipping {
+	return &RuleShipping{
+		rules: rules,
+	}
+}
+
+// ShippingCost implements interfaces.ShippingCalculator.
+func (s *RuleShipping) ShippingCost(ctx context.Context, method domain.DeliveryMethod, items domain.Items, currency domain.Currency) (money.Money, error) {
+	rule, err := s.rule(ctx, method)
+	if err != nil {
+		return money.Money{}, err
+	}
+	return rule.Fee(items, currency), nil
+}
+
+// CheckDeliveryDate implements interfaces.ShippingCalculator.
+func (s *RuleShipping) CheckDeliveryDate(ctx context.Context, method domain.DeliveryMethod, date, now time.Time) error {
+	rule, err := s.rule(ctx, method)
+	if err != nil {
+		return err
+	}
+	return rule.CheckDate(date, now)
+}
+
+// rule returns rule of method. Broken rule is a server misconfiguration, not a fault of client.
+func (s *RuleShipping) rule(ctx context.Context, method domain.DeliveryMethod) (*domain.ShippingRule, error) {
+	rule, err := s.rules.Get(ctx, method)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := rule.Validate(); err != nil {
+		return nil, fmt.Errorf("shipping rule of %s: %v", method, err)
+	}
+
+	return rule, nil
+}
@@ -0,0 +1,43 @@
+package pricing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/dzhordano/ecom-thing/services/order/internal/application/interfaces"
+	"github.com/dzhordano/ecom-thing/services/order/internal/domain"
+	"github.com/dzhordano/ecom-thing/services/order/internal/domain/repository"
+)
+
+// TableTaxes taxes orders by rate tables of repository.
+// Tables are read on every call, so changed rates apply to the next order.
+type TableTaxes struct {
+	tables repository.TaxRepository
+}
+
+var _ interfaces.TaxCalculator = (*TableTaxes)(nil)
+
+func NewTableTaxes(tables repository.TaxRepository) *TableTaxes {
+	return &TableTaxes{
+		tables: tables,
+	}
+}
+
+// Taxes implements interfaces.TaxCalculator.
+func (t *TableTaxes) Taxes(ctx context.Context, region domain.TaxRegion) (*domain.TaxTable, error) {
+	table, err := t.tables.GetTable(ctx, region)
+	if errors.Is(err, domain.ErrTaxTableNotFound) {
+		return domain.NoTaxes(region), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Broken table is a server misconfiguration, not a fault of client.
+	if err := table.Validate(); err != nil {
+		return nil, fmt.Errorf("tax table of %s: %v", region, err)
+	}
+
+	return table, nil
+}
@@ -0,0 +1,310 @@
+package pg
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/dzhordano/ecom-thing/services/order/internal/domain"
+	"github.com/dzhordano/ecom-thing/services/order/internal/domain/repository"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+var (
+	couponsTable     = "coupons"
+	redemptionsTable = "coupon_redemptions"
+
+	couponColumns = []string{"id", "code", "discount_type", "discount", "amount", "currency", "min_order_amount",
+		"max_redemptions", "max_redemptions_per_user", "redemptions", "product_ids", "categories", "active",
+		"valid_from", "valid_to", "created_at", "updated_at"}
+)
+
+// uniqueViolation is postgres error code of unique constraint violation.
+const uniqueViolation = "23505"
+
+type CouponRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewCouponRepository(db *pgxpool.Pool) repository.CouponRepository {
+	return &CouponRepository{
+		db: db,
+	}
+}
+
+// Save implements repository.CouponRepository.
+func (r *CouponRepository) Save(ctx context.Context, coupon *domain.Coupon) error {
+	const op = "repository.CouponRepository.Save"
+
+	insertQuery := sq.Insert(couponsTable).
+		Columns(couponColumns[1:]...).
+		Values(coupon.Code, coupon.Type, coupon.Discount, coupon.Amount, coupon.Currency, coupon.MinOrderAmount,
+			coupon.MaxRedemptions, coupon.MaxRedemptionsPerUser, coupon.Redemptions, coupon.ProductIDs, coupon.Categories,
+			coupon.Active, coupon.ValidFrom, coupon.ValidTo, coupon.CreatedAt, coupon.UpdatedAt).
+		Suffix("RETURNING id").
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := insertQuery.ToSql()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := r.db.QueryRow(ctx, query, args...).Scan(&coupon.ID); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+			return fmt.Errorf("%s: %w", op, domain.ErrCouponAlreadyExists)
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// GetByCode implements repository.CouponRepository.
+func (r *CouponRepository) GetByCode(ctx context.Context, code string) (*domain.Coupon, error) {
+	const op = "repository.CouponRepository.GetByCode"
+
+	selectQuery := sq.Select(couponColumns...).
+		From(couponsTable).
+		Where(sq.Eq{"code": code}).
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := selectQuery.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	coupon, err := scanCoupon(r.db.QueryRow(ctx, query, args...))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%s: %w", op, domain.ErrCouponNotFound)
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return coupon, nil
+}
+
+// Update implements repository.CouponRepository. Redemptions counter is left as is.
+func (r *CouponRepository) Update(ctx context.Context, coupon *domain.Coupon) error {
+	const op = "repository.CouponRepository.Update"
+
+	updateQuery := sq.Update(couponsTable).
+		Set("discount_type", coupon.Type).
+		Set("discount", coupon.Discount).
+		Set("amount", coupon.Amount).
+		Set("currency", coupon.Currency).
+		Set("min_order_amount", coupon.MinOrderAmount).
+		Set("max_redemptions", coupon.MaxRedemptions).
+		Set("max_redemptions_per_user", coupon.MaxRedemptionsPerUser).
+		Set("product_ids", coupon.ProductIDs).
+		Set("categories", coupon.Categories).
+		Set("active", coupon.Active).
+		Set("valid_from", coupon.ValidFrom).
+		Set("valid_to", coupon.ValidTo).
+		Set("updated_at", coupon.UpdatedAt).
+		Where(sq.Eq{"id": coupon.ID}).
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := updateQuery.ToSql()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	tag, err := r.db.Exec(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("%s: %w", op, domain.ErrCouponNotFound)
+	}
+
+	return nil
+}
+
+// List implements repository.CouponRepository.
+func (r *CouponRepository) List(ctx context.Context, activeOnly bool, limit, offset uint64) ([]*domain.Coupon, error) {
+	const op = "repository.CouponRepository.List"
+
+	selectQuery := sq.Select(couponColumns...).
+		From(couponsTable).
+		OrderBy("id").
+		Limit(limit).
+		Offset(offset).
+		PlaceholderFormat(sq.Dollar)
+
+	if activeOnly {
+		selectQuery = selectQuery.Where(sq.Eq{"active": true})
+	}
+
+	query, args, err := selectQuery.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var coupons []*domain.Coupon
+	for rows.Next() {
+		coupon, err := scanCoupon(rows)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		coupons = append(coupons, coupon)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return coupons, nil
+}
+
+// GetUsage implements repository.CouponRepository.
+func (r *CouponRepository) GetUsage(ctx context.Context, code string, limit uint64) (*domain.CouponUsage, error) {
+	const op = "repository.CouponRepository.GetUsage"
+
+	coupon, err := r.GetByCode(ctx, code)
+	if err != nil {
+		return nil, err
+	}
+
+	usage := &domain.CouponUsage{Coupon: coupon}
+
+	countQuery := sq.Select("COUNT(DISTINCT user_id)").
+		From(redemptionsTable).
+		Where(sq.Eq{"coupon_id": coupon.ID}).
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := countQuery.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := r.db.QueryRow(ctx, query, args...).Scan(&usage.UniqueUsers); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	selectQuery := sq.Select("coupon_id", "order_id", "user_id", "discount", "currency", "redeemed_at").
+		From(redemptionsTable).
+		Where(sq.Eq{"coupon_id": coupon.ID}).
+		OrderBy("redeemed_at DESC").
+		Limit(limit).
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err = selectQuery.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var rd domain.CouponRedemption
+		var currency string
+		if err := rows.Scan(&rd.CouponID, &rd.OrderID, &rd.UserID, &rd.Discount, &currency, &rd.RedeemedAt); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		rd.Discount.Currency = currency
+
+		usage.Recent = append(usage.Recent, rd)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return usage, nil
+}
+
+// redeemCoupon records redemption of order.Coupon within tx.
+//
+// Counter update locks coupon row, so concurrent redemptions of one coupon are serialized
+// and per user count below sees every redemption committed before.
+func redeemCoupon(ctx context.Context, tx pgx.Tx, order *domain.Order) error {
+	if order.Coupon == "" {
+		return nil
+	}
+
+	updateQuery := sq.Update(couponsTable).
+		Set("redemptions", sq.Expr("redemptions + 1")).
+		Where(sq.Eq{"code": order.Coupon}).
+		Where("(max_redemptions = 0 OR redemptions < max_redemptions)").
+		Suffix("RETURNING id, max_redemptions_per_user").
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := updateQuery.ToSql()
+	if err != nil {
+		return err
+	}
+
+	var couponId uint
+	var perUser uint64
+	if err := tx.QueryRow(ctx, query, args...).Scan(&couponId, &perUser); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.ErrCouponRedemptionLimit
+		}
+		return err
+	}
+
+	if perUser > 0 {
+		countQuery := sq.Select("COUNT(*)").
+			From(redemptionsTable).
+			Where(sq.Eq{"coupon_id": couponId, "user_id": order.UserID}).
+			PlaceholderFormat(sq.Dollar)
+
+		query, args, err := countQuery.ToSql()
+		if err != nil {
+			return err
+		}
+
+		var redeemed uint64
+		if err := tx.QueryRow(ctx, query, args...).Scan(&redeemed); err != nil {
+			return err
+		}
+
+		if redeemed >= perUser {
+			return domain.ErrCouponRedemptionLimit
+		}
+	}
+
+	insertQuery := sq.Insert(redemptionsTable).
+		Columns("coupon_id", "order_id", "user_id", "discount", "currency", "redeemed_at").
+		Values(couponId, order.ID, order.UserID, order.Discount(), order.Currency, order.CreatedAt).
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err = insertQuery.ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(ctx, query, args...)
+	return err
+}
+
+func scanCoupon(row pgx.Row) (*domain.Coupon, error) {
+	var c domain.Coupon
+	if err := row.Scan(&c.ID, &c.Code, &c.Type, &c.Discount, &c.Amount, &c.Currency, &c.MinOrderAmount,
+		&c.MaxRedemptions, &c.MaxRedemptionsPerUser, &c.Redemptions, &c.ProductIDs, &c.Categories, &c.Active,
+		&c.ValidFrom, &c.ValidTo, &c.CreatedAt, &c.UpdatedAt); err != nil {
+		return nil, err
+	}
+
+	c.Amount.Currency = c.Currency.String()
+	c.MinOrderAmount.Currency = c.Currency.String()
+
+	return &c, nil
+}
@@ -0,0 +1,151 @@
+package pg
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/dzhordano/ecom-thing/services/order/internal/domain"
+	"github.com/dzhordano/ecom-thing/services/order/internal/domain/repository"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+var (
+	idempotencyKeysTable = "idempotency_keys"
+
+	idempotencyKeyColumns = []string{"user_id", "operation", "key", "request_hash", "resource_id", "response", "lock_id", "locked_until", "created_at", "expires_at"}
+)
+
+type IdempotencyRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewIdempotencyRepository(db *pgxpool.Pool) repository.IdempotencyRepository {
+	return &IdempotencyRepository{
+		db: db,
+	}
+}
+
+// Acquire implements repository.IdempotencyRepository.
+func (r *IdempotencyRepository) Acquire(ctx context.Context, key *domain.IdempotencyKey) (*domain.IdempotencyKey, error) {
+	const op = "repository.IdempotencyRepository.Acquire"
+
+	// Expired key is taken over in place, so is key of the same request whose lease ended before it was completed.
+	// Live one is left untouched and nothing is affected.
+	insertQuery := sq.Insert(idempotencyKeysTable).
+		Columns(idempotencyKeyColumns...).
+		Values(key.UserID, key.Operation, key.Key, key.RequestHash, key.ResourceID, key.Response, key.LockID, key.LockedUntil, key.CreatedAt, key.ExpiresAt).
+		Suffix(`ON CONFLICT (user_id, operation, key) DO UPDATE SET
+			request_hash = EXCLUDED.request_hash,
+			resource_id = EXCLUDED.resource_id,
+			response = EXCLUDED.response,
+			lock_id = EXCLUDED.lock_id,
+			locked_until = EXCLUDED.locked_until,
+			created_at = EXCLUDED.created_at,
+			expires_at = EXCLUDED.expires_at
+		WHERE idempotency_keys.expires_at <= EXCLUDED.created_at
+			OR (idempotency_keys.resource_id = '' AND idempotency_keys.locked_until <= EXCLUDED.created_at
+				AND idempotency_keys.request_hash = EXCLUDED.request_hash)`).
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := insertQuery.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	tag, err := r.db.Exec(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if tag.RowsAffected() > 0 {
+		return nil, nil
+	}
+
+	selectQuery := sq.Select(idempotencyKeyColumns...).
+		From(idempotencyKeysTable).
+		Where(sq.Eq{"user_id": key.UserID, "operation": key.Operation, "key": key.Key}).
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err = selectQuery.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var stored domain.IdempotencyKey
+	if err := r.db.QueryRow(ctx, query, args...).Scan(&stored.UserID, &stored.Operation, &stored.Key,
+		&stored.RequestHash, &stored.ResourceID, &stored.Response, &stored.LockID, &stored.LockedUntil, &stored.CreatedAt, &stored.ExpiresAt); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &stored, nil
+}
+
+// Release implements repository.IdempotencyRepository.
+func (r *IdempotencyRepository) Release(ctx context.Context, key *domain.IdempotencyKey) error {
+	const op = "repository.IdempotencyRepository.Release"
+
+	deleteQuery := sq.Delete(idempotencyKeysTable).
+		Where(sq.Eq{"user_id": key.UserID, "operation": key.Operation, "key": key.Key, "resource_id": "", "lock_id": key.LockID}).
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := deleteQuery.ToSql()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if _, err := r.db.Exec(ctx, query, args...); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// DeleteExpired implements repository.IdempotencyRepository.
+func (r *IdempotencyRepository) DeleteExpired(ctx context.Context, now time.Time) (int64, error) {
+	const op = "repository.IdempotencyRepository.DeleteExpired"
+
+	deleteQuery := sq.Delete(idempotencyKeysTable).
+		Where(sq.LtOrEq{"expires_at": now}).
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := deleteQuery.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	tag, err := r.db.Exec(ctx, query, args...)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return tag.RowsAffected(), nil
+}
+
+// completeIdempotencyKey records result of request in transaction that saved it.
+// Key taken over by retry after its lease ended fails with domain.ErrRequestInProgress, so result is not saved twice.
+func completeIdempotencyKey(ctx context.Context, tx pgx.Tx, key *domain.IdempotencyKey) error {
+	updateQuery := sq.Update(idempotencyKeysTable).
+		Set("resource_id", key.ResourceID).
+		Set("response", key.Response).
+		Where(sq.Eq{"user_id": key.UserID, "operation": key.Operation, "key": key.Key, "resource_id": "", "lock_id": key.LockID}).
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := updateQuery.ToSql()
+	if err != nil {
+		return err
+	}
+
+	tag, err := tx.Exec(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return domain.ErrRequestInProgress
+	}
+
+	return nil
+}
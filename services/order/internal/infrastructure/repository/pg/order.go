@@ -4,29 +4,29 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"strconv"
-	"strings"
+	"time"
 
 	sq "github.com/Masterminds/squirrel"
 	"github.com/dzhordano/ecom-thing/services/order/internal/domain"
 	"github.com/dzhordano/ecom-thing/services/order/internal/domain/repository"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
 var (
-	ordersTable  = "orders"
-	couponsTable = "coupons"
-	outboxTable  = "outbox"
+	ordersTable = "orders"
+	itemsTable  = "order_items"
+	eventsTable = "order_events"
 
-	kafkaEventOrderCreated       = "order-created"
-	kafkaEventOrderCancelled     = "order-cancelled"
-	kafkaEventQuantityRequested  = "quantity-requested"
-	kafkaEventQuantityReleased   = "quantity-released"
-	kafkaEventQuantitySubtracted = "quantity-subtracted"
+	// addressColumns hold parts of delivery address, see addressValues.
+	addressColumns = []string{"delivery_country", "delivery_region", "delivery_city", "delivery_postal_code", "delivery_street",
+		"delivery_building", "delivery_apartment", "delivery_recipient", "delivery_phone"}
 
-	kafkaOrderTopic = "order-events"
+	orderColumns = append([]string{"id", "user_id", "description", "status", "currency", "shipping_fee", "tax_region", "payment_method",
+		"delivery_method", "delivery_date", "coupon_code", "version", "deleted_at", "deleted_by", "anonymized_at", "created_at", "updated_at"},
+		addressColumns...)
 )
 
 type OrderRepository struct {
@@ -42,54 +42,28 @@ func NewOrderRepository(db *pgxpool.Pool) repository.OrderRepository {
 // Save implements repository.OrderRepository.
 //
 // TODO Tx IMPROVE BRUDDA
-func (o *OrderRepository) Save(ctx context.Context, order *domain.Order) error {
+func (o *OrderRepository) Save(ctx context.Context, order *domain.Order, entry *domain.HistoryEntry) error {
 	const op = "repository.OrderRepository.Create"
 
 	return o.withTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
-		insertQuery := sq.Insert(ordersTable).
-			Columns("id", "user_id", "description", "status", "currency", "total_price", "payment_method",
-				"delivery_method", "delivery_address", "delivery_date", "items", "created_at", "updated_at").
-			Values(order.ID.String(), order.UserID.String(), order.Description, order.Status, order.Currency, order.TotalPrice, order.PaymentMethod,
-				order.DeliveryMethod, order.DeliveryAddress, order.DeliveryDate, order.Items, order.CreatedAt, order.UpdatedAt).
-			PlaceholderFormat(sq.Dollar)
-
-		query, args, err := insertQuery.ToSql()
-		if err != nil {
-			return fmt.Errorf("%s: %w", op, err)
-		}
-
-		_, err = tx.Exec(ctx, query, args...)
-		if err != nil {
-			return fmt.Errorf("%s: %w", op, err)
-		}
-
-		insertQuery = sq.Insert(outboxTable).
-			Columns("topic", "event_type", "payload", "created_at").
-			Values(kafkaOrderTopic, kafkaEventOrderCreated, order.OrderEvent(), order.CreatedAt).
-			PlaceholderFormat(sq.Dollar)
-
-		query, args, err = insertQuery.ToSql()
-		if err != nil {
+		if err := insertOrder(ctx, tx, order, entry); err != nil {
 			return fmt.Errorf("%s: %w", op, err)
 		}
 
-		_, err = tx.Exec(ctx, query, args...)
-		if err != nil {
-			return fmt.Errorf("%s: %w", op, err)
-		}
+		return nil
+	})
+}
 
-		insertQuery = sq.Insert(outboxTable).
-			Columns("topic", "event_type", "payload", "created_at").
-			Values(kafkaOrderTopic, kafkaEventQuantityRequested, order.InventoryEvent(), order.CreatedAt).
-			PlaceholderFormat(sq.Dollar)
+// SaveWithKey implements repository.OrderRepository.
+func (o *OrderRepository) SaveWithKey(ctx context.Context, order *domain.Order, entry *domain.HistoryEntry, key *domain.IdempotencyKey) error {
+	const op = "repository.OrderRepository.SaveWithKey"
 
-		query, args, err = insertQuery.ToSql()
-		if err != nil {
+	return o.withTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		if err := insertOrder(ctx, tx, order, entry); err != nil {
 			return fmt.Errorf("%s: %w", op, err)
 		}
 
-		_, err = tx.Exec(ctx, query, args...)
-		if err != nil {
+		if err := completeIdempotencyKey(ctx, tx, key); err != nil {
 			return fmt.Errorf("%s: %w", op, err)
 		}
 
@@ -97,57 +71,48 @@ func (o *OrderRepository) Save(ctx context.Context, order *domain.Order) error {
 	})
 }
 
-// GetById implements repository.OrderRepository.
-func (o *OrderRepository) GetById(ctx context.Context, orderId string) (*domain.Order, error) {
-	const op = "repository.OrderRepository.GetById"
+func insertOrder(ctx context.Context, tx pgx.Tx, order *domain.Order, entry *domain.HistoryEntry) error {
+	if err := bookDeliverySlot(ctx, tx, order); err != nil {
+		return err
+	}
 
-	selectQuery := sq.Select("id", "user_id", "description", "status", "currency", "total_price", "payment_method",
-		"delivery_method", "delivery_address", "delivery_date", "items", "created_at", "updated_at").
-		From(ordersTable).
-		Where(sq.Eq{"id": orderId}).
+	insertQuery := sq.Insert(ordersTable).
+		Columns("id", "user_id", "description", "status", "currency", "total_price", "shipping_fee", "tax_region", "payment_method",
+			"delivery_method", "delivery_address", "delivery_date", "coupon_code", "version", "created_at", "updated_at").
+		Columns(addressColumns...).
+		Values(append([]any{order.ID.String(), order.UserID.String(), order.Description, order.Status, order.Currency, order.TotalPrice(), order.ShippingFee,
+			order.TaxRegion, order.PaymentMethod, order.DeliveryMethod, order.DeliveryAddress.String(), order.DeliveryDate, order.Coupon, order.Version,
+			order.CreatedAt, order.UpdatedAt},
+			addressValues(order.DeliveryAddress)...)...).
 		PlaceholderFormat(sq.Dollar)
 
-	query, args, err := selectQuery.ToSql()
+	query, args, err := insertQuery.ToSql()
 	if err != nil {
-		return nil, fmt.Errorf("%s: %w", op, err)
+		return err
 	}
 
-	var order domain.Order
-	var items []string
-	if err := o.db.QueryRow(ctx, query, args...).Scan(
-		&order.ID,
-		&order.UserID,
-		&order.Description,
-		&order.Status,
-		&order.Currency,
-		&order.TotalPrice,
-		&order.PaymentMethod,
-		&order.DeliveryMethod,
-		&order.DeliveryAddress,
-		&order.DeliveryDate,
-		&items,
-		&order.CreatedAt,
-		&order.UpdatedAt,
-	); err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, fmt.Errorf("%s: %w", op, domain.ErrOrderNotFound)
-		}
-		return nil, fmt.Errorf("%s: %w", op, err)
+	if _, err := tx.Exec(ctx, query, args...); err != nil {
+		return err
 	}
 
-	parseItems(&order, items)
+	if err := insertItems(ctx, tx, order); err != nil {
+		return err
+	}
 
-	return &order, nil
+	if err := redeemCoupon(ctx, tx, order); err != nil {
+		return err
+	}
+
+	return insertHistory(ctx, tx, entry)
 }
 
-// ListByUser implements repository.OrderRepository.
-func (o *OrderRepository) ListByUser(ctx context.Context, userId string) ([]*domain.Order, error) {
-	const op = "repository.OrderRepository.ListByUser"
+// GetById implements repository.OrderRepository.
+func (o *OrderRepository) GetById(ctx context.Context, orderId string) (*domain.Order, error) {
+	const op = "repository.OrderRepository.GetById"
 
-	selectQuery := sq.Select("id", "user_id", "description", "status", "currency", "total_price", "payment_method",
-		"delivery_method", "delivery_address", "delivery_date", "items", "created_at", "updated_at").
+	selectQuery := sq.Select(orderColumns...).
 		From(ordersTable).
-		Where(sq.Eq{"user_id": userId}).
+		Where(sq.Eq{"id": orderId}).
 		PlaceholderFormat(sq.Dollar)
 
 	query, args, err := selectQuery.ToSql()
@@ -155,145 +120,149 @@ func (o *OrderRepository) ListByUser(ctx context.Context, userId string) ([]*dom
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
 
-	rows, err := o.db.Query(ctx, query, args...)
+	q := conn(ctx, o.db)
+
+	order, err := scanOrder(q.QueryRow(ctx, query, args...))
 	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%s: %w", op, domain.ErrOrderNotFound)
+		}
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
 
-	var orders []*domain.Order
-	for rows.Next() {
-		var order domain.Order
-		var items []string
-		if err := rows.Scan(&order.ID, &order.UserID, &order.Description, &order.Status, &order.Currency, &order.TotalPrice, &order.PaymentMethod,
-			&order.DeliveryMethod, &order.DeliveryAddress, &order.DeliveryDate, &items, &order.CreatedAt, &order.UpdatedAt); err != nil {
-			return nil, fmt.Errorf("%s: %w", op, err)
-		}
-
-		parseItems(&order, items)
-
-		orders = append(orders, &order)
+	if err := loadItems(ctx, q, []*domain.Order{order}); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
 	}
 
-	return orders, nil
+	return order, nil
 }
 
 // TODO Хорошо ли? Узнать про bloat.
 //
 // Update implements repository.OrderRepository.
-func (o *OrderRepository) Update(ctx context.Context, order *domain.Order) error {
+func (o *OrderRepository) Update(ctx context.Context, order *domain.Order, entry *domain.HistoryEntry) error {
 	const op = "repository.OrderRepository.Update"
 
 	return o.withTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
-		updateQuery := sq.Update(ordersTable).
-			Set("description", order.Description).
-			Set("status", order.Status).
-			Set("currency", order.Currency).
-			Set("total_price", order.TotalPrice).
-			Set("payment_method", order.PaymentMethod).
-			Set("delivery_method", order.DeliveryMethod).
-			Set("delivery_address", order.DeliveryAddress).
-			Set("delivery_date", order.DeliveryDate).
-			Set("items", order.Items).
-			Set("updated_at", order.UpdatedAt).
-			Where(sq.Eq{"id": order.ID}).
-			PlaceholderFormat(sq.Dollar)
-
-		query, args, err := updateQuery.ToSql()
-		if err != nil {
-			return fmt.Errorf("%s: %w", op, err)
-		}
-
-		_, err = tx.Exec(ctx, query, args...)
-		if err != nil {
-			if errors.Is(err, pgx.ErrNoRows) {
-				return fmt.Errorf("%s: %w", op, domain.ErrOrderNotFound)
-			}
+		if err := rebookOrder(ctx, tx, order, entry); err != nil {
 			return fmt.Errorf("%s: %w", op, err)
 		}
 
-		var kafkaEvent string
-		switch order.Status {
-		case domain.OrderCancelled:
-			kafkaEvent = kafkaEventQuantityReleased // When we cancel order we expected quantity to be released.
-		case domain.OrderCompleted:
-			kafkaEvent = kafkaEventQuantitySubtracted // And when order is completed we expected quantity to be removed at all.
-		}
+		return nil
+	})
+}
 
-		// insert to inventory outbox
-		insertQuery := sq.Insert(outboxTable).
-			Columns("topic", "event_type", "payload", "created_at").
-			Values(kafkaOrderTopic, kafkaEvent, order.InventoryEvent(), order.UpdatedAt).
-			PlaceholderFormat(sq.Dollar)
+// UpdateItems implements repository.OrderRepository.
+func (o *OrderRepository) UpdateItems(ctx context.Context, order *domain.Order, change *domain.StockChange, entry *domain.HistoryEntry) error {
+	const op = "repository.OrderRepository.UpdateItems"
 
-		query, args, err = insertQuery.ToSql()
-		if err != nil {
+	return o.withTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		if err := rebookOrder(ctx, tx, order, entry); err != nil {
 			return fmt.Errorf("%s: %w", op, err)
 		}
 
-		_, err = tx.Exec(ctx, query, args...)
-		if err != nil {
-			return fmt.Errorf("%s: %w", op, err)
+		if len(change.Locked) > 0 {
+			if err := insertStockLock(ctx, tx, change, order.UpdatedAt); err != nil {
+				return fmt.Errorf("%s: %w", op, err)
+			}
+
+			if err := insertOutbox(ctx, tx, outboxEventItemsLocked, change.LockEvent(), order.UpdatedAt); err != nil {
+				return fmt.Errorf("%s: %w", op, err)
+			}
 		}
 
-		// If order isn't cancelled - we're not sending event for payment service's digestion.
-		if order.Status != domain.OrderCancelled {
-			return nil
+		if len(change.Released) > 0 {
+			if err := insertOutbox(ctx, tx, outboxEventItemsReleased, change.ReleaseEvent(), order.UpdatedAt); err != nil {
+				return fmt.Errorf("%s: %w", op, err)
+			}
 		}
 
-		// insert to payment outbox
-		insertQuery = sq.Insert(outboxTable).
-			Columns("topic", "event_type", "payload", "created_at").
-			Values(kafkaOrderTopic, kafkaEvent, order.OrderEvent(), order.UpdatedAt).
-			PlaceholderFormat(sq.Dollar)
+		return nil
+	})
+}
 
-		query, args, err = insertQuery.ToSql()
-		if err != nil {
-			return fmt.Errorf("%s: %w", op, err)
-		}
+// rebookOrder books new delivery slot of order if it changed, then saves order and its history entry.
+func rebookOrder(ctx context.Context, tx pgx.Tx, order *domain.Order, entry *domain.HistoryEntry) error {
+	changed, err := slotChanged(ctx, tx, order)
+	if err != nil {
+		return err
+	}
 
-		_, err = tx.Exec(ctx, query, args...)
-		if err != nil {
-			return fmt.Errorf("%s: %w", op, err)
+	if changed {
+		if err := bookDeliverySlot(ctx, tx, order); err != nil {
+			return err
 		}
+	}
 
-		return nil
-	})
+	if err := updateOrder(ctx, tx, order); err != nil {
+		return err
+	}
+
+	return insertHistory(ctx, tx, entry)
 }
 
-// TODO Тут пока просто удаляем. Также не отправляется никаких событий.
-// Delete implements repository.OrderRepository.
-func (o *OrderRepository) Delete(ctx context.Context, orderId string) error {
-	const op = "repository.OrderRepository.Delete"
+// updateOrder saves order fields, and its lines if they changed, if order version wasn't changed and bumps it.
+func updateOrder(ctx context.Context, tx pgx.Tx, order *domain.Order) error {
+	updateQuery := sq.Update(ordersTable).
+		Set("description", order.Description).
+		Set("status", order.Status).
+		Set("currency", order.Currency).
+		Set("total_price", order.TotalPrice()). // Kept for search filters.
+		Set("shipping_fee", order.ShippingFee).
+		Set("payment_method", order.PaymentMethod).
+		Set("delivery_method", order.DeliveryMethod).
+		Set("delivery_address", order.DeliveryAddress.String()).
+		Set("delivery_date", order.DeliveryDate).
+		Set("version", order.Version+1).
+		Set("deleted_at", nullTime(order.DeletedAt)).
+		Set("deleted_by", nullUUID(order.DeletedBy)).
+		Set("updated_at", order.UpdatedAt).
+		Where(sq.Eq{"id": order.ID, "version": order.Version}).
+		PlaceholderFormat(sq.Dollar)
+
+	for i, value := range addressValues(order.DeliveryAddress) {
+		updateQuery = updateQuery.Set(addressColumns[i], value)
+	}
 
-	return o.withTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
-		deleteQuery := sq.Delete(ordersTable).
-			Where(sq.Eq{"id": orderId}).
-			PlaceholderFormat(sq.Dollar)
+	query, args, err := updateQuery.ToSql()
+	if err != nil {
+		return err
+	}
 
-		query, args, err := deleteQuery.ToSql()
-		if err != nil {
-			return fmt.Errorf("%s: %w", op, err)
-		}
+	tag, err := tx.Exec(ctx, query, args...)
+	if err != nil {
+		return err
+	}
 
-		_, err = tx.Exec(ctx, query, args...)
-		if err != nil {
-			if errors.Is(err, pgx.ErrNoRows) {
-				return fmt.Errorf("%s: %w", op, domain.ErrOrderNotFound)
-			}
-			return fmt.Errorf("%s: %w", op, err)
+	if tag.RowsAffected() == 0 {
+		return domain.ErrOrderConflict
+	}
+
+	// Most updates change status or details only, lines snapshotted at checkout are left as they are.
+	changed, err := itemsChanged(ctx, tx, order)
+	if err != nil {
+		return err
+	}
+
+	if changed {
+		if err := replaceItems(ctx, tx, order); err != nil {
+			return err
 		}
+	}
 
-		return nil
-	})
+	order.Version++
+
+	return nil
 }
 
-// GetCoupon implements repository.OrderRepository.
-func (o *OrderRepository) GetCoupon(ctx context.Context, code string) (*domain.Coupon, error) {
-	const op = "repository.OrderRepository.GetCoupon"
+// GetHistory implements repository.OrderRepository.
+func (o *OrderRepository) GetHistory(ctx context.Context, orderId string) ([]*domain.HistoryEntry, error) {
+	const op = "repository.OrderRepository.GetHistory"
 
-	selectQuery := sq.Select("id", "code", "discount", "valid_from", "valid_to").
-		From(couponsTable).
-		Where(sq.Eq{"code": code}).
+	selectQuery := sq.Select("id", "order_id", "actor_type", "actor_id", "event_type", "diff", "created_at").
+		From(eventsTable).
+		Where(sq.Eq{"order_id": orderId}).
+		OrderBy("id").
 		PlaceholderFormat(sq.Dollar)
 
 	query, args, err := selectQuery.ToSql()
@@ -301,42 +270,167 @@ func (o *OrderRepository) GetCoupon(ctx context.Context, code string) (*domain.C
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
 
-	var disc domain.Coupon
-	if err := o.db.QueryRow(ctx, query, args...).Scan(&disc.ID, &disc.Code, &disc.Discount, &disc.ValidFrom, &disc.ValidTo); err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, fmt.Errorf("%s: %v: %w", op, err, domain.ErrCouponNotFound) // FIXME Думаю вот так больше контекста??? А то терять саму ошибку не очень...
+	rows, err := o.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var entries []*domain.HistoryEntry
+	for rows.Next() {
+		var e domain.HistoryEntry
+		if err := rows.Scan(&e.ID, &e.OrderID, &e.Actor.Type, &e.Actor.ID, &e.Type, &e.Diff, &e.CreatedAt); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
 		}
+
+		entries = append(entries, &e)
+	}
+
+	if err := rows.Err(); err != nil {
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
 
-	return &disc, nil
+	return entries, nil
 }
 
-func (o *OrderRepository) Search(ctx context.Context, params domain.SearchParams) ([]*domain.Order, error) {
+// insertHistory writes order history entry within tx. Nil entry is skipped.
+func insertHistory(ctx context.Context, tx pgx.Tx, entry *domain.HistoryEntry) error {
+	if entry == nil {
+		return nil
+	}
+
+	diff := entry.Diff
+	if diff == nil {
+		diff = []domain.FieldChange{}
+	}
+
+	insertQuery := sq.Insert(eventsTable).
+		Columns("order_id", "actor_type", "actor_id", "event_type", "diff", "created_at").
+		Values(entry.OrderID, entry.Actor.Type, entry.Actor.ID, entry.Type, diff, entry.CreatedAt).
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := insertQuery.ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(ctx, query, args...)
+	return err
+}
+
+// Search implements repository.OrderRepository.
+func (o *OrderRepository) Search(ctx context.Context, params domain.SearchParams) ([]domain.SearchHit, error) {
 	const op = "repository.OrderRepository.Search"
 
-	selectQuery := sq.Select("id", "user_id", "description", "status", "currency", "total_price", "payment_method", "delivery_method",
-		"delivery_address", "delivery_date", "items", "created_at", "updated_at").
+	selectQuery := sq.Select(orderColumns...)
+	if params.Query != nil {
+		selectQuery = selectQuery.Column(rankExpr, *params.Query)
+	} else {
+		selectQuery = selectQuery.Column("0::real")
+	}
+
+	selectQuery, err := filterOrders(selectQuery, params)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	selectQuery, err = pageOrders(selectQuery, params)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	hits, err := queryHits(ctx, o.db, selectQuery)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return hits, nil
+}
+
+// Count implements repository.OrderRepository.
+func (o *OrderRepository) Count(ctx context.Context, params domain.SearchParams) (uint64, error) {
+	const op = "repository.OrderRepository.Count"
+
+	countQuery, err := filterOrders(sq.Select("COUNT(*)"), params)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	query, args, err := countQuery.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var total uint64
+	if err := o.db.QueryRow(ctx, query, args...).Scan(&total); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return total, nil
+}
+
+// Search query is matched against search_vector column, see migration 000010_order_search.
+// Its text search configuration must be the one column is built with.
+const (
+	matchExpr = "search_vector @@ websearch_to_tsquery('simple', ?)"
+	rankExpr  = "ts_rank(search_vector, websearch_to_tsquery('simple', ?))"
+)
+
+// pageOrders sorts by params key with id as tie breaker and continues after cursor, if there is one.
+// Row comparison keeps it a single index range scan on (key, id).
+func pageOrders(selectQuery sq.SelectBuilder, params domain.SearchParams) (sq.SelectBuilder, error) {
+	dir, cmp := "DESC", "<"
+	if params.SortOrder == domain.SortAsc {
+		dir, cmp = "ASC", ">"
+	}
+
+	key, keyArgs := string(params.SortBy), []any(nil)
+	if params.SortBy == domain.SortByRelevance {
+		key, keyArgs = rankExpr, []any{*params.Query}
+	}
+
+	selectQuery = selectQuery.
+		OrderByClause(key+" "+dir+", id "+dir, keyArgs...).
+		Limit(params.Limit)
+
+	cursor, err := params.Cursor()
+	if err != nil {
+		return selectQuery, err
+	}
+
+	if cursor == nil {
+		return selectQuery.Offset(params.Offset), nil
+	}
+
+	v, err := cursor.SortValue()
+	if err != nil {
+		return selectQuery, err
+	}
+
+	return selectQuery.Where("("+key+", id) "+cmp+" (?, ?)", append(keyArgs, v, cursor.ID)...), nil
+}
+
+// filterOrders restricts selectQuery on orders table to orders matching params, paging is not applied.
+func filterOrders(selectQuery sq.SelectBuilder, params domain.SearchParams) (sq.SelectBuilder, error) {
+	selectQuery = selectQuery.
 		From(ordersTable).
-		PlaceholderFormat(sq.Dollar).
-		Limit(params.Limit).
-		Offset(params.Offset)
+		PlaceholderFormat(sq.Dollar)
+
+	if !params.IncludeDeleted {
+		selectQuery = selectQuery.Where(sq.Eq{"deleted_at": nil})
+	}
 
-	// FIXME проверить производительность запроса.
-	// Узнать как такие методы вообще делать/нужны ли они.
+	// Filters other than query are exact matches.
 	if params.Query != nil {
-		selectQuery = selectQuery.Where(sq.Or{
-			sq.Like{"description": fmt.Sprintf("%%%s%%", *params.Query)},
-			sq.Like{"status": fmt.Sprintf("%%%s%%", *params.Query)},
-			sq.Like{"currency": fmt.Sprintf("%%%s%%", *params.Query)},
-			sq.Like{"payment_method": fmt.Sprintf("%%%s%%", *params.Query)},
-			sq.Like{"delivery_method": fmt.Sprintf("%%%s%%", *params.Query)},
-			sq.Like{"delivery_address": fmt.Sprintf("%%%s%%", *params.Query)},
-		})
+		selectQuery = selectQuery.Where(matchExpr, *params.Query)
 	}
 
 	// TODO оптимизировать эту шляпу?
 
+	if params.UserID != nil {
+		selectQuery = selectQuery.Where(sq.Eq{"user_id": *params.UserID})
+	}
+
 	if params.Description != nil {
 		selectQuery = selectQuery.Where(sq.Eq{"description": *params.Description})
 	}
@@ -357,8 +451,16 @@ func (o *OrderRepository) Search(ctx context.Context, params domain.SearchParams
 		selectQuery = selectQuery.Where(sq.Eq{"delivery_method": *params.DeliveryMethod})
 	}
 
-	if params.DeliveryAddress != nil {
-		selectQuery = selectQuery.Where(sq.Eq{"delivery_address": *params.DeliveryAddress})
+	if params.DeliveryCountry != nil {
+		selectQuery = selectQuery.Where(sq.Eq{"delivery_country": *params.DeliveryCountry})
+	}
+
+	if params.DeliveryCity != nil {
+		selectQuery = selectQuery.Where(sq.Expr("lower(delivery_city) = lower(?)", *params.DeliveryCity))
+	}
+
+	if params.DeliveryPostalCode != nil {
+		selectQuery = selectQuery.Where(sq.Eq{"delivery_postal_code": *params.DeliveryPostalCode})
 	}
 
 	if !params.DeliveryDateFrom.IsZero() {
@@ -377,66 +479,164 @@ func (o *OrderRepository) Search(ctx context.Context, params domain.SearchParams
 		selectQuery = selectQuery.Where(sq.LtOrEq{"total_price": *params.MaxPrice})
 	}
 
+	// Lines count per order is looked up by primary key of order_items.
 	if params.MinItemsAmount != nil {
-		selectQuery = selectQuery.Where(sq.GtOrEq{"(array_length(items, 1))": *params.MinItemsAmount})
+		selectQuery = selectQuery.Where("(SELECT COUNT(*) FROM order_items oi WHERE oi.order_id = orders.id) >= ?", *params.MinItemsAmount)
 	}
 
 	if params.MaxItemsAmount != nil {
-		selectQuery = selectQuery.Where(sq.LtOrEq{"(array_length(items, 1))": *params.MaxItemsAmount})
+		selectQuery = selectQuery.Where("(SELECT COUNT(*) FROM order_items oi WHERE oi.order_id = orders.id) <= ?", *params.MaxItemsAmount)
 	}
 
+	if params.ProductID != nil || params.MinItemQuantity != nil || params.MaxItemQuantity != nil {
+		lines := sq.Select("order_id").From(itemsTable)
+
+		if params.ProductID != nil {
+			lines = lines.Where(sq.Eq{"product_id": *params.ProductID})
+		}
+
+		if params.MinItemQuantity != nil {
+			lines = lines.Where(sq.GtOrEq{"quantity": *params.MinItemQuantity})
+		}
+
+		if params.MaxItemQuantity != nil {
+			lines = lines.Where(sq.LtOrEq{"quantity": *params.MaxItemQuantity})
+		}
+
+		linesQuery, linesArgs, err := lines.ToSql()
+		if err != nil {
+			return selectQuery, err
+		}
+
+		selectQuery = selectQuery.Where("id IN ("+linesQuery+")", linesArgs...)
+	}
+
+	return selectQuery, nil
+}
+
+// queryOrders runs select of orderColumns and loads items of found orders.
+func queryOrders(ctx context.Context, q querier, selectQuery sq.SelectBuilder) ([]*domain.Order, error) {
 	query, args, err := selectQuery.ToSql()
 	if err != nil {
-		return nil, fmt.Errorf("%s: %w", op, err)
+		return nil, err
 	}
 
-	rows, err := o.db.Query(ctx, query, args...)
+	rows, err := q.Query(ctx, query, args...)
 	if err != nil {
-		return nil, fmt.Errorf("%s: %w", op, err)
+		return nil, err
 	}
+	defer rows.Close()
 
 	var orders []*domain.Order
 	for rows.Next() {
-		var order domain.Order
-		var items []string
-		if err := rows.Scan(&order.ID, &order.UserID, &order.Description, &order.Status, &order.Currency, &order.TotalPrice, &order.PaymentMethod, &order.DeliveryMethod,
-			&order.DeliveryAddress, &order.DeliveryDate, &items, &order.CreatedAt, &order.UpdatedAt); err != nil {
-			return nil, fmt.Errorf("%s: %w", op, err)
+		order, err := scanOrder(rows)
+		if err != nil {
+			return nil, err
 		}
 
-		parseItems(&order, items)
+		orders = append(orders, order)
+	}
 
-		orders = append(orders, &order)
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if err := loadItems(ctx, q, orders); err != nil {
+		return nil, err
 	}
 
 	return orders, nil
 }
 
-// parseItems преобразует строку полученную из бд в []domain.Item.
-func parseItems(order *domain.Order, items []string) {
-	for _, item := range items {
-		sitems := strings.Split(item, ",")
+// queryHits is queryOrders for select of orderColumns followed by search rank.
+func queryHits(ctx context.Context, q querier, selectQuery sq.SelectBuilder) ([]domain.SearchHit, error) {
+	query, args, err := selectQuery.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := q.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var (
+		hits   []domain.SearchHit
+		orders []*domain.Order
+	)
+	for rows.Next() {
+		var rank float32
+		order, err := scanOrder(rows, &rank)
+		if err != nil {
+			return nil, err
+		}
+
+		hits = append(hits, domain.SearchHit{Order: order, Rank: rank})
+		orders = append(orders, order)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if err := loadItems(ctx, q, orders); err != nil {
+		return nil, err
+	}
+
+	return hits, nil
+}
 
-		pId := (sitems[0])[1:]
-		pQuantity := (sitems[1])[:(len(sitems[1]) - 1)]
+// scanOrder scans orderColumns, extra destinations receive columns selected after them.
+func scanOrder(row pgx.Row, extra ...any) (*domain.Order, error) {
+	var (
+		order                   domain.Order
+		deletedAt, anonymizedAt *time.Time
+		deletedBy               *uuid.UUID
+	)
+	a := &order.DeliveryAddress
+	dest := append([]any{&order.ID, &order.UserID, &order.Description, &order.Status, &order.Currency, &order.ShippingFee, &order.TaxRegion, &order.PaymentMethod,
+		&order.DeliveryMethod, &order.DeliveryDate, &order.Coupon, &order.Version, &deletedAt, &deletedBy, &anonymizedAt, &order.CreatedAt, &order.UpdatedAt,
+		&a.Country, &a.Region, &a.City, &a.PostalCode, &a.Street, &a.Building, &a.Apartment, &a.Recipient, &a.Phone}, extra...)
+	if err := row.Scan(dest...); err != nil {
+		return nil, err
+	}
 
-		order.Items = append(order.Items, domain.Item{
-			ProductID: uuid.MustParse(pId), // TODO По идее невозможна паника, так как БД консистентна...?
-			Quantity:  uint64FromString(pQuantity),
-		})
+	if deletedAt != nil {
+		order.DeletedAt = *deletedAt
+	}
+	if deletedBy != nil {
+		order.DeletedBy = *deletedBy
 	}
+	if anonymizedAt != nil {
+		order.AnonymizedAt = *anonymizedAt
+	}
+
+	order.ShippingFee.Currency = order.Currency.String()
+
+	return &order, nil
 }
 
-// Эта функция подразумевает уже "правильный" ввод, так как поле заведомо валидно.
-//
-// НЕ использовать если это не так.
-func uint64FromString(s string) uint64 {
-	i, _ := strconv.ParseUint(s, 10, 64)
-	return i
+// addressValues returns parts of delivery address in order of addressColumns.
+func addressValues(a domain.Address) []any {
+	return []any{a.Country, a.Region, a.City, a.PostalCode, a.Street, a.Building, a.Apartment, a.Recipient, a.Phone}
 }
 
+// querier is satisfied by both pool and transaction, so helpers can run either way.
+type querier interface {
+	Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+// withTx runs fn in transaction, or in savepoint of transaction ctx is within.
 func (o *OrderRepository) withTx(ctx context.Context, fn func(ctx context.Context, tx pgx.Tx) error) error {
-	tx, err := o.db.Begin(ctx)
+	begin := o.db.Begin
+	if outer, ok := TxFromContext(ctx); ok {
+		begin = outer.Begin
+	}
+
+	tx, err := begin(ctx)
 	if err != nil {
 		return err
 	}
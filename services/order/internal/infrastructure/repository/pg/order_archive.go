@@ -0,0 +1,200 @@
+package pg
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/dzhordano/ecom-thing/services/order/internal/domain"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// Fields of history diffs that hold personal data, see domain.DiffOrders.
+var personalHistoryFields = []string{domain.FieldDescription, domain.FieldDeliveryAddress}
+
+// Delete implements repository.OrderRepository.
+func (o *OrderRepository) Delete(ctx context.Context, order *domain.Order, entry *domain.HistoryEntry) error {
+	const op = "repository.OrderRepository.Delete"
+
+	return o.withTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		// Returns are requested under the same lock, so none can start until order is archived.
+		if _, err := lockOrder(ctx, tx, order.ID.String()); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		if err := checkSettled(ctx, tx, order.ID); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		if err := updateOrder(ctx, tx, order); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		if err := insertHistory(ctx, tx, entry); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		return nil
+	})
+}
+
+// checkSettled returns domain.ErrOrderNotArchivable if checkout of order still holds its stock or payment,
+// or if any of its returns is not refunded or rejected yet.
+func checkSettled(ctx context.Context, tx pgx.Tx, orderId uuid.UUID) error {
+	var checkout, returns bool
+	err := tx.QueryRow(ctx, "SELECT EXISTS (SELECT 1 FROM "+sagasTable+" WHERE order_id = $1 AND state NOT IN ($2, $3)), "+
+		"EXISTS (SELECT 1 FROM "+returnsTable+" WHERE order_id = $1 AND status NOT IN ($4, $5))",
+		orderId, domain.SagaCompleted, domain.SagaCompensated, domain.ReturnRefunded, domain.ReturnRejected).
+		Scan(&checkout, &returns)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case checkout:
+		return fmt.Errorf("%w: checkout is in progress", domain.ErrOrderNotArchivable)
+	case returns:
+		return fmt.Errorf("%w: return is in progress", domain.ErrOrderNotArchivable)
+	}
+
+	return nil
+}
+
+// AnonymizeArchived implements repository.OrderRepository.
+func (o *OrderRepository) AnonymizeArchived(ctx context.Context, deletedBefore time.Time, limit uint64) (int64, error) {
+	const op = "repository.OrderRepository.AnonymizeArchived"
+
+	var anonymized int64
+	err := o.withTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		ids, err := lockArchived(ctx, tx, deletedBefore, limit, true)
+		if err != nil || len(ids) == 0 {
+			return err
+		}
+
+		// Country is kept, order is taxed by it. Other address parts are blanked.
+		updateQuery := sq.Update(ordersTable).
+			Set("user_id", uuid.Nil).
+			Set("description", "").
+			Set("delivery_address", "").
+			Set("anonymized_at", time.Now().UTC()).
+			Set("version", sq.Expr("version + 1")).
+			Where("id = ANY(?)", ids).
+			PlaceholderFormat(sq.Dollar)
+
+		for _, column := range addressColumns {
+			if column != "delivery_country" {
+				updateQuery = updateQuery.Set(column, "")
+			}
+		}
+
+		query, args, err := updateQuery.ToSql()
+		if err != nil {
+			return err
+		}
+
+		tag, err := tx.Exec(ctx, query, args...)
+		if err != nil {
+			return err
+		}
+		anonymized = tag.RowsAffected()
+
+		if _, err := tx.Exec(ctx, "UPDATE "+returnsTable+" SET user_id = $1, reason = '' WHERE order_id = ANY($2)", uuid.Nil, ids); err != nil {
+			return err
+		}
+
+		// Changes keep their field names, so history still tells what was changed and when.
+		_, err = tx.Exec(ctx, "UPDATE "+eventsTable+" SET "+
+			"diff = (SELECT COALESCE(jsonb_agg(CASE WHEN c->>'field' = ANY($2) THEN jsonb_build_object('field', c->'field') ELSE c END), '[]') "+
+			"FROM jsonb_array_elements(diff) c), "+
+			"actor_id = CASE WHEN actor_type = $3 THEN '' ELSE actor_id END "+
+			"WHERE order_id = ANY($1)",
+			ids, personalHistoryFields, domain.ActorUser)
+		return err
+	})
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return anonymized, nil
+}
+
+// PurgeArchived implements repository.OrderRepository.
+func (o *OrderRepository) PurgeArchived(ctx context.Context, deletedBefore time.Time, limit uint64) (int64, error) {
+	const op = "repository.OrderRepository.PurgeArchived"
+
+	var purged int64
+	err := o.withTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		ids, err := lockArchived(ctx, tx, deletedBefore, limit, false)
+		if err != nil || len(ids) == 0 {
+			return err
+		}
+
+		// Lines and returns go with orders by cascade, history and sagas don't reference them.
+		tag, err := tx.Exec(ctx, "DELETE FROM "+ordersTable+" WHERE id = ANY($1)", ids)
+		if err != nil {
+			return err
+		}
+		purged = tag.RowsAffected()
+
+		for _, table := range []string{eventsTable, sagasTable} {
+			if _, err := tx.Exec(ctx, "DELETE FROM "+table+" WHERE order_id = ANY($1)", ids); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return purged, nil
+}
+
+// lockArchived returns ids of up to limit orders archived before given time, oldest first. Anonymized orders
+// are skipped if notAnonymized is set. Orders stay locked until tx ends, ones locked by other replica are left for it.
+func lockArchived(ctx context.Context, tx pgx.Tx, deletedBefore time.Time, limit uint64, notAnonymized bool) ([]uuid.UUID, error) {
+	selectQuery := sq.Select("id").
+		From(ordersTable).
+		Where(sq.Lt{"deleted_at": deletedBefore}).
+		OrderBy("deleted_at").
+		Limit(limit).
+		Suffix("FOR UPDATE SKIP LOCKED").
+		PlaceholderFormat(sq.Dollar)
+
+	if notAnonymized {
+		selectQuery = selectQuery.Where(sq.Eq{"anonymized_at": nil})
+	}
+
+	query, args, err := selectQuery.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := tx.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ids []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, rows.Err()
+}
+
+// nullUUID stores nil uuid as NULL.
+func nullUUID(id uuid.UUID) *uuid.UUID {
+	if id == uuid.Nil {
+		return nil
+	}
+	return &id
+}
@@ -0,0 +1,81 @@
+package pg
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/dzhordano/ecom-thing/services/order/internal/domain"
+	"github.com/dzhordano/ecom-thing/services/order/internal/domain/repository"
+	"github.com/jackc/pgx/v5"
+)
+
+// CancelItems implements repository.OrderRepository.
+func (o *OrderRepository) CancelItems(ctx context.Context, orderId string, cancel repository.CancelItemsFunc) (*domain.Order, error) {
+	const op = "repository.OrderRepository.CancelItems"
+
+	var order *domain.Order
+	err := o.withTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		var err error
+		if order, err = lockOrder(ctx, tx, orderId); err != nil {
+			return err
+		}
+
+		cancellation, entry, err := cancel(order)
+		if err != nil {
+			return err
+		}
+
+		if err := updateOrder(ctx, tx, order); err != nil {
+			return err
+		}
+
+		if err := insertHistory(ctx, tx, entry); err != nil {
+			return err
+		}
+
+		if err := insertOutbox(ctx, tx, outboxEventItemsReleased, cancellation.StockEvent(), order.UpdatedAt); err != nil {
+			return err
+		}
+
+		if !cancellation.Paid {
+			return nil
+		}
+
+		return insertOutbox(ctx, tx, outboxEventRefundRequested, cancellation.RefundEvent(), order.UpdatedAt)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return order, nil
+}
+
+// lockOrder returns order with its items, locked until tx ends.
+func lockOrder(ctx context.Context, tx pgx.Tx, orderId string) (*domain.Order, error) {
+	selectQuery := sq.Select(orderColumns...).
+		From(ordersTable).
+		Where(sq.Eq{"id": orderId}).
+		Suffix("FOR UPDATE").
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := selectQuery.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	order, err := scanOrder(tx.QueryRow(ctx, query, args...))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrOrderNotFound
+		}
+		return nil, err
+	}
+
+	if err := loadItems(ctx, tx, []*domain.Order{order}); err != nil {
+		return nil, err
+	}
+
+	return order, nil
+}
@@ -0,0 +1,171 @@
+package pg
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/dzhordano/ecom-thing/services/order/internal/domain"
+	"github.com/dzhordano/ecom-thing/services/order/internal/domain/repository"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// ExpirePending implements repository.OrderRepository.
+func (o *OrderRepository) ExpirePending(ctx context.Context, createdBefore time.Time, limit uint64, skip []uuid.UUID,
+	expire repository.ExpireFunc) ([]*domain.Order, map[uuid.UUID]error, error) {
+	const op = "repository.OrderRepository.ExpirePending"
+
+	var expired []*domain.Order
+	failed := make(map[uuid.UUID]error)
+	err := o.withTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		// Orders stay locked until commit. Ones locked by other replica are left for it.
+		selectQuery := sq.Select(orderColumns...).
+			From(ordersTable).
+			Where(sq.Eq{"status": domain.OrderPending}).
+			Where(sq.Lt{"created_at": createdBefore}).
+			OrderBy("created_at").
+			Limit(limit).
+			Suffix("FOR UPDATE SKIP LOCKED").
+			PlaceholderFormat(sq.Dollar)
+
+		if len(skip) > 0 {
+			selectQuery = selectQuery.Where("NOT (id = ANY(?))", skip)
+		}
+
+		orders, err := queryOrders(ctx, tx, selectQuery)
+		if err != nil {
+			return err
+		}
+
+		sagas, err := lockUnfinishedSagas(ctx, tx, orders)
+		if err != nil {
+			return err
+		}
+
+		for _, order := range orders {
+			if err := expireOrder(ctx, tx, order, sagas[order.ID], expire); err != nil {
+				failed[order.ID] = err
+				continue
+			}
+
+			expired = append(expired, order)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return expired, failed, nil
+}
+
+// expireOrder writes expired order in savepoint, so its failure rolls back only the order and not the whole batch.
+func expireOrder(ctx context.Context, tx pgx.Tx, order *domain.Order, saga *domain.CheckoutSaga, expire repository.ExpireFunc) error {
+	entry, err := expire(order, saga)
+	if err != nil {
+		return err
+	}
+
+	sp, err := tx.Begin(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := writeExpiredOrder(ctx, sp, order, saga, entry); err != nil {
+		if rbErr := sp.Rollback(ctx); rbErr != nil {
+			return rbErr
+		}
+		return err
+	}
+
+	return sp.Commit(ctx)
+}
+
+func writeExpiredOrder(ctx context.Context, tx pgx.Tx, order *domain.Order, saga *domain.CheckoutSaga, entry *domain.HistoryEntry) error {
+	if err := updateStatus(ctx, tx, order); err != nil {
+		return err
+	}
+
+	if saga != nil {
+		if err := updateSaga(ctx, tx, saga); err != nil {
+			return err
+		}
+	}
+
+	if err := insertHistory(ctx, tx, entry); err != nil {
+		return err
+	}
+
+	if err := insertOutbox(ctx, tx, outboxEventQuantityReleased, order.InventoryEvent(), order.UpdatedAt); err != nil {
+		return err
+	}
+
+	return insertOutbox(ctx, tx, outboxEventOrderCancelled, order.OrderEvent(), order.UpdatedAt)
+}
+
+// lockUnfinishedSagas returns unfinished sagas of given orders by order id, locked until tx ends.
+func lockUnfinishedSagas(ctx context.Context, tx pgx.Tx, orders []*domain.Order) (map[uuid.UUID]*domain.CheckoutSaga, error) {
+	sagas := make(map[uuid.UUID]*domain.CheckoutSaga, len(orders))
+	if len(orders) == 0 {
+		return sagas, nil
+	}
+
+	ids := make([]uuid.UUID, 0, len(orders))
+	for _, order := range orders {
+		ids = append(ids, order.ID)
+	}
+
+	selectQuery := sq.Select(sagaColumns...).
+		From(sagasTable).
+		Where("order_id = ANY(?)", ids).
+		Where(sq.NotEq{"state": []domain.SagaState{domain.SagaCompleted, domain.SagaCompensated}}).
+		Suffix("FOR UPDATE").
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := selectQuery.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := tx.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		saga, err := scanSaga(rows)
+		if err != nil {
+			return nil, err
+		}
+		sagas[saga.OrderID] = saga
+	}
+
+	return sagas, rows.Err()
+}
+
+// updateStatus saves status of locked order and bumps its version.
+func updateStatus(ctx context.Context, tx pgx.Tx, order *domain.Order) error {
+	updateQuery := sq.Update(ordersTable).
+		Set("status", order.Status).
+		Set("version", sq.Expr("version + 1")).
+		Set("updated_at", order.UpdatedAt).
+		Where(sq.Eq{"id": order.ID}).
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := updateQuery.ToSql()
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.Exec(ctx, query, args...); err != nil {
+		return err
+	}
+
+	order.Version++
+
+	return nil
+}
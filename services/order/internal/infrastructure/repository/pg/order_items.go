@@ -0,0 +1,136 @@
+package pg
+
+import (
+	"context"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/dzhordano/ecom-thing/services/order/internal/domain"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// Order lines and return lines share layout, table differs only in owner column.
+var lineColumns = []string{"line_no", "product_id", "quantity", "name", "unit_price", "currency", "discount",
+	"tax_rate", "tax", "tax_included"}
+
+// insertItems stores order lines numbered from 1 in order they appear in order.Items.
+func insertItems(ctx context.Context, tx pgx.Tx, order *domain.Order) error {
+	return insertLines(ctx, tx, itemsTable, "order_id", order.ID, order.Items)
+}
+
+// replaceItems overwrites stored lines with current order.Items.
+func replaceItems(ctx context.Context, tx pgx.Tx, order *domain.Order) error {
+	deleteQuery := sq.Delete(itemsTable).
+		Where(sq.Eq{"order_id": order.ID}).
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := deleteQuery.ToSql()
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.Exec(ctx, query, args...); err != nil {
+		return err
+	}
+
+	return insertItems(ctx, tx, order)
+}
+
+// itemsChanged reports whether order.Items differ from stored lines of order.
+func itemsChanged(ctx context.Context, tx pgx.Tx, order *domain.Order) (bool, error) {
+	lines, err := queryLines(ctx, tx, itemsTable, "order_id", []uuid.UUID{order.ID})
+	if err != nil {
+		return false, err
+	}
+
+	return !lines[order.ID].Equal(order.Items), nil
+}
+
+// loadItems fills Items of given orders with a single query.
+func loadItems(ctx context.Context, q querier, orders []*domain.Order) error {
+	if len(orders) == 0 {
+		return nil
+	}
+
+	ids := make([]uuid.UUID, 0, len(orders))
+	for _, order := range orders {
+		ids = append(ids, order.ID)
+	}
+
+	lines, err := queryLines(ctx, q, itemsTable, "order_id", ids)
+	if err != nil {
+		return err
+	}
+
+	for _, order := range orders {
+		order.Items = lines[order.ID]
+		if order.Items == nil {
+			order.Items = domain.Items{}
+		}
+	}
+
+	return nil
+}
+
+// insertLines stores items of owner numbered from 1.
+func insertLines(ctx context.Context, tx pgx.Tx, table, ownerColumn string, ownerId uuid.UUID, items domain.Items) error {
+	if len(items) == 0 {
+		return nil
+	}
+
+	insertQuery := sq.Insert(table).
+		Columns(append([]string{ownerColumn}, lineColumns...)...).
+		PlaceholderFormat(sq.Dollar)
+
+	for i, item := range items {
+		insertQuery = insertQuery.Values(ownerId, i+1, item.ProductID, item.Quantity, item.Name,
+			item.UnitPrice, item.UnitPrice.Currency, item.Discount, item.TaxRate, item.Tax, item.TaxIncluded)
+	}
+
+	query, args, err := insertQuery.ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(ctx, query, args...)
+	return err
+}
+
+// queryLines returns items of given owners by owner id, in line order.
+func queryLines(ctx context.Context, q querier, table, ownerColumn string, ownerIds []uuid.UUID) (map[uuid.UUID]domain.Items, error) {
+	selectQuery := sq.Select(ownerColumn, "product_id", "quantity", "name", "unit_price", "currency", "discount",
+		"tax_rate", "tax", "tax_included").
+		From(table).
+		Where(ownerColumn+" = ANY(?)", ownerIds).
+		OrderBy(ownerColumn, "line_no").
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := selectQuery.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := q.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	lines := make(map[uuid.UUID]domain.Items, len(ownerIds))
+	for rows.Next() {
+		var ownerId uuid.UUID
+		var item domain.Item
+		var currency string
+		if err := rows.Scan(&ownerId, &item.ProductID, &item.Quantity, &item.Name,
+			&item.UnitPrice, &currency, &item.Discount, &item.TaxRate, &item.Tax, &item.TaxIncluded); err != nil {
+			return nil, err
+		}
+		item.UnitPrice.Currency = currency
+		item.Discount.Currency = currency
+		item.Tax.Currency = currency
+
+		lines[ownerId] = append(lines[ownerId], item)
+	}
+
+	return lines, rows.Err()
+}
@@ -0,0 +1,78 @@
+package pg
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/dzhordano/ecom-thing/services/order/internal/domain"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+const stockLocksTable = "order_stock_locks"
+
+// TakeStockLock implements repository.OrderRepository.
+func (o *OrderRepository) TakeStockLock(ctx context.Context, orderId, lockId string) (domain.Items, error) {
+	const op = "repository.OrderRepository.TakeStockLock"
+
+	var items domain.Items
+	err := o.withTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		deleteQuery := sq.Delete(stockLocksTable).
+			Where(sq.Eq{"id": lockId, "order_id": orderId}).
+			Suffix("RETURNING items").
+			PlaceholderFormat(sq.Dollar)
+
+		query, args, err := deleteQuery.ToSql()
+		if err != nil {
+			return err
+		}
+
+		var quantities map[string]uint64
+		if err := tx.QueryRow(ctx, query, args...).Scan(&quantities); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return domain.ErrStockLockNotFound
+			}
+			return err
+		}
+
+		for id, q := range quantities {
+			productId, err := uuid.Parse(id)
+			if err != nil {
+				return err
+			}
+			items = append(items, domain.Item{ProductID: productId, Quantity: q})
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return items, nil
+}
+
+// insertStockLock keeps units change locks until inventory replies, so rejected ones can be taken off order.
+// Quantities are stored by product id, the way inventory gets them.
+func insertStockLock(ctx context.Context, tx pgx.Tx, change *domain.StockChange, now time.Time) error {
+	quantities := make(map[string]uint64, len(change.Locked))
+	for _, item := range change.Locked {
+		quantities[item.ProductID.String()] += item.Quantity
+	}
+
+	insertQuery := sq.Insert(stockLocksTable).
+		Columns("id", "order_id", "items", "created_at").
+		Values(change.LockID, change.OrderID, quantities, now).
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := insertQuery.ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(ctx, query, args...)
+	return err
+}
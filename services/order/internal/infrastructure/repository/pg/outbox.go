@@ -0,0 +1,44 @@
+package pg
+
+import (
+	"context"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+)
+
+// Events repositories write to outbox along with data they describe.
+// Names match ones used by outbox commands, so consumers can't tell them apart.
+var (
+	outboxTable = "outbox"
+
+	outboxOrderTopic = "order-events"
+
+	outboxEventOrderCancelled   = "order-cancelled"
+	outboxEventQuantityReleased = "quantity-released"
+
+	// Partial counterparts of the above, emitted for cancelled, removed and returned lines only.
+	outboxEventItemsReleased   = "items-released"
+	outboxEventItemsReturned   = "items-returned"
+	outboxEventRefundRequested = "refund-requested"
+
+	// Stock of units added to order after its stock was requested.
+	outboxEventItemsLocked = "items-locked"
+)
+
+// insertOutbox writes event within tx. It is published by outbox processor after tx commits.
+func insertOutbox(ctx context.Context, tx pgx.Tx, eventType string, payload any, now time.Time) error {
+	insertQuery := sq.Insert(outboxTable).
+		Columns("topic", "event_type", "payload", "created_at").
+		Values(outboxOrderTopic, eventType, payload, now).
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := insertQuery.ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(ctx, query, args...)
+	return err
+}
@@ -0,0 +1,230 @@
+package pg
+
+import (
+	"context"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/dzhordano/ecom-thing/services/order/internal/domain"
+	"github.com/dzhordano/ecom-thing/services/order/internal/domain/repository"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+var (
+	returnsTable     = "order_returns"
+	returnItemsTable = "return_items"
+
+	returnColumns = []string{"id", "order_id", "user_id", "currency", "reason", "status", "created_at", "updated_at"}
+)
+
+type ReturnRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewReturnRepository(db *pgxpool.Pool) repository.ReturnRepository {
+	return &ReturnRepository{
+		db: db,
+	}
+}
+
+// Create implements repository.ReturnRepository.
+func (r *ReturnRepository) Create(ctx context.Context, orderId string, create repository.CreateReturnFunc) (*domain.Return, error) {
+	const op = "repository.ReturnRepository.Create"
+
+	var ret *domain.Return
+	err := r.withTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		// Order lock keeps concurrent returns from taking the same items twice.
+		order, err := lockOrder(ctx, tx, orderId)
+		if err != nil {
+			return err
+		}
+
+		returns, err := queryReturns(ctx, tx, returnsQuery().Where(sq.Eq{"order_id": order.ID}))
+		if err != nil {
+			return err
+		}
+
+		if ret, err = create(order, returns); err != nil {
+			return err
+		}
+
+		insertQuery := sq.Insert(returnsTable).
+			Columns(returnColumns...).
+			Values(ret.ID, ret.OrderID, ret.UserID, ret.Currency, ret.Reason, ret.Status, ret.CreatedAt, ret.UpdatedAt).
+			PlaceholderFormat(sq.Dollar)
+
+		query, args, err := insertQuery.ToSql()
+		if err != nil {
+			return err
+		}
+
+		if _, err := tx.Exec(ctx, query, args...); err != nil {
+			return err
+		}
+
+		return insertLines(ctx, tx, returnItemsTable, "return_id", ret.ID, ret.Items)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return ret, nil
+}
+
+// GetById implements repository.ReturnRepository.
+func (r *ReturnRepository) GetById(ctx context.Context, returnId string) (*domain.Return, error) {
+	const op = "repository.ReturnRepository.GetById"
+
+	returns, err := queryReturns(ctx, r.db, returnsQuery().Where(sq.Eq{"id": returnId}))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if len(returns) == 0 {
+		return nil, fmt.Errorf("%s: %w", op, domain.ErrReturnNotFound)
+	}
+
+	return returns[0], nil
+}
+
+// ListByOrder implements repository.ReturnRepository.
+func (r *ReturnRepository) ListByOrder(ctx context.Context, orderId string) ([]*domain.Return, error) {
+	const op = "repository.ReturnRepository.ListByOrder"
+
+	returns, err := queryReturns(ctx, r.db, returnsQuery().Where(sq.Eq{"order_id": orderId}))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return returns, nil
+}
+
+// Update implements repository.ReturnRepository.
+func (r *ReturnRepository) Update(ctx context.Context, returnId string, update func(r *domain.Return) error) (*domain.Return, error) {
+	const op = "repository.ReturnRepository.Update"
+
+	var ret *domain.Return
+	err := r.withTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		returns, err := queryReturns(ctx, tx, returnsQuery().Where(sq.Eq{"id": returnId}).Suffix("FOR UPDATE"))
+		if err != nil {
+			return err
+		}
+
+		if len(returns) == 0 {
+			return domain.ErrReturnNotFound
+		}
+		ret = returns[0]
+
+		before := ret.Status
+		if err := update(ret); err != nil {
+			return err
+		}
+
+		updateQuery := sq.Update(returnsTable).
+			Set("status", ret.Status).
+			Set("updated_at", ret.UpdatedAt).
+			Where(sq.Eq{"id": ret.ID}).
+			PlaceholderFormat(sq.Dollar)
+
+		query, args, err := updateQuery.ToSql()
+		if err != nil {
+			return err
+		}
+
+		if _, err := tx.Exec(ctx, query, args...); err != nil {
+			return err
+		}
+
+		if before == ret.Status {
+			return nil
+		}
+
+		switch ret.Status {
+		case domain.ReturnReceived:
+			return insertOutbox(ctx, tx, outboxEventItemsReturned, ret.StockEvent(), ret.UpdatedAt)
+		case domain.ReturnRefunded:
+			return insertOutbox(ctx, tx, outboxEventRefundRequested, ret.RefundEvent(), ret.UpdatedAt)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return ret, nil
+}
+
+func returnsQuery() sq.SelectBuilder {
+	return sq.Select(returnColumns...).
+		From(returnsTable).
+		OrderBy("created_at", "id").
+		PlaceholderFormat(sq.Dollar)
+}
+
+// queryReturns runs select of returnColumns and loads items of found returns.
+func queryReturns(ctx context.Context, q querier, selectQuery sq.SelectBuilder) ([]*domain.Return, error) {
+	query, args, err := selectQuery.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := q.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var returns []*domain.Return
+	for rows.Next() {
+		var ret domain.Return
+		if err := rows.Scan(&ret.ID, &ret.OrderID, &ret.UserID, &ret.Currency, &ret.Reason, &ret.Status,
+			&ret.CreatedAt, &ret.UpdatedAt); err != nil {
+			return nil, err
+		}
+
+		returns = append(returns, &ret)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(returns) == 0 {
+		return returns, nil
+	}
+
+	ids := make([]uuid.UUID, 0, len(returns))
+	for _, ret := range returns {
+		ids = append(ids, ret.ID)
+	}
+
+	lines, err := queryLines(ctx, q, returnItemsTable, "return_id", ids)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, ret := range returns {
+		ret.Items = lines[ret.ID]
+	}
+
+	return returns, nil
+}
+
+func (r *ReturnRepository) withTx(ctx context.Context, fn func(ctx context.Context, tx pgx.Tx) error) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := fn(ctx, tx); err != nil {
+		if rbErr := tx.Rollback(ctx); rbErr != nil {
+			return rbErr
+		}
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
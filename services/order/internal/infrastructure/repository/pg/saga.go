@@ -0,0 +1,189 @@
+package pg
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/dzhordano/ecom-thing/services/order/internal/domain"
+	"github.com/dzhordano/ecom-thing/services/order/internal/domain/repository"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+var (
+	sagasTable = "checkout_sagas"
+
+	sagaColumns = []string{"order_id", "state", "payment_requested", "stock_committed", "payment_captured", "reason",
+		"deadline", "retry_at", "version", "created_at", "updated_at"}
+)
+
+type SagaRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewSagaRepository(db *pgxpool.Pool) repository.SagaRepository {
+	return &SagaRepository{
+		db: db,
+	}
+}
+
+// Save implements repository.SagaRepository.
+func (r *SagaRepository) Save(ctx context.Context, saga *domain.CheckoutSaga) error {
+	const op = "repository.SagaRepository.Save"
+
+	insertQuery := sq.Insert(sagasTable).
+		Columns(sagaColumns...).
+		Values(saga.OrderID, saga.State, saga.PaymentRequested, saga.StockCommitted, saga.PaymentCaptured, saga.Reason,
+			nullTime(saga.Deadline), nullTime(saga.RetryAt), saga.Version, saga.CreatedAt, saga.UpdatedAt).
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := insertQuery.ToSql()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if _, err := conn(ctx, r.db).Exec(ctx, query, args...); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// Get implements repository.SagaRepository.
+func (r *SagaRepository) Get(ctx context.Context, orderId string) (*domain.CheckoutSaga, error) {
+	const op = "repository.SagaRepository.Get"
+
+	selectQuery := sq.Select(sagaColumns...).
+		From(sagasTable).
+		Where(sq.Eq{"order_id": orderId}).
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := selectQuery.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	saga, err := scanSaga(conn(ctx, r.db).QueryRow(ctx, query, args...))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%s: %w", op, domain.ErrSagaNotFound)
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return saga, nil
+}
+
+// Update implements repository.SagaRepository.
+func (r *SagaRepository) Update(ctx context.Context, saga *domain.CheckoutSaga) error {
+	const op = "repository.SagaRepository.Update"
+
+	if err := updateSaga(ctx, conn(ctx, r.db), saga); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// ListDue implements repository.SagaRepository.
+func (r *SagaRepository) ListDue(ctx context.Context, now time.Time, limit uint64) ([]*domain.CheckoutSaga, error) {
+	const op = "repository.SagaRepository.ListDue"
+
+	selectQuery := sq.Select(sagaColumns...).
+		From(sagasTable).
+		Where(sq.LtOrEq{"retry_at": now}).
+		OrderBy("retry_at").
+		Limit(limit).
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := selectQuery.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var sagas []*domain.CheckoutSaga
+	for rows.Next() {
+		saga, err := scanSaga(rows)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		sagas = append(sagas, saga)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return sagas, nil
+}
+
+func scanSaga(row pgx.Row) (*domain.CheckoutSaga, error) {
+	var saga domain.CheckoutSaga
+	var deadline, retryAt *time.Time
+
+	if err := row.Scan(&saga.OrderID, &saga.State, &saga.PaymentRequested, &saga.StockCommitted, &saga.PaymentCaptured, &saga.Reason,
+		&deadline, &retryAt, &saga.Version, &saga.CreatedAt, &saga.UpdatedAt); err != nil {
+		return nil, err
+	}
+
+	if deadline != nil {
+		saga.Deadline = *deadline
+	}
+	if retryAt != nil {
+		saga.RetryAt = *retryAt
+	}
+
+	return &saga, nil
+}
+
+// updateSaga saves saga if its version wasn't changed and bumps it.
+func updateSaga(ctx context.Context, q querier, saga *domain.CheckoutSaga) error {
+	updateQuery := sq.Update(sagasTable).
+		Set("state", saga.State).
+		Set("payment_requested", saga.PaymentRequested).
+		Set("stock_committed", saga.StockCommitted).
+		Set("payment_captured", saga.PaymentCaptured).
+		Set("reason", saga.Reason).
+		Set("deadline", nullTime(saga.Deadline)).
+		Set("retry_at", nullTime(saga.RetryAt)).
+		Set("version", saga.Version+1).
+		Set("updated_at", saga.UpdatedAt).
+		Where(sq.Eq{"order_id": saga.OrderID, "version": saga.Version}).
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := updateQuery.ToSql()
+	if err != nil {
+		return err
+	}
+
+	tag, err := q.Exec(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return domain.ErrSagaConflict
+	}
+
+	saga.Version++
+
+	return nil
+}
+
+// nullTime stores zero time as NULL.
+func nullTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
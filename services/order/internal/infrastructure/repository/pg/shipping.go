@@ -0,0 +1,156 @@
+package pg
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/dzhordano/ecom-thing/services/order/internal/domain"
+	"github.com/dzhordano/ecom-thing/services/order/internal/domain/repository"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+var (
+	shippingRulesTable = "shipping_rules"
+	shippingTiersTable = "shipping_rule_tiers"
+)
+
+type ShippingRuleRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewShippingRuleRepository(db *pgxpool.Pool) repository.ShippingRuleRepository {
+	return &ShippingRuleRepository{
+		db: db,
+	}
+}
+
+// Get implements repository.ShippingRuleRepository.
+func (r *ShippingRuleRepository) Get(ctx context.Context, method domain.DeliveryMethod) (*domain.ShippingRule, error) {
+	const op = "repository.ShippingRuleRepository.Get"
+
+	selectQuery := sq.Select("delivery_method", "base_fee", "free_from", "min_days", "max_days", "slot_capacity").
+		From(shippingRulesTable).
+		Where(sq.Eq{"delivery_method": method}).
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := selectQuery.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var rule domain.ShippingRule
+	if err := r.db.QueryRow(ctx, query, args...).Scan(&rule.Method, &rule.BaseFee, &rule.FreeFrom,
+		&rule.MinDays, &rule.MaxDays, &rule.SlotCapacity); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%s: %w", op, domain.ErrShippingRuleNotFound)
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	tiersQuery := sq.Select("min_items", "fee").
+		From(shippingTiersTable).
+		Where(sq.Eq{"delivery_method": method}).
+		OrderBy("min_items").
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err = tiersQuery.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var tier domain.ShippingTier
+		if err := rows.Scan(&tier.MinItems, &tier.Fee); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		rule.Tiers = append(rule.Tiers, tier)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &rule, nil
+}
+
+// bookDeliverySlot checks that delivery day of order has room for it. Bookings of one slot are serialized
+// by transaction-level advisory lock, so concurrent orders can't overbook it. Cancelled and refunded
+// orders don't take room. Method without rule or capacity is unlimited.
+func bookDeliverySlot(ctx context.Context, tx pgx.Tx, order *domain.Order) error {
+	var capacity uint64
+	err := tx.QueryRow(ctx, "SELECT slot_capacity FROM "+shippingRulesTable+" WHERE delivery_method = $1", order.DeliveryMethod).
+		Scan(&capacity)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if capacity == 0 {
+		return nil
+	}
+
+	from, to := domain.DeliverySlot(order.DeliveryDate)
+
+	slot := fmt.Sprintf("delivery slot %s %s", order.DeliveryMethod, from.Format(time.DateOnly))
+	if _, err := tx.Exec(ctx, "SELECT pg_advisory_xact_lock(hashtext($1))", slot); err != nil {
+		return err
+	}
+
+	countQuery := sq.Select("COUNT(*)").
+		From(ordersTable).
+		Where(sq.Eq{"delivery_method": order.DeliveryMethod}).
+		Where(sq.GtOrEq{"delivery_date": from}).
+		Where(sq.Lt{"delivery_date": to}).
+		Where(sq.NotEq{"status": []domain.Status{domain.OrderCancelled, domain.OrderRefunded}}).
+		Where(sq.NotEq{"id": order.ID}).
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := countQuery.ToSql()
+	if err != nil {
+		return err
+	}
+
+	var booked uint64
+	if err := tx.QueryRow(ctx, query, args...).Scan(&booked); err != nil {
+		return err
+	}
+
+	if booked >= capacity {
+		return domain.ErrDeliverySlotUnavailable
+	}
+
+	return nil
+}
+
+// slotChanged tells if order is delivered with another method or on another day than the saved one.
+func slotChanged(ctx context.Context, tx pgx.Tx, order *domain.Order) (bool, error) {
+	var (
+		method domain.DeliveryMethod
+		date   time.Time
+	)
+	err := tx.QueryRow(ctx, "SELECT delivery_method, delivery_date FROM "+ordersTable+" WHERE id = $1 FOR UPDATE", order.ID).
+		Scan(&method, &date)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return false, domain.ErrOrderNotFound
+	}
+	if err != nil {
+		return false, err
+	}
+
+	before, _ := domain.DeliverySlot(date)
+	after, _ := domain.DeliverySlot(order.DeliveryDate)
+
+	return method != order.DeliveryMethod || !before.Equal(after), nil
+}
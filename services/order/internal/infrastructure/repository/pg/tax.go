@@ -0,0 +1,85 @@
+package pg
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/dzhordano/ecom-thing/services/order/internal/domain"
+	"github.com/dzhordano/ecom-thing/services/order/internal/domain/repository"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+var (
+	taxRegionsTable = "tax_regions"
+	taxRatesTable   = "tax_rates"
+)
+
+type TaxRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewTaxRepository(db *pgxpool.Pool) repository.TaxRepository {
+	return &TaxRepository{
+		db: db,
+	}
+}
+
+// GetTable implements repository.TaxRepository.
+func (r *TaxRepository) GetTable(ctx context.Context, region domain.TaxRegion) (*domain.TaxTable, error) {
+	const op = "repository.TaxRepository.GetTable"
+
+	selectQuery := sq.Select("region", "included", "default_rate").
+		From(taxRegionsTable).
+		Where(sq.Eq{"region": region}).
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := selectQuery.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var table domain.TaxTable
+	if err := r.db.QueryRow(ctx, query, args...).Scan(&table.Region, &table.Included, &table.DefaultRate); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%s: %w", op, domain.ErrTaxTableNotFound)
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	ratesQuery := sq.Select("category", "rate").
+		From(taxRatesTable).
+		Where(sq.Eq{"region": region}).
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err = ratesQuery.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	table.Rates = make(map[string]float64)
+	for rows.Next() {
+		var (
+			category string
+			rate     float64
+		)
+		if err := rows.Scan(&category, &rate); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		table.Rates[category] = rate
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &table, nil
+}
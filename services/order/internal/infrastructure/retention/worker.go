@@ -0,0 +1,63 @@
+package retention
+
+import (
+	"context"
+	"time"
+
+	"github.com/dzhordano/ecom-thing/services/order/internal/application/interfaces"
+	"github.com/dzhordano/ecom-thing/services/order/internal/infrastructure"
+	"github.com/dzhordano/ecom-thing/services/order/pkg/logger"
+)
+
+// Worker periodically erases orders archived for longer than retention period.
+// Every replica may run it, each order is erased by one of them.
+type Worker struct {
+	log       logger.Logger
+	retention interfaces.OrderRetention
+	interval  time.Duration
+}
+
+func NewWorker(log logger.Logger, retention interfaces.OrderRetention, interval time.Duration) *Worker {
+	return &Worker{
+		log:       log,
+		retention: retention,
+		interval:  interval,
+	}
+}
+
+// Start runs worker until context is cancelled. Meant to be run in a separate goroutine.
+func (w *Worker) Start(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			w.run(ctx)
+		case <-ctx.Done():
+			w.log.Info("order retention worker shutting down")
+			return
+		}
+	}
+}
+
+func (w *Worker) run(ctx context.Context) {
+	start := time.Now()
+
+	// Errors are logged by retention itself.
+	erased, err := w.retention.EraseArchived(ctx)
+
+	infrastructure.HistRetentionDuration.Observe(time.Since(start).Seconds())
+	infrastructure.IncErasedOrdersCounter.Add(float64(erased))
+
+	if err != nil {
+		infrastructure.IncRetentionRunsCounter.WithLabelValues("error").Inc()
+		return
+	}
+
+	infrastructure.IncRetentionRunsCounter.WithLabelValues("ok").Inc()
+
+	if erased > 0 {
+		w.log.Debug("archived orders erased", "count", erased)
+	}
+}
@@ -1,8 +1,11 @@
 package converter
 
 import (
+	"time"
+
 	"github.com/dzhordano/ecom-thing/services/order/internal/domain"
 	order_v1 "github.com/dzhordano/ecom-thing/services/order/pkg/api/order/v1"
+	"github.com/dzhordano/ecom-thing/services/order/pkg/money"
 	"github.com/google/uuid"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -14,10 +17,10 @@ func FromDomainToProto_Order(order *domain.Order) *order_v1.Order {
 		Description:     order.Description,
 		Status:          order.Status.String(),
 		Currency:        order.Currency.String(),
-		TotalPrice:      order.TotalPrice,
+		TotalPrice:      FromDomainToProto_Money(order.TotalPrice()),
 		PaymentMethod:   order.PaymentMethod.String(),
 		DeliveryMethod:  order.DeliveryMethod.String(),
-		DeliveryAddress: order.DeliveryAddress,
+		DeliveryAddress: order.DeliveryAddress.String(),
 		DeliveryDate: &timestamppb.Timestamp{
 			Seconds: order.DeliveryDate.Unix(),
 			Nanos:   int32(order.DeliveryDate.Nanosecond()),
@@ -31,6 +34,14 @@ func FromDomainToProto_Order(order *domain.Order) *order_v1.Order {
 			Seconds: order.UpdatedAt.Unix(),
 			Nanos:   int32(order.UpdatedAt.Nanosecond()),
 		},
+		Coupon:      order.Coupon,
+		ShippingFee: FromDomainToProto_Money(order.ShippingFee),
+		Tax:         FromDomainToProto_Money(order.Tax()),
+		TaxRegion:   order.TaxRegion.String(),
+		Address:     FromDomainToProto_Address(order.DeliveryAddress),
+		Version:     order.Version,
+		DeletedAt:   timeToProtoIfNotZero(order.DeletedAt),
+		DeletedBy:   uuidToStringIfNotNil(order.DeletedBy),
 	}
 }
 
@@ -50,10 +61,10 @@ func FromDomainToProto_OrderWItems(order *domain.Order, items []*order_v1.Item)
 		Description:     order.Description,
 		Status:          order.Status.String(),
 		Currency:        order.Currency.String(),
-		TotalPrice:      order.TotalPrice,
+		TotalPrice:      FromDomainToProto_Money(order.TotalPrice()),
 		PaymentMethod:   order.PaymentMethod.String(),
 		DeliveryMethod:  order.DeliveryMethod.String(),
-		DeliveryAddress: order.DeliveryAddress,
+		DeliveryAddress: order.DeliveryAddress.String(),
 		DeliveryDate: &timestamppb.Timestamp{
 			Seconds: order.DeliveryDate.Unix(),
 			Nanos:   int32(order.DeliveryDate.Nanosecond()),
@@ -67,20 +78,125 @@ func FromDomainToProto_OrderWItems(order *domain.Order, items []*order_v1.Item)
 			Seconds: order.UpdatedAt.Unix(),
 			Nanos:   int32(order.UpdatedAt.Nanosecond()),
 		},
+		Coupon:      order.Coupon,
+		ShippingFee: FromDomainToProto_Money(order.ShippingFee),
+		Tax:         FromDomainToProto_Money(order.Tax()),
+		TaxRegion:   order.TaxRegion.String(),
+		Address:     FromDomainToProto_Address(order.DeliveryAddress),
+		Version:     order.Version,
+		DeletedAt:   timeToProtoIfNotZero(order.DeletedAt),
+		DeletedBy:   uuidToStringIfNotNil(order.DeletedBy),
+	}
+}
+
+// timeToProtoIfNotZero leaves unset times unset.
+func timeToProtoIfNotZero(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+// uuidToStringIfNotNil leaves unset ids empty.
+func uuidToStringIfNotNil(id uuid.UUID) string {
+	if id == uuid.Nil {
+		return ""
+	}
+	return id.String()
+}
+
+func FromDomainToProto_Address(a domain.Address) *order_v1.Address {
+	return &order_v1.Address{
+		Country:    a.Country,
+		Region:     a.Region,
+		City:       a.City,
+		PostalCode: a.PostalCode,
+		Street:     a.Street,
+		Building:   a.Building,
+		Apartment:  a.Apartment,
+		Recipient:  a.Recipient,
+		Phone:      a.Phone,
 	}
 }
 
 func FromDomainToProto_Items(items []domain.Item) []*order_v1.Item {
 	var result []*order_v1.Item
 	for _, item := range items {
+		tax := money.New(item.Tax.Amount, item.UnitPrice.Currency) // Untaxed lines have no currency of tax.
 		result = append(result, &order_v1.Item{
-			ItemId:   item.ProductID.String(),
-			Quantity: item.Quantity,
+			ItemId:      item.ProductID.String(),
+			Quantity:    item.Quantity,
+			Name:        item.Name,
+			UnitPrice:   FromDomainToProto_Money(item.UnitPrice),
+			Currency:    item.UnitPrice.Currency,
+			Discount:    FromDomainToProto_Money(item.Discount),
+			Total:       FromDomainToProto_Money(item.Total()),
+			TaxRate:     item.TaxRate,
+			Tax:         FromDomainToProto_Money(tax),
+			TaxIncluded: item.TaxIncluded,
 		})
 	}
 	return result
 }
 
+func FromDomainToProto_Quote(q *domain.Quote) *order_v1.QuoteOrderResponse {
+	lines := make([]*order_v1.QuoteLine, 0, len(q.Lines))
+	for _, line := range q.Lines {
+		lines = append(lines, &order_v1.QuoteLine{
+			Item:      FromDomainToProto_Items([]domain.Item{line.Item})[0],
+			Tax:       FromDomainToProto_Money(line.Tax),
+			Available: line.Available,
+		})
+	}
+
+	warnings := make([]*order_v1.QuoteWarning, 0, len(q.Warnings))
+	for _, w := range q.Warnings {
+		var itemId string
+		if w.ProductID != uuid.Nil {
+			itemId = w.ProductID.String()
+		}
+
+		warnings = append(warnings, &order_v1.QuoteWarning{
+			ItemId:  itemId,
+			Message: w.Err.Error(),
+		})
+	}
+
+	return &order_v1.QuoteOrderResponse{
+		Lines:     lines,
+		Subtotal:  FromDomainToProto_Money(q.Subtotal()),
+		Discount:  FromDomainToProto_Money(q.Discount()),
+		Shipping:  FromDomainToProto_Money(q.Shipping),
+		Tax:       FromDomainToProto_Money(q.Tax()),
+		Total:     FromDomainToProto_Money(q.Total()),
+		Coupon:    q.Coupon,
+		Warnings:  warnings,
+		TaxRegion: q.TaxRegion.String(),
+	}
+}
+
+func FromDomainToProto_Money(m money.Money) *order_v1.Money {
+	return &order_v1.Money{
+		Amount:   m.Amount,
+		Currency: m.Currency,
+	}
+}
+
+// RPCItemsToDomain takes only product and quantity from request, price snapshot is made by service.
+func RPCAddressToDomain(a *order_v1.Address) domain.Address {
+	return domain.Address{
+		Country:    a.GetCountry(),
+		Region:     a.GetRegion(),
+		City:       a.GetCity(),
+		PostalCode: a.GetPostalCode(),
+		Street:     a.GetStreet(),
+		Building:   a.GetBuilding(),
+		Apartment:  a.GetApartment(),
+		Recipient:  a.GetRecipient(),
+		Phone:      a.GetPhone(),
+	}
+}
+
 func RPCItemsToDomain(items []*order_v1.Item) ([]domain.Item, error) {
 	var result []domain.Item
 	for _, item := range items {
@@ -96,3 +212,110 @@ func RPCItemsToDomain(items []*order_v1.Item) ([]domain.Item, error) {
 	}
 	return result, nil
 }
+
+func FromDomainToProto_HistoryEntries(entries []*domain.HistoryEntry) []*order_v1.OrderHistoryEntry {
+	result := make([]*order_v1.OrderHistoryEntry, 0, len(entries))
+	for _, e := range entries {
+		changes := make([]*order_v1.FieldChange, 0, len(e.Diff))
+		for _, c := range e.Diff {
+			changes = append(changes, &order_v1.FieldChange{
+				Field: c.Field,
+				From:  c.From,
+				To:    c.To,
+			})
+		}
+
+		result = append(result, &order_v1.OrderHistoryEntry{
+			Id:        e.ID,
+			OrderId:   e.OrderID.String(),
+			ActorType: string(e.Actor.Type),
+			ActorId:   e.Actor.ID,
+			Type:      string(e.Type),
+			Changes:   changes,
+			CreatedAt: timestamppb.New(e.CreatedAt),
+		})
+	}
+	return result
+}
+
+func FromDomainToProto_Coupon(c *domain.Coupon) *order_v1.Coupon {
+	productIds := make([]string, 0, len(c.ProductIDs))
+	for _, id := range c.ProductIDs {
+		productIds = append(productIds, id.String())
+	}
+
+	return &order_v1.Coupon{
+		Code:                  c.Code,
+		DiscountType:          c.Type.String(),
+		Discount:              c.Discount,
+		Amount:                FromDomainToProto_Money(c.Amount),
+		Currency:              c.Currency.String(),
+		MinOrderAmount:        FromDomainToProto_Money(c.MinOrderAmount),
+		MaxRedemptions:        c.MaxRedemptions,
+		MaxRedemptionsPerUser: c.MaxRedemptionsPerUser,
+		Redemptions:           c.Redemptions,
+		ProductIds:            productIds,
+		Categories:            c.Categories,
+		Active:                c.Active,
+		ValidFrom:             timestamppb.New(c.ValidFrom),
+		ValidTo:               timestamppb.New(c.ValidTo),
+		CreatedAt:             timestamppb.New(c.CreatedAt),
+		UpdatedAt:             timestamppb.New(c.UpdatedAt),
+	}
+}
+
+func FromDomainToProto_Coupons(coupons []*domain.Coupon) []*order_v1.Coupon {
+	result := make([]*order_v1.Coupon, 0, len(coupons))
+	for _, c := range coupons {
+		result = append(result, FromDomainToProto_Coupon(c))
+	}
+	return result
+}
+
+func FromDomainToProto_CouponRedemptions(redemptions []domain.CouponRedemption) []*order_v1.CouponRedemption {
+	result := make([]*order_v1.CouponRedemption, 0, len(redemptions))
+	for _, r := range redemptions {
+		result = append(result, &order_v1.CouponRedemption{
+			OrderId:    r.OrderID.String(),
+			UserId:     r.UserID.String(),
+			Discount:   FromDomainToProto_Money(r.Discount),
+			RedeemedAt: timestamppb.New(r.RedeemedAt),
+		})
+	}
+	return result
+}
+
+func FromDomainToProto_Return(r *domain.Return) *order_v1.Return {
+	return &order_v1.Return{
+		Id:        r.ID.String(),
+		OrderId:   r.OrderID.String(),
+		UserId:    r.UserID.String(),
+		Status:    r.Status.String(),
+		Reason:    r.Reason,
+		Items:     FromDomainToProto_Items(r.Items),
+		Total:     FromDomainToProto_Money(r.Total()),
+		CreatedAt: timestamppb.New(r.CreatedAt),
+		UpdatedAt: timestamppb.New(r.UpdatedAt),
+	}
+}
+
+func FromDomainToProto_Returns(returns []*domain.Return) []*order_v1.Return {
+	result := make([]*order_v1.Return, 0, len(returns))
+	for _, r := range returns {
+		result = append(result, FromDomainToProto_Return(r))
+	}
+	return result
+}
+
+// RPCProductIDsToDomain parses product restrictions of coupon.
+func RPCProductIDsToDomain(ids []string) ([]uuid.UUID, error) {
+	result := make([]uuid.UUID, 0, len(ids))
+	for _, id := range ids {
+		pid, err := uuid.Parse(id)
+		if err != nil {
+			return nil, domain.ErrInvalidUUID
+		}
+		result = append(result, pid)
+	}
+	return result, nil
+}
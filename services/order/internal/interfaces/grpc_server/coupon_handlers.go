@@ -0,0 +1,192 @@
+package grpc_server
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/dzhordano/ecom-thing/services/order/internal/application/dto"
+	"github.com/dzhordano/ecom-thing/services/order/internal/application/interfaces"
+	"github.com/dzhordano/ecom-thing/services/order/internal/interfaces/grpc_server/converter"
+	api "github.com/dzhordano/ecom-thing/services/order/pkg/api/order/v1"
+)
+
+// defaultCouponLimit is used when request has no limit set.
+const defaultCouponLimit = 10
+
+type CouponHandler struct {
+	api.UnimplementedCouponServiceServer
+	service interfaces.CouponService
+}
+
+func NewCouponHandler(s interfaces.CouponService) *CouponHandler {
+	return &CouponHandler{
+		service: s,
+	}
+}
+
+func (h *CouponHandler) CreateCoupon(ctx context.Context, req *api.CreateCouponRequest) (*api.CreateCouponResponse, error) {
+	span := trace.SpanFromContext(ctx)
+	defer span.End()
+
+	productIds, err := converter.RPCProductIDsToDomain(req.GetProductIds())
+	if err != nil {
+		return nil, err
+	}
+
+	info := dto.CreateCouponRequest{
+		Code:                  req.GetCode(),
+		Type:                  req.GetDiscountType(),
+		Discount:              req.GetDiscount(),
+		Amount:                req.GetAmount(),
+		Currency:              req.GetCurrency(),
+		MinOrderAmount:        req.GetMinOrderAmount(),
+		MaxRedemptions:        req.GetMaxRedemptions(),
+		MaxRedemptionsPerUser: req.GetMaxRedemptionsPerUser(),
+		ProductIDs:            productIds,
+		Categories:            req.GetCategories(),
+		ValidFrom:             req.GetValidFrom().AsTime(),
+		ValidTo:               req.GetValidTo().AsTime(),
+	}
+	if info.Categories == nil {
+		info.Categories = []string{}
+	}
+
+	span.AddEvent("call service",
+		trace.WithAttributes(
+			attribute.String("code", info.Code),
+		),
+	)
+
+	coupon, err := h.service.CreateCoupon(ctx, info)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.CreateCouponResponse{
+		Coupon: converter.FromDomainToProto_Coupon(coupon),
+	}, nil
+}
+
+func (h *CouponHandler) UpdateCoupon(ctx context.Context, req *api.UpdateCouponRequest) (*api.UpdateCouponResponse, error) {
+	span := trace.SpanFromContext(ctx)
+	defer span.End()
+
+	info := dto.UpdateCouponRequest{
+		Code:                  req.GetCode(),
+		Type:                  req.DiscountType,
+		Discount:              req.Discount,
+		Amount:                req.Amount,
+		Currency:              req.Currency,
+		MinOrderAmount:        req.MinOrderAmount,
+		MaxRedemptions:        req.MaxRedemptions,
+		MaxRedemptionsPerUser: req.MaxRedemptionsPerUser,
+	}
+
+	if req.ProductIds != nil {
+		productIds, err := converter.RPCProductIDsToDomain(req.ProductIds.GetValues())
+		if err != nil {
+			return nil, err
+		}
+		info.ProductIDs = &productIds
+	}
+
+	if req.Categories != nil {
+		categories := append([]string{}, req.Categories.GetValues()...)
+		info.Categories = &categories
+	}
+
+	if req.ValidFrom.IsValid() {
+		t := req.ValidFrom.AsTime()
+		info.ValidFrom = &t
+	}
+
+	if req.ValidTo.IsValid() {
+		t := req.ValidTo.AsTime()
+		info.ValidTo = &t
+	}
+
+	span.AddEvent("call service",
+		trace.WithAttributes(
+			attribute.String("code", info.Code),
+		),
+	)
+
+	coupon, err := h.service.UpdateCoupon(ctx, info)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.UpdateCouponResponse{
+		Coupon: converter.FromDomainToProto_Coupon(coupon),
+	}, nil
+}
+
+func (h *CouponHandler) DeactivateCoupon(ctx context.Context, req *api.DeactivateCouponRequest) (*api.DeactivateCouponResponse, error) {
+	span := trace.SpanFromContext(ctx)
+	defer span.End()
+
+	coupon, err := h.service.DeactivateCoupon(ctx, req.GetCode())
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.DeactivateCouponResponse{
+		Coupon: converter.FromDomainToProto_Coupon(coupon),
+	}, nil
+}
+
+func (h *CouponHandler) ListCoupons(ctx context.Context, req *api.ListCouponsRequest) (*api.ListCouponsResponse, error) {
+	span := trace.SpanFromContext(ctx)
+	defer span.End()
+
+	limit := req.GetLimit()
+	if limit == 0 {
+		limit = defaultCouponLimit
+	}
+
+	span.AddEvent("call service",
+		trace.WithAttributes(
+			attribute.Int64("limit", int64(limit)),
+			attribute.Int64("offset", int64(req.GetOffset())),
+			attribute.Bool("active_only", req.GetActiveOnly()),
+		),
+	)
+
+	coupons, err := h.service.ListCoupons(ctx, req.GetActiveOnly(), limit, req.GetOffset())
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.ListCouponsResponse{
+		Coupons: converter.FromDomainToProto_Coupons(coupons),
+	}, nil
+}
+
+func (h *CouponHandler) GetCouponUsage(ctx context.Context, req *api.GetCouponUsageRequest) (*api.GetCouponUsageResponse, error) {
+	span := trace.SpanFromContext(ctx)
+	defer span.End()
+
+	limit := req.GetLimit()
+	if limit == 0 {
+		limit = defaultCouponLimit
+	}
+
+	usage, err := h.service.GetCouponUsage(ctx, req.GetCode(), limit)
+	if err != nil {
+		return nil, err
+	}
+
+	span.AddEvent("usage retrieved",
+		trace.WithAttributes(
+			attribute.Int64("redemptions", int64(usage.Coupon.Redemptions)),
+		),
+	)
+
+	return &api.GetCouponUsageResponse{
+		Coupon:      converter.FromDomainToProto_Coupon(usage.Coupon),
+		UniqueUsers: usage.UniqueUsers,
+		Redemptions: converter.FromDomainToProto_CouponRedemptions(usage.Recent),
+	}, nil
+}
@@ -12,8 +12,6 @@ import (
 	"github.com/dzhordano/ecom-thing/services/order/internal/interfaces/grpc_server/converter"
 	api "github.com/dzhordano/ecom-thing/services/order/pkg/api/order/v1"
 	"github.com/google/uuid"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -49,9 +47,10 @@ func (h *OrderHandler) CreateOrder(ctx context.Context, req *api.CreateOrderRequ
 		Coupon:          req.GetCoupon(),
 		PaymentMethod:   req.GetPaymentMethod(),
 		DeliveryMethod:  req.GetDeliveryMethod(),
-		DeliveryAddress: req.GetDeliveryAddress(),
+		DeliveryAddress: converter.RPCAddressToDomain(req.GetAddress()),
 		DeliveryDate:    req.GetDeliveryDate().AsTime(),
 		Items:           items,
+		IdempotencyKey:  idempotencyKeyFromCtx(ctx),
 	}
 
 	span.AddEvent("call service")
@@ -74,6 +73,44 @@ func (h *OrderHandler) CreateOrder(ctx context.Context, req *api.CreateOrderRequ
 	return resp, nil
 }
 
+func (h *OrderHandler) QuoteOrder(ctx context.Context, req *api.QuoteOrderRequest) (*api.QuoteOrderResponse, error) {
+	span := trace.SpanFromContext(ctx)
+	defer span.End()
+
+	span.AddEvent("parse items",
+		trace.WithAttributes(
+			attribute.Int("count", len(req.GetItems())),
+		),
+	)
+
+	items, err := converter.RPCItemsToDomain(req.GetItems())
+	if err != nil {
+		return nil, err
+	}
+
+	info := dto.CreateOrderRequest{
+		Currency:       req.GetCurrency(),
+		Coupon:         req.GetCoupon(),
+		DeliveryMethod: req.GetDeliveryMethod(),
+		Items:          items,
+	}
+
+	span.AddEvent("call service")
+
+	quote, err := h.service.QuoteOrder(ctx, info)
+	if err != nil {
+		return nil, err
+	}
+
+	span.AddEvent("order quoted",
+		trace.WithAttributes(
+			attribute.Int("warnings", len(quote.Warnings)),
+		),
+	)
+
+	return converter.FromDomainToProto_Quote(quote), nil
+}
+
 func (h *OrderHandler) GetOrder(ctx context.Context, req *api.GetOrderRequest) (*api.GetOrderResponse, error) {
 	span := trace.SpanFromContext(ctx)
 	defer span.End()
@@ -107,9 +144,75 @@ func (h *OrderHandler) GetOrder(ctx context.Context, req *api.GetOrderRequest) (
 	return resp, nil
 }
 
-// TODO: implement
+func (h *OrderHandler) GetOrderHistory(ctx context.Context, req *api.GetOrderHistoryRequest) (*api.GetOrderHistoryResponse, error) {
+	span := trace.SpanFromContext(ctx)
+	defer span.End()
+
+	span.AddEvent("parse id",
+		trace.WithAttributes(
+			attribute.String("order_id", req.GetId()),
+		),
+	)
+
+	orderId, err := uuid.Parse(req.GetId())
+	if err != nil {
+		return nil, domain.ErrInvalidUUID
+	}
+
+	span.AddEvent("call service")
+
+	entries, err := h.service.GetOrderHistory(ctx, orderId)
+	if err != nil {
+		return nil, err
+	}
+
+	span.AddEvent("order history retrieved",
+		trace.WithAttributes(
+			attribute.Int("count", len(entries)),
+		),
+	)
+
+	return &api.GetOrderHistoryResponse{
+		Entries: converter.FromDomainToProto_HistoryEntries(entries),
+	}, nil
+}
+
 func (h *OrderHandler) ListOrders(ctx context.Context, req *api.ListOrdersRequest) (*api.ListOrdersResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "not implemented")
+	span := trace.SpanFromContext(ctx)
+	defer span.End()
+
+	span.AddEvent("call service",
+		trace.WithAttributes(
+			attribute.Int64("limit", int64(req.GetLimit())),
+			attribute.Int64("offset", int64(req.GetOffset())),
+		),
+	)
+
+	page, err := h.service.ListByUser(ctx, map[string]any{
+		"limit":     nonZero(req.GetLimit()),
+		"offset":    nonZero(req.GetOffset()),
+		"pageToken": req.GetPageToken(),
+		"sortBy":    req.SortBy,
+		"sortOrder": req.SortOrder,
+		"withTotal": req.GetIncludeTotal(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	span.AddEvent("orders retrieved",
+		trace.WithAttributes(
+			attribute.Int("count", len(page.Orders)),
+		),
+	)
+
+	resp := &api.ListOrdersResponse{
+		Orders:        converter.FromDomainToProto_Orders(page.Orders),
+		NextPageToken: page.NextPageToken,
+		Total:         page.Total,
+	}
+
+	return resp, nil
 }
 
 func (h *OrderHandler) UpdateOrder(ctx context.Context, req *api.UpdateOrderRequest) (*api.UpdateOrderResponse, error) {
@@ -137,16 +240,21 @@ func (h *OrderHandler) UpdateOrder(ctx context.Context, req *api.UpdateOrderRequ
 		t = req.DeliveryDate.AsTime()
 	}
 
+	var address *domain.Address
+	if req.Address != nil {
+		a := converter.RPCAddressToDomain(req.Address)
+		address = &a
+	}
+
 	info := dto.UpdateOrderRequest{
 		OrderID:         oid,
 		Description:     req.Description,
-		Status:          req.Status,
-		TotalPrice:      req.TotalPrice,
 		PaymentMethod:   req.PaymentMethod,
 		DeliveryMethod:  req.DeliveryMethod,
-		DeliveryAddress: req.DeliveryAddress,
+		DeliveryAddress: address,
 		DeliveryDate:    t,
 		Items:           items,
+		Version:         req.Version,
 	}
 
 	span.AddEvent("call service")
@@ -200,6 +308,39 @@ func (h *OrderHandler) DeleteOrder(ctx context.Context, req *api.DeleteOrderRequ
 	return &api.DeleteOrderResponse{}, nil
 }
 
+func (h *OrderHandler) RestoreOrder(ctx context.Context, req *api.RestoreOrderRequest) (*api.RestoreOrderResponse, error) {
+	span := trace.SpanFromContext(ctx)
+	defer span.End()
+
+	span.AddEvent("parse id",
+		trace.WithAttributes(
+			attribute.String("order_id", req.GetId()),
+		),
+	)
+
+	oid, err := uuid.Parse(req.GetId())
+	if err != nil {
+		return nil, domain.ErrInvalidUUID
+	}
+
+	span.AddEvent("call service")
+
+	order, err := h.service.RestoreOrder(ctx, oid)
+	if err != nil {
+		return nil, err
+	}
+
+	span.AddEvent("order restored",
+		trace.WithAttributes(
+			attribute.Stringer("order_id", oid),
+		),
+	)
+
+	return &api.RestoreOrderResponse{
+		Order: converter.FromDomainToProto_Order(order),
+	}, nil
+}
+
 func (h *OrderHandler) SearchOrders(ctx context.Context, req *api.SearchOrdersRequest) (*api.SearchOrdersResponse, error) {
 	span := trace.SpanFromContext(ctx)
 	defer span.End()
@@ -210,22 +351,41 @@ func (h *OrderHandler) SearchOrders(ctx context.Context, req *api.SearchOrdersRe
 		),
 	)
 
-	orders, err := h.service.SearchOrders(ctx, map[string]any{
-		"limit":            req.Limit,
-		"offset":           req.Offset,
-		"query":            req.Query,
-		"description":      req.Description,
-		"status":           req.Status,
-		"currency":         req.Currency,
-		"minPrice":         req.MinPrice,
-		"maxPrice":         req.MaxPrice,
-		"deliveryMethod":   req.DeliveryMethod,
-		"paymentMethod":    req.PaymentMethod,
-		"deliveryAddress":  req.DeliveryAddress,
-		"deliveryDateFrom": timeFromProtoIfNotZero(req.DeliveryDateFrom),
-		"deliveryDateTo":   timeFromProtoIfNotZero(req.DeliveryDateTo),
-		"minItemsAmount":   req.MinItemsAmount,
-		"maxItemsAmount":   req.MaxItemsAmount,
+	var productId *uuid.UUID
+	if req.ProductId != nil {
+		pid, err := uuid.Parse(req.GetProductId())
+		if err != nil {
+			return nil, domain.ErrInvalidUUID
+		}
+		productId = &pid
+	}
+
+	page, err := h.service.SearchOrders(ctx, map[string]any{
+		"limit":              req.Limit,
+		"offset":             req.Offset,
+		"pageToken":          req.GetPageToken(),
+		"sortBy":             req.SortBy,
+		"sortOrder":          req.SortOrder,
+		"withTotal":          req.GetIncludeTotal(),
+		"query":              req.Query,
+		"description":        req.Description,
+		"status":             req.Status,
+		"currency":           req.Currency,
+		"minPrice":           req.MinPrice,
+		"maxPrice":           req.MaxPrice,
+		"deliveryMethod":     req.DeliveryMethod,
+		"paymentMethod":      req.PaymentMethod,
+		"deliveryCountry":    req.DeliveryCountry,
+		"deliveryCity":       req.DeliveryCity,
+		"deliveryPostalCode": req.DeliveryPostalCode,
+		"deliveryDateFrom":   timeFromProtoIfNotZero(req.DeliveryDateFrom),
+		"deliveryDateTo":     timeFromProtoIfNotZero(req.DeliveryDateTo),
+		"minItemsAmount":     req.MinItemsAmount,
+		"maxItemsAmount":     req.MaxItemsAmount,
+		"productId":          productId,
+		"minItemQuantity":    req.MinItemQuantity,
+		"maxItemQuantity":    req.MaxItemQuantity,
+		"includeDeleted":     req.GetIncludeDeleted(),
 	})
 	if err != nil {
 		return nil, err
@@ -233,12 +393,14 @@ func (h *OrderHandler) SearchOrders(ctx context.Context, req *api.SearchOrdersRe
 
 	span.AddEvent("orders found",
 		trace.WithAttributes(
-			attribute.Int("count", len(orders)),
+			attribute.Int("count", len(page.Orders)),
 		),
 	)
 
 	resp := &api.SearchOrdersResponse{
-		Orders: converter.FromDomainToProto_Orders(orders),
+		Orders:        converter.FromDomainToProto_Orders(page.Orders),
+		NextPageToken: page.NextPageToken,
+		Total:         page.Total,
 	}
 
 	return resp, nil
@@ -298,6 +460,125 @@ func (h *OrderHandler) CancelOrder(ctx context.Context, req *api.CancelOrderRequ
 	return &api.CancelOrderResponse{}, nil
 }
 
+func (h *OrderHandler) CancelOrderItems(ctx context.Context, req *api.CancelOrderItemsRequest) (*api.CancelOrderItemsResponse, error) {
+	span := trace.SpanFromContext(ctx)
+	defer span.End()
+
+	span.AddEvent("parse id",
+		trace.WithAttributes(
+			attribute.String("order_id", req.GetId()),
+		),
+	)
+
+	oid, err := uuid.Parse(req.GetId())
+	if err != nil {
+		return nil, domain.ErrInvalidUUID
+	}
+
+	items, err := converter.RPCItemsToDomain(req.GetItems())
+	if err != nil {
+		return nil, err
+	}
+
+	span.AddEvent("call service",
+		trace.WithAttributes(
+			attribute.Int("items", len(items)),
+		),
+	)
+
+	order, err := h.service.CancelOrderItems(ctx, oid, items)
+	if err != nil {
+		return nil, err
+	}
+
+	span.AddEvent("order items canceled")
+
+	return &api.CancelOrderItemsResponse{
+		Order: converter.FromDomainToProto_Order(order),
+	}, nil
+}
+
+func (h *OrderHandler) ShipOrder(ctx context.Context, req *api.ShipOrderRequest) (*api.ShipOrderResponse, error) {
+	span := trace.SpanFromContext(ctx)
+	defer span.End()
+
+	span.AddEvent("parse id",
+		trace.WithAttributes(
+			attribute.String("order_id", req.GetId()),
+		),
+	)
+
+	oid, err := uuid.Parse(req.GetId())
+	if err != nil {
+		return nil, domain.ErrInvalidUUID
+	}
+
+	span.AddEvent("call service")
+
+	err = h.service.ShipOrder(ctx, oid)
+	if err != nil {
+		return nil, err
+	}
+
+	span.AddEvent("order shipped")
+
+	return &api.ShipOrderResponse{}, nil
+}
+
+func (h *OrderHandler) DeliverOrder(ctx context.Context, req *api.DeliverOrderRequest) (*api.DeliverOrderResponse, error) {
+	span := trace.SpanFromContext(ctx)
+	defer span.End()
+
+	span.AddEvent("parse id",
+		trace.WithAttributes(
+			attribute.String("order_id", req.GetId()),
+		),
+	)
+
+	oid, err := uuid.Parse(req.GetId())
+	if err != nil {
+		return nil, domain.ErrInvalidUUID
+	}
+
+	span.AddEvent("call service")
+
+	err = h.service.DeliverOrder(ctx, oid)
+	if err != nil {
+		return nil, err
+	}
+
+	span.AddEvent("order delivered")
+
+	return &api.DeliverOrderResponse{}, nil
+}
+
+func (h *OrderHandler) RefundOrder(ctx context.Context, req *api.RefundOrderRequest) (*api.RefundOrderResponse, error) {
+	span := trace.SpanFromContext(ctx)
+	defer span.End()
+
+	span.AddEvent("parse id",
+		trace.WithAttributes(
+			attribute.String("order_id", req.GetId()),
+		),
+	)
+
+	oid, err := uuid.Parse(req.GetId())
+	if err != nil {
+		return nil, domain.ErrInvalidUUID
+	}
+
+	span.AddEvent("call service")
+
+	err = h.service.RefundOrder(ctx, oid)
+	if err != nil {
+		return nil, err
+	}
+
+	span.AddEvent("order refunded")
+
+	return &api.RefundOrderResponse{}, nil
+}
+
 // This func checks if input time of proto type is zero. If so - returns nil time.
 //
 // Because when you use t.AsTime() it applies 1970-01-01 00:00:00 +0000 UTC as zero value due to protobuf implementation.
@@ -307,3 +588,11 @@ func timeFromProtoIfNotZero(t *timestamppb.Timestamp) time.Time {
 	}
 	return t.AsTime()
 }
+
+// nonZero maps unset (zero) proto3 scalar to nil, so service applies its default.
+func nonZero(v uint64) *uint64 {
+	if v == 0 {
+		return nil
+	}
+	return &v
+}
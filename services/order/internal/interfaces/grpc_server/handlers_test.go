@@ -6,6 +6,7 @@ import (
 	"github.com/dzhordano/ecom-thing/services/order/internal/domain"
 	mock_interfaces "github.com/dzhordano/ecom-thing/services/order/internal/interfaces/grpc_server/mocks"
 	api "github.com/dzhordano/ecom-thing/services/order/pkg/api/order/v1"
+	"github.com/dzhordano/ecom-thing/services/order/pkg/money"
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -31,7 +32,7 @@ func TestItemHandler_CancelOrder(t *testing.T) {
 		{
 			name: "OK",
 			req: &api.CancelOrderRequest{
-				OrderId: testId.String(),
+				Id: testId.String(),
 			},
 			mockBehavior: func(s *mock_interfaces.MockOrderService, orderId uuid.UUID) {
 				s.EXPECT().CancelOrder(
@@ -44,7 +45,7 @@ func TestItemHandler_CancelOrder(t *testing.T) {
 		{
 			name: "NOT FOUND",
 			req: &api.CancelOrderRequest{
-				OrderId: testId.String(),
+				Id: testId.String(),
 			},
 			mockBehavior: func(s *mock_interfaces.MockOrderService, orderId uuid.UUID) {
 				s.EXPECT().CancelOrder(
@@ -57,23 +58,23 @@ func TestItemHandler_CancelOrder(t *testing.T) {
 		{
 			name: "INVALID UUID",
 			req: &api.CancelOrderRequest{
-				OrderId: "invalid",
+				Id: "invalid",
 			},
 			mockBehavior: func(s *mock_interfaces.MockOrderService, orderId uuid.UUID) {},
 			expectedErr:  domain.ErrInvalidUUID,
 		},
 		{
-			name: "ALREADY COMPLETED",
+			name: "INVALID TRANSITION",
 			req: &api.CancelOrderRequest{
-				OrderId: testId.String(),
+				Id: testId.String(),
 			},
 			mockBehavior: func(s *mock_interfaces.MockOrderService, orderId uuid.UUID) {
 				s.EXPECT().CancelOrder(
 					gomock.Any(),
 					gomock.Eq(orderId),
-				).Return(domain.ErrOrderAlreadyCompleted).Times(1)
+				).Return(domain.ErrInvalidTransition).Times(1)
 			},
-			expectedErr: domain.ErrOrderAlreadyCompleted,
+			expectedErr: domain.ErrInvalidTransition,
 		},
 	}
 
@@ -95,6 +96,78 @@ func TestItemHandler_CancelOrder(t *testing.T) {
 	}
 }
 
+func TestItemHandler_CancelOrderItems(t *testing.T) {
+	type mockBehavior func(s *mock_interfaces.MockOrderService, orderId uuid.UUID)
+
+	testId := uuid.New()
+	productId := uuid.New()
+
+	tests := []struct {
+		name         string
+		req          *api.CancelOrderItemsRequest
+		mockBehavior mockBehavior
+		expectedErr  error
+	}{
+		{
+			name: "OK",
+			req: &api.CancelOrderItemsRequest{
+				Id:    testId.String(),
+				Items: []*api.Item{{ItemId: productId.String(), Quantity: 1}},
+			},
+			mockBehavior: func(s *mock_interfaces.MockOrderService, orderId uuid.UUID) {
+				s.EXPECT().CancelOrderItems(
+					gomock.Any(),
+					gomock.Eq(orderId),
+					gomock.Eq([]domain.Item{{ProductID: productId, Quantity: 1}}),
+				).Return(&domain.Order{ID: orderId}, nil).Times(1)
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "NOT CANCELLABLE",
+			req: &api.CancelOrderItemsRequest{
+				Id:    testId.String(),
+				Items: []*api.Item{{ItemId: productId.String(), Quantity: 1}},
+			},
+			mockBehavior: func(s *mock_interfaces.MockOrderService, orderId uuid.UUID) {
+				s.EXPECT().CancelOrderItems(
+					gomock.Any(),
+					gomock.Eq(orderId),
+					gomock.Any(),
+				).Return(nil, domain.ErrItemsNotCancellable).Times(1)
+			},
+			expectedErr: domain.ErrItemsNotCancellable,
+		},
+		{
+			name: "INVALID UUID",
+			req: &api.CancelOrderItemsRequest{
+				Id: "invalid",
+			},
+			mockBehavior: func(s *mock_interfaces.MockOrderService, orderId uuid.UUID) {},
+			expectedErr:  domain.ErrInvalidUUID,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockItemService := mock_interfaces.NewMockOrderService(ctrl)
+			tt.mockBehavior(mockItemService, testId)
+
+			s := NewOrderHandler(mockItemService)
+
+			resp, err := s.CancelOrderItems(context.Background(), tt.req)
+
+			assert.ErrorIs(t, err, tt.expectedErr)
+			if tt.expectedErr == nil {
+				assert.Equal(t, testId.String(), resp.GetOrder().GetId())
+			}
+		})
+	}
+}
+
 func TestItemHandler_CompleteOrder(t *testing.T) {
 	type mockBehavior func(s *mock_interfaces.MockOrderService, orderId uuid.UUID)
 
@@ -112,7 +185,7 @@ func TestItemHandler_CompleteOrder(t *testing.T) {
 		{
 			name: "OK",
 			req: &api.CompleteOrderRequest{
-				OrderId: testId.String(),
+				Id: testId.String(),
 			},
 			mockBehavior: func(s *mock_interfaces.MockOrderService, orderId uuid.UUID) {
 				s.EXPECT().CompleteOrder(
@@ -125,7 +198,7 @@ func TestItemHandler_CompleteOrder(t *testing.T) {
 		{
 			name: "NOT FOUND",
 			req: &api.CompleteOrderRequest{
-				OrderId: testId.String(),
+				Id: testId.String(),
 			},
 			mockBehavior: func(s *mock_interfaces.MockOrderService, orderId uuid.UUID) {
 				s.EXPECT().CompleteOrder(
@@ -138,23 +211,23 @@ func TestItemHandler_CompleteOrder(t *testing.T) {
 		{
 			name: "INVALID UUID",
 			req: &api.CompleteOrderRequest{
-				OrderId: "invalid",
+				Id: "invalid",
 			},
 			mockBehavior: func(s *mock_interfaces.MockOrderService, orderId uuid.UUID) {},
 			expectedErr:  domain.ErrInvalidUUID,
 		},
 		{
-			name: "ALREADY CANCELLED",
+			name: "INVALID TRANSITION",
 			req: &api.CompleteOrderRequest{
-				OrderId: testId.String(),
+				Id: testId.String(),
 			},
 			mockBehavior: func(s *mock_interfaces.MockOrderService, orderId uuid.UUID) {
 				s.EXPECT().CompleteOrder(
 					gomock.Any(),
 					gomock.Eq(orderId),
-				).Return(domain.ErrOrderAlreadyCancelled).Times(1)
+				).Return(domain.ErrInvalidTransition).Times(1)
 			},
-			expectedErr: domain.ErrOrderAlreadyCancelled,
+			expectedErr: domain.ErrInvalidTransition,
 		},
 	}
 
@@ -176,6 +249,105 @@ func TestItemHandler_CompleteOrder(t *testing.T) {
 	}
 }
 
+func TestItemHandler_ShipDeliverRefundOrder(t *testing.T) {
+	testId, err := uuid.NewUUID()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Ship, deliver and refund handlers only differ in service method they call.
+	rpcs := []struct {
+		name   string
+		expect func(s *mock_interfaces.MockOrderService) *gomock.Call
+		call   func(h *OrderHandler, id string) error
+	}{
+		{
+			name: "ShipOrder",
+			expect: func(s *mock_interfaces.MockOrderService) *gomock.Call {
+				return s.EXPECT().ShipOrder(gomock.Any(), gomock.Eq(testId))
+			},
+			call: func(h *OrderHandler, id string) error {
+				_, err := h.ShipOrder(context.Background(), &api.ShipOrderRequest{Id: id})
+				return err
+			},
+		},
+		{
+			name: "DeliverOrder",
+			expect: func(s *mock_interfaces.MockOrderService) *gomock.Call {
+				return s.EXPECT().DeliverOrder(gomock.Any(), gomock.Eq(testId))
+			},
+			call: func(h *OrderHandler, id string) error {
+				_, err := h.DeliverOrder(context.Background(), &api.DeliverOrderRequest{Id: id})
+				return err
+			},
+		},
+		{
+			name: "RefundOrder",
+			expect: func(s *mock_interfaces.MockOrderService) *gomock.Call {
+				return s.EXPECT().RefundOrder(gomock.Any(), gomock.Eq(testId))
+			},
+			call: func(h *OrderHandler, id string) error {
+				_, err := h.RefundOrder(context.Background(), &api.RefundOrderRequest{Id: id})
+				return err
+			},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		id          string
+		serviceErr  error
+		callService bool
+		expectedErr error
+	}{
+		{
+			name:        "OK",
+			id:          testId.String(),
+			callService: true,
+		},
+		{
+			name:        "NOT FOUND",
+			id:          testId.String(),
+			serviceErr:  domain.ErrOrderNotFound,
+			callService: true,
+			expectedErr: domain.ErrOrderNotFound,
+		},
+		{
+			name:        "INVALID UUID",
+			id:          "invalid",
+			expectedErr: domain.ErrInvalidUUID,
+		},
+		{
+			name:        "INVALID TRANSITION",
+			id:          testId.String(),
+			serviceErr:  domain.ErrInvalidTransition,
+			callService: true,
+			expectedErr: domain.ErrInvalidTransition,
+		},
+	}
+
+	for _, rpc := range rpcs {
+		for _, tt := range tests {
+			t.Run(rpc.name+" "+tt.name, func(t *testing.T) {
+				ctrl := gomock.NewController(t)
+				defer ctrl.Finish()
+
+				mockOrderService := mock_interfaces.NewMockOrderService(ctrl)
+				if tt.callService {
+					rpc.expect(mockOrderService).Return(tt.serviceErr).Times(1)
+				}
+
+				err := rpc.call(NewOrderHandler(mockOrderService), tt.id)
+
+				assert.ErrorIs(t, err, tt.expectedErr)
+			})
+		}
+	}
+}
+
+var testAddress = domain.Address{Country: "RU", City: "Moscow", PostalCode: "101000", Street: "Tverskaya", Building: "1",
+	Recipient: "Ivan Ivanov", Phone: "+79991234567"}
+
 func TestItemHandler_GetOrder(t *testing.T) {
 	type mockBehavior func(s *mock_interfaces.MockOrderService, orderId uuid.UUID)
 
@@ -184,33 +356,29 @@ func TestItemHandler_GetOrder(t *testing.T) {
 		"tt",
 		domain.OrderPending.String(),
 		domain.RUB.String(),
-		10.00,
-		1.00,
 		domain.BankCard.String(),
 		domain.Pickup.String(),
-		"tt st.",
+		testAddress,
 		time.Now().Add(time.Hour),
-		[]domain.Item{
-			{
-				ProductID: uuid.UUID{},
-				Quantity:  1,
-			},
-		},
+		domain.Items{domain.NewItem(uuid.UUID{}, 1, "tt", money.New(1000, "RUB"), 1.00)},
 	)
 	if err != nil {
 		t.Fatal(err)
 	}
 
 	protoTestOrder := &api.Order{
-		OrderId:         testOrder.ID.String(),
+		Id:         testOrder.ID.String(),
 		UserId:          testOrder.UserID.String(),
 		Description:     testOrder.Description,
 		Status:          testOrder.Status.String(),
 		Currency:        testOrder.Currency.String(),
-		TotalPrice:      testOrder.TotalPrice,
+		TotalPrice:      protoMoney(testOrder.TotalPrice()),
+		ShippingFee:     protoMoney(testOrder.ShippingFee),
+		Tax:             protoMoney(testOrder.Tax()),
 		PaymentMethod:   testOrder.PaymentMethod.String(),
 		DeliveryMethod:  testOrder.DeliveryMethod.String(),
-		DeliveryAddress: testOrder.DeliveryAddress,
+		DeliveryAddress: testOrder.DeliveryAddress.String(),
+		Address:         protoAddress(testOrder.DeliveryAddress),
 		DeliveryDate: &timestamppb.Timestamp{
 			Seconds: testOrder.DeliveryDate.Unix(),
 			Nanos:   int32(testOrder.DeliveryDate.Nanosecond()),
@@ -225,8 +393,14 @@ func TestItemHandler_GetOrder(t *testing.T) {
 		},
 		Items: []*api.Item{
 			{
-				ItemId:   testOrder.Items[0].ProductID.String(),
-				Quantity: testOrder.Items[0].Quantity,
+				ItemId:    testOrder.Items[0].ProductID.String(),
+				Quantity:  testOrder.Items[0].Quantity,
+				Name:      "tt",
+				UnitPrice: &api.Money{Amount: 1000, Currency: "RUB"},
+				Currency:  domain.RUB.String(),
+				Discount:  &api.Money{Amount: 10, Currency: "RUB"},
+				Total:     &api.Money{Amount: 990, Currency: "RUB"},
+				Tax:       &api.Money{Amount: 0, Currency: "RUB"},
 			},
 		},
 	}
@@ -241,7 +415,7 @@ func TestItemHandler_GetOrder(t *testing.T) {
 		{
 			name: "OK",
 			req: &api.GetOrderRequest{
-				OrderId: testOrder.ID.String(),
+				Id: testOrder.ID.String(),
 			},
 			mockBehavior: func(s *mock_interfaces.MockOrderService, orderId uuid.UUID) {
 				s.EXPECT().GetById(
@@ -257,7 +431,7 @@ func TestItemHandler_GetOrder(t *testing.T) {
 		{
 			name: "ORDER NOT FOUND",
 			req: &api.GetOrderRequest{
-				OrderId: testOrder.ID.String(),
+				Id: testOrder.ID.String(),
 			},
 			mockBehavior: func(s *mock_interfaces.MockOrderService, orderId uuid.UUID) {
 				s.EXPECT().GetById(
@@ -271,7 +445,7 @@ func TestItemHandler_GetOrder(t *testing.T) {
 		{
 			name: "INVALID UUID",
 			req: &api.GetOrderRequest{
-				OrderId: "invalid uuid",
+				Id: "invalid uuid",
 			},
 			mockBehavior: func(s *mock_interfaces.MockOrderService, orderId uuid.UUID) {},
 			expectedResp: nil,
@@ -280,7 +454,7 @@ func TestItemHandler_GetOrder(t *testing.T) {
 		{
 			name: "INTERNAL",
 			req: &api.GetOrderRequest{
-				OrderId: testOrder.ID.String(),
+				Id: testOrder.ID.String(),
 			},
 			mockBehavior: func(s *mock_interfaces.MockOrderService, orderId uuid.UUID) {
 				s.EXPECT().GetById(
@@ -312,6 +486,98 @@ func TestItemHandler_GetOrder(t *testing.T) {
 	}
 }
 
+func TestItemHandler_GetOrderHistory(t *testing.T) {
+	type mockBehavior func(s *mock_interfaces.MockOrderService, orderId uuid.UUID)
+
+	orderId := uuid.New()
+	createdAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	entries := []*domain.HistoryEntry{
+		{
+			ID:        1,
+			OrderID:   orderId,
+			Actor:     domain.Actor{Type: domain.ActorSystem, ID: "event-id"},
+			Type:      domain.ChangeStatusChanged,
+			Diff:      []domain.FieldChange{{Field: "status", From: "pending", To: "paid"}},
+			CreatedAt: createdAt,
+		},
+	}
+
+	tests := []struct {
+		name         string
+		req          *api.GetOrderHistoryRequest
+		mockBehavior mockBehavior
+		expectedResp *api.GetOrderHistoryResponse
+		expectedErr  error
+	}{
+		{
+			name: "OK",
+			req: &api.GetOrderHistoryRequest{
+				Id: orderId.String(),
+			},
+			mockBehavior: func(s *mock_interfaces.MockOrderService, orderId uuid.UUID) {
+				s.EXPECT().GetOrderHistory(
+					gomock.Any(),
+					gomock.Eq(orderId),
+				).Return(entries, nil).Times(1)
+			},
+			expectedResp: &api.GetOrderHistoryResponse{
+				Entries: []*api.OrderHistoryEntry{
+					{
+						Id:        1,
+						OrderId:   orderId.String(),
+						ActorType: "system",
+						ActorId:   "event-id",
+						Type:      "status_changed",
+						Changes:   []*api.FieldChange{{Field: "status", From: "pending", To: "paid"}},
+						CreatedAt: timestamppb.New(createdAt),
+					},
+				},
+			},
+		},
+		{
+			name: "PERMISSION DENIED",
+			req: &api.GetOrderHistoryRequest{
+				Id: orderId.String(),
+			},
+			mockBehavior: func(s *mock_interfaces.MockOrderService, orderId uuid.UUID) {
+				s.EXPECT().GetOrderHistory(
+					gomock.Any(),
+					gomock.Eq(orderId),
+				).Return(nil, domain.ErrPermissionDenied).Times(1)
+			},
+			expectedResp: nil,
+			expectedErr:  domain.ErrPermissionDenied,
+		},
+		{
+			name: "INVALID UUID",
+			req: &api.GetOrderHistoryRequest{
+				Id: "invalid uuid",
+			},
+			mockBehavior: func(s *mock_interfaces.MockOrderService, orderId uuid.UUID) {},
+			expectedResp: nil,
+			expectedErr:  domain.ErrInvalidUUID,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockOrderService := mock_interfaces.NewMockOrderService(ctrl)
+			tt.mockBehavior(mockOrderService, orderId)
+
+			s := NewOrderHandler(mockOrderService)
+
+			resp, err := s.GetOrderHistory(context.Background(), tt.req)
+
+			assert.Equal(t, tt.expectedResp, resp)
+			assert.ErrorIs(t, err, tt.expectedErr)
+		})
+	}
+}
+
 func TestItemHandler_CreateOrder(t *testing.T) {
 	type mockBehavior func(s *mock_interfaces.MockOrderService, info dto.CreateOrderRequest)
 
@@ -321,10 +587,9 @@ func TestItemHandler_CreateOrder(t *testing.T) {
 		Description:     "test description",
 		Status:          domain.OrderPending,
 		Currency:        domain.RUB,
-		TotalPrice:      100,
 		PaymentMethod:   domain.Cash,
 		DeliveryMethod:  domain.Pickup,
-		DeliveryAddress: "test address",
+		DeliveryAddress: testAddress,
 		DeliveryDate:    time.Now().UTC(),
 		Items: domain.Items{
 			{
@@ -337,15 +602,18 @@ func TestItemHandler_CreateOrder(t *testing.T) {
 	}
 
 	rpcTestOrder := &api.Order{
-		OrderId:         testOrder.ID.String(),
+		Id:         testOrder.ID.String(),
 		UserId:          testOrder.UserID.String(),
 		Description:     testOrder.Description,
 		Status:          testOrder.Status.String(),
 		Currency:        testOrder.Currency.String(),
-		TotalPrice:      testOrder.TotalPrice,
+		TotalPrice:      protoMoney(testOrder.TotalPrice()),
+		ShippingFee:     protoMoney(testOrder.ShippingFee),
+		Tax:             protoMoney(testOrder.Tax()),
 		PaymentMethod:   testOrder.PaymentMethod.String(),
 		DeliveryMethod:  testOrder.DeliveryMethod.String(),
-		DeliveryAddress: testOrder.DeliveryAddress,
+		DeliveryAddress: testOrder.DeliveryAddress.String(),
+		Address:         protoAddress(testOrder.DeliveryAddress),
 		DeliveryDate: &timestamppb.Timestamp{
 			Seconds: testOrder.DeliveryDate.Unix(),
 			Nanos:   int32(testOrder.DeliveryDate.Nanosecond()),
@@ -374,7 +642,7 @@ func TestItemHandler_CreateOrder(t *testing.T) {
 		Coupon:          &testCoupon,
 		PaymentMethod:   rpcTestOrder.PaymentMethod,
 		DeliveryMethod:  rpcTestOrder.DeliveryMethod,
-		DeliveryAddress: rpcTestOrder.DeliveryAddress,
+		Address:         rpcTestOrder.Address,
 		DeliveryDate:    rpcTestOrder.DeliveryDate,
 		Items:           rpcTestOrder.Items,
 	}
@@ -385,7 +653,7 @@ func TestItemHandler_CreateOrder(t *testing.T) {
 		Coupon:          &testCoupon,
 		PaymentMethod:   rpcTestOrder.PaymentMethod,
 		DeliveryMethod:  rpcTestOrder.DeliveryMethod,
-		DeliveryAddress: rpcTestOrder.DeliveryAddress,
+		Address:         rpcTestOrder.Address,
 		DeliveryDate:    rpcTestOrder.DeliveryDate,
 		Items: []*api.Item{
 			{
@@ -426,15 +694,18 @@ func TestItemHandler_CreateOrder(t *testing.T) {
 			},
 			expectedResp: &api.CreateOrderResponse{
 				Order: &api.Order{
-					OrderId:         testOrder.ID.String(),
+					Id:         testOrder.ID.String(),
 					UserId:          testOrder.UserID.String(),
 					Description:     testOrder.Description,
 					Status:          testOrder.Status.String(),
 					Currency:        testOrder.Currency.String(),
-					TotalPrice:      testOrder.TotalPrice,
+					TotalPrice:      protoMoney(testOrder.TotalPrice()),
+					ShippingFee:     protoMoney(testOrder.ShippingFee),
+					Tax:             protoMoney(testOrder.Tax()),
 					PaymentMethod:   testOrder.PaymentMethod.String(),
 					DeliveryMethod:  testOrder.DeliveryMethod.String(),
-					DeliveryAddress: testOrder.DeliveryAddress,
+					DeliveryAddress: testOrder.DeliveryAddress.String(),
+					Address:         protoAddress(testOrder.DeliveryAddress),
 					DeliveryDate: &timestamppb.Timestamp{
 						Seconds: testOrder.DeliveryDate.Unix(),
 						Nanos:   int32(testOrder.DeliveryDate.Nanosecond()),
@@ -647,7 +918,7 @@ func TestItemHandler_DeleteOrder(t *testing.T) {
 		{
 			name: "OK",
 			req: &api.DeleteOrderRequest{
-				OrderId: testId.String(),
+				Id: testId.String(),
 			},
 			mockBehavior: func(s *mock_interfaces.MockOrderService, orderId uuid.UUID) {
 				s.EXPECT().DeleteOrder(
@@ -660,7 +931,7 @@ func TestItemHandler_DeleteOrder(t *testing.T) {
 		{
 			name: "NOT FOUND",
 			req: &api.DeleteOrderRequest{
-				OrderId: testId.String(),
+				Id: testId.String(),
 			},
 			mockBehavior: func(s *mock_interfaces.MockOrderService, orderId uuid.UUID) {
 				s.EXPECT().DeleteOrder(
@@ -673,7 +944,7 @@ func TestItemHandler_DeleteOrder(t *testing.T) {
 		{
 			name: "INVALID UUID",
 			req: &api.DeleteOrderRequest{
-				OrderId: "invalid uuid",
+				Id: "invalid uuid",
 			},
 			mockBehavior: func(s *mock_interfaces.MockOrderService, orderId uuid.UUID) {},
 			expectedErr:  domain.ErrInvalidUUID,
@@ -681,7 +952,7 @@ func TestItemHandler_DeleteOrder(t *testing.T) {
 		{
 			name: "INTERNAL",
 			req: &api.DeleteOrderRequest{
-				OrderId: testId.String(),
+				Id: testId.String(),
 			},
 			mockBehavior: func(s *mock_interfaces.MockOrderService, orderId uuid.UUID) {
 				s.EXPECT().DeleteOrder(
@@ -711,9 +982,190 @@ func TestItemHandler_DeleteOrder(t *testing.T) {
 	}
 }
 
-// TODO
+func TestItemHandler_RestoreOrder(t *testing.T) {
+	type mockBehavior func(s *mock_interfaces.MockOrderService, orderId uuid.UUID)
+
+	testId := uuid.New()
+
+	tests := []struct {
+		name         string
+		req          *api.RestoreOrderRequest
+		mockBehavior mockBehavior
+		expectedErr  error
+	}{
+		{
+			name: "OK",
+			req: &api.RestoreOrderRequest{
+				Id: testId.String(),
+			},
+			mockBehavior: func(s *mock_interfaces.MockOrderService, orderId uuid.UUID) {
+				s.EXPECT().RestoreOrder(
+					gomock.Any(),
+					gomock.Eq(orderId),
+				).Return(&domain.Order{ID: orderId, UserID: uuid.New()}, nil).Times(1)
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "NOT RESTORABLE",
+			req: &api.RestoreOrderRequest{
+				Id: testId.String(),
+			},
+			mockBehavior: func(s *mock_interfaces.MockOrderService, orderId uuid.UUID) {
+				s.EXPECT().RestoreOrder(
+					gomock.Any(),
+					gomock.Eq(orderId),
+				).Return(nil, domain.ErrOrderNotRestorable).Times(1)
+			},
+			expectedErr: domain.ErrOrderNotRestorable,
+		},
+		{
+			name: "INVALID UUID",
+			req: &api.RestoreOrderRequest{
+				Id: "invalid uuid",
+			},
+			mockBehavior: func(s *mock_interfaces.MockOrderService, orderId uuid.UUID) {},
+			expectedErr:  domain.ErrInvalidUUID,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockOrderService := mock_interfaces.NewMockOrderService(ctrl)
+			tt.mockBehavior(mockOrderService, testId)
+
+			s := NewOrderHandler(mockOrderService)
+
+			resp, err := s.RestoreOrder(context.Background(), tt.req)
+
+			assert.ErrorIs(t, err, tt.expectedErr)
+			if tt.expectedErr == nil {
+				assert.Equal(t, testId.String(), resp.GetOrder().GetId())
+			}
+		})
+	}
+}
+
 func TestItemHandler_ListOrders(t *testing.T) {
-	t.Skip("not implemented")
+	type mockBehavior func(s *mock_interfaces.MockOrderService, filters map[string]any)
+
+	testOrder := &domain.Order{
+		ID:     uuid.New(),
+		UserID: uuid.New(),
+		Items: []domain.Item{
+			{
+				ProductID: uuid.New(),
+				Quantity:  1,
+			},
+		},
+	}
+
+	limit := uint64(10)
+	sortBy := string(domain.SortByTotalPrice)
+	total := uint64(11)
+
+	tests := []struct {
+		name          string
+		req           *api.ListOrdersRequest
+		filters       map[string]any
+		mockBehavior  mockBehavior
+		expectedLen   int
+		expectedToken string
+		expectedTotal uint64
+		expectedErr   error
+	}{
+		{
+			name: "OK",
+			req: &api.ListOrdersRequest{
+				Limit:  10,
+				Offset: 0,
+			},
+			filters: map[string]any{
+				"limit":     &limit,
+				"offset":    (*uint64)(nil),
+				"pageToken": "",
+				"sortBy":    (*string)(nil),
+				"sortOrder": (*string)(nil),
+				"withTotal": false,
+			},
+			mockBehavior: func(s *mock_interfaces.MockOrderService, filters map[string]any) {
+				s.EXPECT().ListByUser(
+					gomock.Any(),
+					gomock.Eq(filters),
+				).Return(&domain.OrderPage{Orders: []*domain.Order{testOrder}}, nil).Times(1)
+			},
+			expectedLen: 1,
+			expectedErr: nil,
+		},
+		{
+			name: "NEXT PAGE",
+			req: &api.ListOrdersRequest{
+				Limit:        10,
+				PageToken:    "token",
+				SortBy:       &sortBy,
+				IncludeTotal: true,
+			},
+			filters: map[string]any{
+				"limit":     &limit,
+				"offset":    (*uint64)(nil),
+				"pageToken": "token",
+				"sortBy":    &sortBy,
+				"sortOrder": (*string)(nil),
+				"withTotal": true,
+			},
+			mockBehavior: func(s *mock_interfaces.MockOrderService, filters map[string]any) {
+				s.EXPECT().ListByUser(
+					gomock.Any(),
+					gomock.Eq(filters),
+				).Return(&domain.OrderPage{
+					Orders:        []*domain.Order{testOrder},
+					NextPageToken: "next",
+					Total:         &total,
+				}, nil).Times(1)
+			},
+			expectedLen:   1,
+			expectedToken: "next",
+			expectedTotal: total,
+			expectedErr:   nil,
+		},
+		{
+			name: "UNAUTHENTICATED",
+			req: &api.ListOrdersRequest{
+				Limit:  10,
+				Offset: 0,
+			},
+			mockBehavior: func(s *mock_interfaces.MockOrderService, _ map[string]any) {
+				s.EXPECT().ListByUser(
+					gomock.Any(),
+					gomock.Any(),
+				).Return(nil, domain.ErrUnauthenticated).Times(1)
+			},
+			expectedLen: 0,
+			expectedErr: domain.ErrUnauthenticated,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockOrderService := mock_interfaces.NewMockOrderService(ctrl)
+			tt.mockBehavior(mockOrderService, tt.filters)
+
+			s := NewOrderHandler(mockOrderService)
+
+			resp, err := s.ListOrders(context.Background(), tt.req)
+
+			assert.ErrorIs(t, err, tt.expectedErr)
+			assert.Len(t, resp.GetOrders(), tt.expectedLen)
+			assert.Equal(t, tt.expectedToken, resp.GetNextPageToken())
+			assert.Equal(t, tt.expectedTotal, resp.GetTotal())
+		})
+	}
 }
 
 // TODO
@@ -731,10 +1183,9 @@ func TestItemHandler_UpdateOrder(t *testing.T) {
 		Description:     "test description",
 		Status:          domain.OrderPending,
 		Currency:        domain.RUB,
-		TotalPrice:      100,
 		PaymentMethod:   domain.Cash,
 		DeliveryMethod:  domain.Pickup,
-		DeliveryAddress: "test address",
+		DeliveryAddress: testAddress,
 		DeliveryDate:    time.Now().UTC(),
 		Items: domain.Items{
 			{
@@ -747,23 +1198,30 @@ func TestItemHandler_UpdateOrder(t *testing.T) {
 	}
 
 	rpcTestOrder := &api.Order{
-		OrderId:         testOrder.ID.String(),
+		Id:         testOrder.ID.String(),
 		UserId:          testOrder.UserID.String(),
 		Description:     testOrder.Description,
 		Status:          testOrder.Status.String(),
 		Currency:        testOrder.Currency.String(),
-		TotalPrice:      testOrder.TotalPrice,
+		TotalPrice:      protoMoney(testOrder.TotalPrice()),
+		ShippingFee:     protoMoney(testOrder.ShippingFee),
+		Tax:             protoMoney(testOrder.Tax()),
 		PaymentMethod:   testOrder.PaymentMethod.String(),
 		DeliveryMethod:  testOrder.DeliveryMethod.String(),
-		DeliveryAddress: testOrder.DeliveryAddress,
+		DeliveryAddress: testOrder.DeliveryAddress.String(),
+		Address:         protoAddress(testOrder.DeliveryAddress),
 		DeliveryDate: &timestamppb.Timestamp{
 			Seconds: testOrder.DeliveryDate.Unix(),
 			Nanos:   int32(testOrder.DeliveryDate.Nanosecond()),
 		},
 		Items: []*api.Item{
 			{
-				ItemId:   testOrder.Items[0].ProductID.String(),
-				Quantity: testOrder.Items[0].Quantity,
+				ItemId:    testOrder.Items[0].ProductID.String(),
+				Quantity:  testOrder.Items[0].Quantity,
+				UnitPrice: protoMoney(testOrder.Items[0].UnitPrice),
+				Discount:  protoMoney(testOrder.Items[0].Discount),
+				Total:     protoMoney(testOrder.Items[0].Total()),
+				Tax:       protoMoney(testOrder.Items[0].Tax),
 			},
 		},
 		CreatedAt: &timestamppb.Timestamp{
@@ -779,8 +1237,6 @@ func TestItemHandler_UpdateOrder(t *testing.T) {
 	validInfo := dto.UpdateOrderRequest{
 		OrderID:         testOrder.ID,
 		Description:     &testOrder.Description,
-		Status:          (*string)(&testOrder.Status),
-		TotalPrice:      &testOrder.TotalPrice,
 		PaymentMethod:   (*string)(&testOrder.PaymentMethod),
 		DeliveryMethod:  (*string)(&testOrder.DeliveryMethod),
 		DeliveryAddress: &testOrder.DeliveryAddress,
@@ -788,14 +1244,17 @@ func TestItemHandler_UpdateOrder(t *testing.T) {
 		Items:           testOrder.Items,
 	}
 
+	// Deprecated total is sent by older clients and ignored.
+	deprecatedTotal := testOrder.TotalPrice().Float64()
+
 	rpcReq := &api.UpdateOrderRequest{
-		OrderId:         rpcTestOrder.OrderId,
+		Id:         rpcTestOrder.Id,
 		Description:     &rpcTestOrder.Description,
-		Status:          &rpcTestOrder.Status,
-		TotalPrice:      &rpcTestOrder.TotalPrice,
+		TotalPrice:      &deprecatedTotal,
 		PaymentMethod:   &rpcTestOrder.PaymentMethod,
 		DeliveryMethod:  &rpcTestOrder.DeliveryMethod,
 		DeliveryAddress: &rpcTestOrder.DeliveryAddress,
+		Address:         rpcTestOrder.Address,
 		DeliveryDate:    rpcTestOrder.DeliveryDate,
 		Items:           rpcTestOrder.Items,
 	}
@@ -826,7 +1285,7 @@ func TestItemHandler_UpdateOrder(t *testing.T) {
 		{
 			name: "INVALID UUID",
 			req: &api.UpdateOrderRequest{
-				OrderId: "invalid uuid",
+				Id: "invalid uuid",
 			},
 			info:         dto.UpdateOrderRequest{},
 			mockBehavior: func(s *mock_interfaces.MockOrderService, info dto.UpdateOrderRequest) {},
@@ -892,3 +1351,21 @@ func Test_timeFromProtoIfNotZero(t *testing.T) {
 		})
 	}
 }
+
+func protoAddress(a domain.Address) *api.Address {
+	return &api.Address{
+		Country:    a.Country,
+		Region:     a.Region,
+		City:       a.City,
+		PostalCode: a.PostalCode,
+		Street:     a.Street,
+		Building:   a.Building,
+		Apartment:  a.Apartment,
+		Recipient:  a.Recipient,
+		Phone:      a.Phone,
+	}
+}
+
+func protoMoney(m money.Money) *api.Money {
+	return &api.Money{Amount: m.Amount, Currency: m.Currency}
+}
@@ -0,0 +1,32 @@
+package grpc_server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestGatewayHeaderMatcher(t *testing.T) {
+	for _, header := range []string{"Idempotency-Key", "idempotency-key", "IDEMPOTENCY-KEY"} {
+		key, ok := gatewayHeaderMatcher(header)
+		assert.True(t, ok, header)
+		assert.Equal(t, IdempotencyKeyMD, key, header)
+	}
+
+	// Other headers keep default behaviour.
+	key, ok := gatewayHeaderMatcher("Authorization")
+	assert.True(t, ok)
+	assert.Equal(t, "grpcgateway-Authorization", key)
+
+	_, ok = gatewayHeaderMatcher("X-Custom")
+	assert.False(t, ok)
+}
+
+func TestIdempotencyKeyFromCtx(t *testing.T) {
+	assert.Empty(t, idempotencyKeyFromCtx(context.Background()))
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(IdempotencyKeyMD, "key"))
+	assert.Equal(t, "key", idempotencyKeyFromCtx(ctx))
+}
@@ -10,7 +10,9 @@ import (
 	"google.golang.org/grpc/status"
 )
 
-func mapError(err error) error {
+// MapError turns error returned by service into gRPC status. Only AppError messages reach clients,
+// everything else becomes internal error.
+func MapError(err error) error {
 	if s, ok := status.FromError(err); ok {
 		return s.Err() // Return the status error if it's a gRPC error
 	}
@@ -35,9 +37,19 @@ func ErrorMapperInterceptor() grpc.UnaryServerInterceptor {
 	) (any, error) {
 		resp, err := handler(ctx, req)
 		if err != nil {
-			return nil, mapError(err)
+			return nil, MapError(err)
 		}
 
 		return resp, nil
 	}
 }
+
+func ErrorMapperStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := handler(srv, ss); err != nil {
+			return MapError(err)
+		}
+
+		return nil
+	}
+}
@@ -51,6 +51,21 @@ func (mr *MockOrderServiceMockRecorder) CancelOrder(ctx, orderId interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelOrder", reflect.TypeOf((*MockOrderService)(nil).CancelOrder), ctx, orderId)
 }
 
+// CancelOrderItems mocks base method.
+func (m *MockOrderService) CancelOrderItems(ctx context.Context, orderId uuid.UUID, items []domain.Item) (*domain.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelOrderItems", ctx, orderId, items)
+	ret0, _ := ret[0].(*domain.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelOrderItems indicates an expected call of CancelOrderItems.
+func (mr *MockOrderServiceMockRecorder) CancelOrderItems(ctx, orderId, items interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelOrderItems", reflect.TypeOf((*MockOrderService)(nil).CancelOrderItems), ctx, orderId, items)
+}
+
 // CompleteOrder mocks base method.
 func (m *MockOrderService) CompleteOrder(ctx context.Context, orderId uuid.UUID) error {
 	m.ctrl.T.Helper()
@@ -80,6 +95,21 @@ func (mr *MockOrderServiceMockRecorder) CreateOrder(ctx, info interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrder", reflect.TypeOf((*MockOrderService)(nil).CreateOrder), ctx, info)
 }
 
+// QuoteOrder mocks base method.
+func (m *MockOrderService) QuoteOrder(ctx context.Context, info dto.CreateOrderRequest) (*domain.Quote, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QuoteOrder", ctx, info)
+	ret0, _ := ret[0].(*domain.Quote)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QuoteOrder indicates an expected call of QuoteOrder.
+func (mr *MockOrderServiceMockRecorder) QuoteOrder(ctx, info interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QuoteOrder", reflect.TypeOf((*MockOrderService)(nil).QuoteOrder), ctx, info)
+}
+
 // DeleteOrder mocks base method.
 func (m *MockOrderService) DeleteOrder(ctx context.Context, orderId uuid.UUID) error {
 	m.ctrl.T.Helper()
@@ -94,6 +124,20 @@ func (mr *MockOrderServiceMockRecorder) DeleteOrder(ctx, orderId interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOrder", reflect.TypeOf((*MockOrderService)(nil).DeleteOrder), ctx, orderId)
 }
 
+// DeliverOrder mocks base method.
+func (m *MockOrderService) DeliverOrder(ctx context.Context, orderId uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeliverOrder", ctx, orderId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeliverOrder indicates an expected call of DeliverOrder.
+func (mr *MockOrderServiceMockRecorder) DeliverOrder(ctx, orderId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeliverOrder", reflect.TypeOf((*MockOrderService)(nil).DeliverOrder), ctx, orderId)
+}
+
 // GetById mocks base method.
 func (m *MockOrderService) GetById(ctx context.Context, orderId uuid.UUID) (*domain.Order, error) {
 	m.ctrl.T.Helper()
@@ -109,26 +153,84 @@ func (mr *MockOrderServiceMockRecorder) GetById(ctx, orderId interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetById", reflect.TypeOf((*MockOrderService)(nil).GetById), ctx, orderId)
 }
 
+// GetOrderHistory mocks base method.
+func (m *MockOrderService) GetOrderHistory(ctx context.Context, orderId uuid.UUID) ([]*domain.HistoryEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrderHistory", ctx, orderId)
+	ret0, _ := ret[0].([]*domain.HistoryEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrderHistory indicates an expected call of GetOrderHistory.
+func (mr *MockOrderServiceMockRecorder) GetOrderHistory(ctx, orderId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrderHistory", reflect.TypeOf((*MockOrderService)(nil).GetOrderHistory), ctx, orderId)
+}
+
 // ListByUser mocks base method.
-func (m *MockOrderService) ListByUser(ctx context.Context, limit, offset uint64) ([]*domain.Order, error) {
+func (m *MockOrderService) ListByUser(ctx context.Context, filters map[string]any) (*domain.OrderPage, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListByUser", ctx, limit, offset)
-	ret0, _ := ret[0].([]*domain.Order)
+	ret := m.ctrl.Call(m, "ListByUser", ctx, filters)
+	ret0, _ := ret[0].(*domain.OrderPage)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListByUser indicates an expected call of ListByUser.
-func (mr *MockOrderServiceMockRecorder) ListByUser(ctx, limit, offset interface{}) *gomock.Call {
+func (mr *MockOrderServiceMockRecorder) ListByUser(ctx, filters interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByUser", reflect.TypeOf((*MockOrderService)(nil).ListByUser), ctx, limit, offset)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByUser", reflect.TypeOf((*MockOrderService)(nil).ListByUser), ctx, filters)
+}
+
+// PayOrder mocks base method.
+func (m *MockOrderService) PayOrder(ctx context.Context, orderId uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PayOrder", ctx, orderId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PayOrder indicates an expected call of PayOrder.
+func (mr *MockOrderServiceMockRecorder) PayOrder(ctx, orderId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PayOrder", reflect.TypeOf((*MockOrderService)(nil).PayOrder), ctx, orderId)
+}
+
+// RefundOrder mocks base method.
+func (m *MockOrderService) RefundOrder(ctx context.Context, orderId uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefundOrder", ctx, orderId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RefundOrder indicates an expected call of RefundOrder.
+func (mr *MockOrderServiceMockRecorder) RefundOrder(ctx, orderId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefundOrder", reflect.TypeOf((*MockOrderService)(nil).RefundOrder), ctx, orderId)
+}
+
+// RestoreOrder mocks base method.
+func (m *MockOrderService) RestoreOrder(ctx context.Context, orderId uuid.UUID) (*domain.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreOrder", ctx, orderId)
+	ret0, _ := ret[0].(*domain.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreOrder indicates an expected call of RestoreOrder.
+func (mr *MockOrderServiceMockRecorder) RestoreOrder(ctx, orderId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreOrder", reflect.TypeOf((*MockOrderService)(nil).RestoreOrder), ctx, orderId)
 }
 
 // SearchOrders mocks base method.
-func (m *MockOrderService) SearchOrders(ctx context.Context, filters map[string]any) ([]*domain.Order, error) {
+func (m *MockOrderService) SearchOrders(ctx context.Context, filters map[string]any) (*domain.OrderPage, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SearchOrders", ctx, filters)
-	ret0, _ := ret[0].([]*domain.Order)
+	ret0, _ := ret[0].(*domain.OrderPage)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -139,6 +241,20 @@ func (mr *MockOrderServiceMockRecorder) SearchOrders(ctx, filters interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchOrders", reflect.TypeOf((*MockOrderService)(nil).SearchOrders), ctx, filters)
 }
 
+// ShipOrder mocks base method.
+func (m *MockOrderService) ShipOrder(ctx context.Context, orderId uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ShipOrder", ctx, orderId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ShipOrder indicates an expected call of ShipOrder.
+func (mr *MockOrderServiceMockRecorder) ShipOrder(ctx, orderId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShipOrder", reflect.TypeOf((*MockOrderService)(nil).ShipOrder), ctx, orderId)
+}
+
 // UpdateOrder mocks base method.
 func (m *MockOrderService) UpdateOrder(ctx context.Context, info dto.UpdateOrderRequest) (*domain.Order, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,38 @@
+package grpc_server
+
+import (
+	"github.com/dzhordano/ecom-thing/services/order/internal/domain"
+	"github.com/dzhordano/ecom-thing/services/order/internal/interfaces/grpc_server/interceptors"
+	api "github.com/dzhordano/ecom-thing/services/order/pkg/api/order/v1"
+)
+
+// methodPolicy mirrors security scopes declared in order.proto, coupon.proto and returns.proto.
+var methodPolicy = interceptors.Policy{
+	api.OrderService_CreateOrder_FullMethodName:      {domain.RoleUser, domain.RoleAdmin},
+	api.OrderService_QuoteOrder_FullMethodName:       {domain.RoleUser, domain.RoleAdmin},
+	api.OrderService_GetOrder_FullMethodName:         {domain.RoleUser, domain.RoleAdmin},
+	api.OrderService_GetOrderHistory_FullMethodName:  {domain.RoleUser, domain.RoleAdmin},
+	api.OrderService_ListOrders_FullMethodName:       {domain.RoleUser, domain.RoleAdmin},
+	api.OrderService_UpdateOrder_FullMethodName:      {domain.RoleAdmin},
+	api.OrderService_DeleteOrder_FullMethodName:      {domain.RoleAdmin},
+	api.OrderService_RestoreOrder_FullMethodName:     {domain.RoleAdmin},
+	api.OrderService_SearchOrders_FullMethodName:     {domain.RoleUser, domain.RoleAdmin},
+	api.OrderService_CompleteOrder_FullMethodName:    {domain.RoleUser, domain.RoleAdmin},
+	api.OrderService_CancelOrder_FullMethodName:      {domain.RoleUser, domain.RoleAdmin},
+	api.OrderService_ShipOrder_FullMethodName:        {domain.RoleAdmin},
+	api.OrderService_DeliverOrder_FullMethodName:     {domain.RoleAdmin},
+	api.OrderService_RefundOrder_FullMethodName:      {domain.RoleAdmin},
+	api.OrderService_CancelOrderItems_FullMethodName: {domain.RoleUser, domain.RoleAdmin},
+	api.OrderService_WatchOrder_FullMethodName:       {domain.RoleUser, domain.RoleAdmin},
+
+	api.CouponService_CreateCoupon_FullMethodName:     {domain.RoleAdmin},
+	api.CouponService_UpdateCoupon_FullMethodName:     {domain.RoleAdmin},
+	api.CouponService_DeactivateCoupon_FullMethodName: {domain.RoleAdmin},
+	api.CouponService_ListCoupons_FullMethodName:      {domain.RoleAdmin},
+	api.CouponService_GetCouponUsage_FullMethodName:   {domain.RoleAdmin},
+
+	api.ReturnService_RequestReturn_FullMethodName:      {domain.RoleUser, domain.RoleAdmin},
+	api.ReturnService_GetReturn_FullMethodName:          {domain.RoleUser, domain.RoleAdmin},
+	api.ReturnService_ListOrderReturns_FullMethodName:   {domain.RoleUser, domain.RoleAdmin},
+	api.ReturnService_UpdateReturnStatus_FullMethodName: {domain.RoleAdmin},
+}
@@ -0,0 +1,105 @@
+package grpc_server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/dzhordano/ecom-thing/services/order/internal/domain"
+	"github.com/dzhordano/ecom-thing/services/order/internal/interfaces/grpc_server/interceptors"
+	api "github.com/dzhordano/ecom-thing/services/order/pkg/api/order/v1"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestMethodPolicy_CoversAllMethods(t *testing.T) {
+	for _, desc := range []grpc.ServiceDesc{api.OrderService_ServiceDesc, api.CouponService_ServiceDesc, api.ReturnService_ServiceDesc} {
+		for _, m := range desc.Methods {
+			method := "/" + desc.ServiceName + "/" + m.MethodName
+			_, ok := methodPolicy[method]
+			assert.True(t, ok, "no policy for %s", method)
+		}
+		for _, st := range desc.Streams {
+			method := "/" + desc.ServiceName + "/" + st.StreamName
+			_, ok := methodPolicy[method]
+			assert.True(t, ok, "no policy for %s", method)
+		}
+	}
+}
+
+func TestAuthorizationInterceptor(t *testing.T) {
+	anonymous := context.Background()
+	user := domain.ContextWithPrincipal(context.Background(), domain.Principal{
+		UserID: uuid.New(),
+		Roles:  []domain.Role{domain.RoleUser},
+	})
+	admin := domain.ContextWithPrincipal(context.Background(), domain.Principal{
+		UserID: uuid.New(),
+		Roles:  []domain.Role{domain.RoleAdmin},
+	})
+	noRoles := domain.ContextWithPrincipal(context.Background(), domain.Principal{
+		UserID: uuid.New(),
+	})
+
+	tests := []struct {
+		method    string
+		anonymous codes.Code
+		noRoles   codes.Code
+		user      codes.Code
+		admin     codes.Code
+	}{
+		{api.OrderService_CreateOrder_FullMethodName, codes.Unauthenticated, codes.PermissionDenied, codes.OK, codes.OK},
+		{api.OrderService_QuoteOrder_FullMethodName, codes.Unauthenticated, codes.PermissionDenied, codes.OK, codes.OK},
+		{api.OrderService_GetOrder_FullMethodName, codes.Unauthenticated, codes.PermissionDenied, codes.OK, codes.OK},
+		{api.OrderService_GetOrderHistory_FullMethodName, codes.Unauthenticated, codes.PermissionDenied, codes.OK, codes.OK},
+		{api.OrderService_ListOrders_FullMethodName, codes.Unauthenticated, codes.PermissionDenied, codes.OK, codes.OK},
+		{api.OrderService_UpdateOrder_FullMethodName, codes.Unauthenticated, codes.PermissionDenied, codes.PermissionDenied, codes.OK},
+		{api.OrderService_DeleteOrder_FullMethodName, codes.Unauthenticated, codes.PermissionDenied, codes.PermissionDenied, codes.OK},
+		{api.OrderService_RestoreOrder_FullMethodName, codes.Unauthenticated, codes.PermissionDenied, codes.PermissionDenied, codes.OK},
+		{api.OrderService_SearchOrders_FullMethodName, codes.Unauthenticated, codes.PermissionDenied, codes.OK, codes.OK},
+		{api.OrderService_CompleteOrder_FullMethodName, codes.Unauthenticated, codes.PermissionDenied, codes.OK, codes.OK},
+		{api.OrderService_CancelOrder_FullMethodName, codes.Unauthenticated, codes.PermissionDenied, codes.OK, codes.OK},
+		{api.OrderService_ShipOrder_FullMethodName, codes.Unauthenticated, codes.PermissionDenied, codes.PermissionDenied, codes.OK},
+		{api.OrderService_DeliverOrder_FullMethodName, codes.Unauthenticated, codes.PermissionDenied, codes.PermissionDenied, codes.OK},
+		{api.OrderService_RefundOrder_FullMethodName, codes.Unauthenticated, codes.PermissionDenied, codes.PermissionDenied, codes.OK},
+		{api.OrderService_CancelOrderItems_FullMethodName, codes.Unauthenticated, codes.PermissionDenied, codes.OK, codes.OK},
+		{api.OrderService_WatchOrder_FullMethodName, codes.Unauthenticated, codes.PermissionDenied, codes.OK, codes.OK},
+		{api.CouponService_CreateCoupon_FullMethodName, codes.Unauthenticated, codes.PermissionDenied, codes.PermissionDenied, codes.OK},
+		{api.CouponService_UpdateCoupon_FullMethodName, codes.Unauthenticated, codes.PermissionDenied, codes.PermissionDenied, codes.OK},
+		{api.CouponService_DeactivateCoupon_FullMethodName, codes.Unauthenticated, codes.PermissionDenied, codes.PermissionDenied, codes.OK},
+		{api.CouponService_ListCoupons_FullMethodName, codes.Unauthenticated, codes.PermissionDenied, codes.PermissionDenied, codes.OK},
+		{api.CouponService_GetCouponUsage_FullMethodName, codes.Unauthenticated, codes.PermissionDenied, codes.PermissionDenied, codes.OK},
+		{api.ReturnService_RequestReturn_FullMethodName, codes.Unauthenticated, codes.PermissionDenied, codes.OK, codes.OK},
+		{api.ReturnService_GetReturn_FullMethodName, codes.Unauthenticated, codes.PermissionDenied, codes.OK, codes.OK},
+		{api.ReturnService_ListOrderReturns_FullMethodName, codes.Unauthenticated, codes.PermissionDenied, codes.OK, codes.OK},
+		{api.ReturnService_UpdateReturnStatus_FullMethodName, codes.Unauthenticated, codes.PermissionDenied, codes.PermissionDenied, codes.OK},
+		{"/api.order.v1.OrderService/Unknown", codes.PermissionDenied, codes.PermissionDenied, codes.PermissionDenied, codes.PermissionDenied},
+	}
+
+	interceptor := interceptors.AuthorizationInterceptor(methodPolicy)
+	handler := func(ctx context.Context, req any) (any, error) {
+		return nil, nil
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.method, func(t *testing.T) {
+			info := &grpc.UnaryServerInfo{FullMethod: tt.method}
+
+			for _, c := range []struct {
+				caller   string
+				ctx      context.Context
+				expected codes.Code
+			}{
+				{"anonymous", anonymous, tt.anonymous},
+				{"no roles", noRoles, tt.noRoles},
+				{"user", user, tt.user},
+				{"admin", admin, tt.admin},
+			} {
+				_, err := interceptor(c.ctx, nil, info, handler)
+				assert.Equal(t, c.expected, status.Code(err), c.caller)
+			}
+		})
+	}
+}
@@ -0,0 +1,131 @@
+package grpc_server
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/dzhordano/ecom-thing/services/order/internal/application/dto"
+	"github.com/dzhordano/ecom-thing/services/order/internal/application/interfaces"
+	"github.com/dzhordano/ecom-thing/services/order/internal/domain"
+	"github.com/dzhordano/ecom-thing/services/order/internal/interfaces/grpc_server/converter"
+	api "github.com/dzhordano/ecom-thing/services/order/pkg/api/order/v1"
+)
+
+type ReturnHandler struct {
+	api.UnimplementedReturnServiceServer
+	service interfaces.ReturnService
+}
+
+func NewReturnHandler(s interfaces.ReturnService) *ReturnHandler {
+	return &ReturnHandler{
+		service: s,
+	}
+}
+
+func (h *ReturnHandler) RequestReturn(ctx context.Context, req *api.RequestReturnRequest) (*api.RequestReturnResponse, error) {
+	span := trace.SpanFromContext(ctx)
+	defer span.End()
+
+	orderId, err := uuid.Parse(req.GetOrderId())
+	if err != nil {
+		return nil, domain.ErrInvalidUUID
+	}
+
+	items, err := converter.RPCItemsToDomain(req.GetItems())
+	if err != nil {
+		return nil, err
+	}
+
+	span.AddEvent("call service",
+		trace.WithAttributes(
+			attribute.String("order_id", req.GetOrderId()),
+			attribute.Int("items", len(items)),
+		),
+	)
+
+	ret, err := h.service.RequestReturn(ctx, dto.RequestReturnRequest{
+		OrderID: orderId,
+		Items:   items,
+		Reason:  req.GetReason(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.RequestReturnResponse{
+		Return: converter.FromDomainToProto_Return(ret),
+	}, nil
+}
+
+func (h *ReturnHandler) GetReturn(ctx context.Context, req *api.GetReturnRequest) (*api.GetReturnResponse, error) {
+	span := trace.SpanFromContext(ctx)
+	defer span.End()
+
+	returnId, err := uuid.Parse(req.GetId())
+	if err != nil {
+		return nil, domain.ErrInvalidUUID
+	}
+
+	ret, err := h.service.GetReturn(ctx, returnId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.GetReturnResponse{
+		Return: converter.FromDomainToProto_Return(ret),
+	}, nil
+}
+
+func (h *ReturnHandler) ListOrderReturns(ctx context.Context, req *api.ListOrderReturnsRequest) (*api.ListOrderReturnsResponse, error) {
+	span := trace.SpanFromContext(ctx)
+	defer span.End()
+
+	orderId, err := uuid.Parse(req.GetOrderId())
+	if err != nil {
+		return nil, domain.ErrInvalidUUID
+	}
+
+	returns, err := h.service.ListOrderReturns(ctx, orderId)
+	if err != nil {
+		return nil, err
+	}
+
+	span.AddEvent("returns retrieved",
+		trace.WithAttributes(
+			attribute.Int("count", len(returns)),
+		),
+	)
+
+	return &api.ListOrderReturnsResponse{
+		Returns: converter.FromDomainToProto_Returns(returns),
+	}, nil
+}
+
+func (h *ReturnHandler) UpdateReturnStatus(ctx context.Context, req *api.UpdateReturnStatusRequest) (*api.UpdateReturnStatusResponse, error) {
+	span := trace.SpanFromContext(ctx)
+	defer span.End()
+
+	returnId, err := uuid.Parse(req.GetId())
+	if err != nil {
+		return nil, domain.ErrInvalidUUID
+	}
+
+	span.AddEvent("call service",
+		trace.WithAttributes(
+			attribute.String("return_id", req.GetId()),
+			attribute.String("status", req.GetStatus()),
+		),
+	)
+
+	ret, err := h.service.UpdateReturnStatus(ctx, returnId, req.GetStatus())
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.UpdateReturnStatusResponse{
+		Return: converter.FromDomainToProto_Return(ret),
+	}, nil
+}
@@ -17,6 +17,7 @@ import (
 	"net/http/pprof"
 	"time"
 
+	"github.com/dzhordano/ecom-thing/services/order/internal/application/interfaces"
 	"github.com/dzhordano/ecom-thing/services/order/internal/infrastructure"
 	"github.com/dzhordano/ecom-thing/services/order/internal/interfaces/grpc_server/interceptors"
 	api "github.com/dzhordano/ecom-thing/services/order/pkg/api/order/v1"
@@ -48,6 +49,12 @@ type Server struct {
 	// FIXME ХЗ Насколько это нормально...
 	cb *gobreaker.Settings
 	tp *tracesdk.TracerProvider
+
+	auth *interceptors.Authenticator
+
+	coupons api.CouponServiceServer
+	returns api.ReturnServiceServer
+	watch   *orderWatch
 }
 
 func WithAddr(addr string) Option {
@@ -68,6 +75,38 @@ func WithTracerProvider(tp *tracesdk.TracerProvider) Option {
 	}
 }
 
+// WithAuthenticator sets authenticator used to resolve caller identity from bearer token.
+func WithAuthenticator(a *interceptors.Authenticator) Option {
+	return func(s *Server) {
+		s.auth = a
+	}
+}
+
+// WithCouponHandler registers coupon management API alongside orders.
+func WithCouponHandler(h api.CouponServiceServer) Option {
+	return func(s *Server) {
+		s.coupons = h
+	}
+}
+
+// WithReturnHandler registers order returns API alongside orders.
+func WithReturnHandler(h api.ReturnServiceServer) Option {
+	return func(s *Server) {
+		s.returns = h
+	}
+}
+
+// WithOrderWatcher enables WatchOrder stream and its SSE counterpart. Heartbeat is sent to clients
+// after that long without update.
+func WithOrderWatcher(w interfaces.OrderWatcher, heartbeat time.Duration) Option {
+	return func(s *Server) {
+		s.watch = &orderWatch{
+			watcher:   w,
+			heartbeat: heartbeat,
+		}
+	}
+}
+
 func WithRateLimiter(limit, burst int) Option {
 	return func(s *Server) {
 		s.ratelimiterLimit = limit
@@ -107,6 +146,15 @@ func MustNew(log logger.Logger, handler api.OrderServiceServer, opts ...Option)
 		panic("addr is required")
 	}
 
+	if s.auth == nil {
+		panic("authenticator is required")
+	}
+
+	if s.watch != nil {
+		s.watch.auth = s.auth
+		handler = watchingOrderServer{OrderServiceServer: handler, watch: s.watch}
+	}
+
 	recoveryOpts := []recovery.Option{
 		recovery.WithRecoveryHandler(func(p interface{}) (err error) {
 			log.Error("Recovered from panic", "panic", p)
@@ -147,9 +195,19 @@ func MustNew(log logger.Logger, handler api.OrderServiceServer, opts ...Option)
 			ratelimiter.RateLimiterInterceptor(),
 			recovery.UnaryServerInterceptor(recoveryOpts...),
 			logging.UnaryServerInterceptor(interceptors.InterceptorLogger(log), loggingOpts...),
+			s.auth.UnaryServerInterceptor(),
+			interceptors.AuthorizationInterceptor(methodPolicy),
 			interceptors.ErrorMapperInterceptor(),
 			interceptors.MetricsInterceptor(),
 		),
+		// Only WatchOrder streams. Rate limiter and circuit breaker count requests, long-lived streams are left out.
+		grpc.ChainStreamInterceptor(
+			recovery.StreamServerInterceptor(recoveryOpts...),
+			logging.StreamServerInterceptor(interceptors.InterceptorLogger(log), loggingOpts...),
+			s.auth.StreamServerInterceptor(),
+			interceptors.AuthorizationStreamInterceptor(methodPolicy),
+			interceptors.ErrorMapperStreamInterceptor(),
+		),
 	}
 
 	if s.tp != nil {
@@ -166,6 +224,12 @@ func MustNew(log logger.Logger, handler api.OrderServiceServer, opts ...Option)
 	srv := grpc.NewServer(sOpts...)
 
 	api.RegisterOrderServiceServer(srv, handler)
+	if s.coupons != nil {
+		api.RegisterCouponServiceServer(srv, s.coupons)
+	}
+	if s.returns != nil {
+		api.RegisterReturnServiceServer(srv, s.returns)
+	}
 
 	reflection.Register(srv)
 
@@ -179,7 +243,7 @@ func MustNew(log logger.Logger, handler api.OrderServiceServer, opts ...Option)
 // Handles all HTTP2 requests with 'content-type: application/grpc_server' headers with grpc_server server
 // Other paths are hardcoded (for now at least).
 //
-// Hardcoded ones are: <addr>/metrics. And if profiling is enabled: <addr>/debug/pprof{/,/cmdline,/profile,/symbol,/trace}.
+// Hardcoded ones are: <addr>/metrics, <addr>/api/v1/orders/{id}/watch if order watcher is set. And if profiling is enabled: <addr>/debug/pprof{/,/cmdline,/profile,/symbol,/trace}.
 func (s *Server) Run(ctx context.Context) error {
 	grpcLis, err := net.Listen("tcp", s.addr)
 	if err != nil {
@@ -187,7 +251,9 @@ func (s *Server) Run(ctx context.Context) error {
 		return err
 	}
 
-	gwMux := runtime.NewServeMux()
+	gwMux := runtime.NewServeMux(
+		runtime.WithIncomingHeaderMatcher(gatewayHeaderMatcher),
+	)
 	dialOpts := []grpc.DialOption{
 		grpc.WithTransportCredentials(
 			insecure.NewCredentials(),
@@ -198,6 +264,18 @@ func (s *Server) Run(ctx context.Context) error {
 		return err
 	}
 
+	if s.coupons != nil {
+		if err := api.RegisterCouponServiceHandlerFromEndpoint(ctx, gwMux, s.addr, dialOpts); err != nil {
+			return err
+		}
+	}
+
+	if s.returns != nil {
+		if err := api.RegisterReturnServiceHandlerFromEndpoint(ctx, gwMux, s.addr, dialOpts); err != nil {
+			return err
+		}
+	}
+
 	r := echo.New()
 
 	// Endpoint for getting swagger docs.
@@ -214,6 +292,10 @@ func (s *Server) Run(ctx context.Context) error {
 	)
 
 	apiGroup := r.Group("/api/v1")
+	if s.watch != nil {
+		// Takes precedence over gateway wildcard below.
+		apiGroup.GET("/orders/:id/watch", s.watch.sse)
+	}
 	// Wrap gateway mux.
 	apiGroup.Any("/*", echo.WrapHandler(http.StripPrefix("/api/v1", gwMux)))
 
@@ -0,0 +1,157 @@
+package grpc_server
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/labstack/echo/v4"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/dzhordano/ecom-thing/services/order/internal/application/interfaces"
+	"github.com/dzhordano/ecom-thing/services/order/internal/domain"
+	"github.com/dzhordano/ecom-thing/services/order/internal/interfaces/grpc_server/converter"
+	"github.com/dzhordano/ecom-thing/services/order/internal/interfaces/grpc_server/interceptors"
+	api "github.com/dzhordano/ecom-thing/services/order/pkg/api/order/v1"
+)
+
+// sseMarshaler renders orders in SSE stream the same way gateway renders them in responses.
+var sseMarshaler = &runtime.JSONPb{
+	MarshalOptions: protojson.MarshalOptions{EmitUnpopulated: true},
+}
+
+// orderWatch serves live order updates both as gRPC stream and as Server-Sent Events.
+type orderWatch struct {
+	watcher   interfaces.OrderWatcher
+	heartbeat time.Duration
+	auth      *interceptors.Authenticator
+}
+
+// run sends order updates until watch ends, pinging client whenever heartbeat passes without update.
+// Watch ending on its own (order deleted, server shutting down) is not an error.
+func (w *orderWatch) run(ctx context.Context, orderId uuid.UUID, send func(*domain.Order) error, ping func() error) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	updates, err := w.watcher.WatchOrder(ctx, orderId)
+	if err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(w.heartbeat)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case order, ok := <-updates:
+			if !ok {
+				return nil
+			}
+			if err := send(order); err != nil {
+				return err
+			}
+			ticker.Reset(w.heartbeat)
+		case <-ticker.C:
+			if err := ping(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// watchingOrderServer adds WatchOrder to order handler, so watching is configured in one place
+// with SSE endpoint.
+type watchingOrderServer struct {
+	api.OrderServiceServer
+	watch *orderWatch
+}
+
+func (s watchingOrderServer) WatchOrder(req *api.WatchOrderRequest, stream api.OrderService_WatchOrderServer) error {
+	orderId, err := uuid.Parse(req.GetId())
+	if err != nil {
+		return domain.NewAppError(domain.ErrInvalidUUID, "invalid order id")
+	}
+
+	return s.watch.run(stream.Context(), orderId,
+		func(order *domain.Order) error {
+			return stream.Send(&api.WatchOrderResponse{Order: converter.FromDomainToProto_Order(order)})
+		},
+		func() error {
+			return stream.Send(&api.WatchOrderResponse{Heartbeat: true})
+		},
+	)
+}
+
+// sse serves WatchOrder as Server-Sent Events: every order state is "order" event with order JSON
+// as data, heartbeats are comments. Errors before stream starts are returned as gateway does.
+func (w *orderWatch) sse(c echo.Context) error {
+	ctx, err := w.auth.AuthenticateHTTP(c.Request())
+	if err != nil {
+		return sseError(c, err)
+	}
+
+	if err := methodPolicy.Authorize(ctx, api.OrderService_WatchOrder_FullMethodName); err != nil {
+		return sseError(c, err)
+	}
+
+	orderId, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return sseError(c, domain.NewAppError(domain.ErrInvalidUUID, "invalid order id"))
+	}
+
+	res := c.Response()
+	started := false
+	start := func() {
+		if started {
+			return
+		}
+		started = true
+
+		res.Header().Set(echo.HeaderContentType, "text/event-stream")
+		res.Header().Set(echo.HeaderCacheControl, "no-cache")
+		res.Header().Set(echo.HeaderConnection, "keep-alive")
+		res.Header().Set("X-Accel-Buffering", "no") // Keep nginx from buffering events.
+		res.WriteHeader(http.StatusOK)
+	}
+
+	err = w.run(ctx, orderId,
+		func(order *domain.Order) error {
+			data, err := sseMarshaler.Marshal(converter.FromDomainToProto_Order(order))
+			if err != nil {
+				return err
+			}
+
+			start()
+			if _, err := fmt.Fprintf(res, "event: order\ndata: %s\n\n", data); err != nil {
+				return err
+			}
+			res.Flush()
+			return nil
+		},
+		func() error {
+			start()
+			if _, err := fmt.Fprint(res, ": heartbeat\n\n"); err != nil {
+				return err
+			}
+			res.Flush()
+			return nil
+		},
+	)
+	if err != nil && !started {
+		return sseError(c, err)
+	}
+
+	return nil
+}
+
+func sseError(c echo.Context, err error) error {
+	st := status.Convert(interceptors.MapError(err))
+	return c.JSON(runtime.HTTPStatusFromCode(st.Code()), map[string]any{
+		"code":    st.Code(),
+		"message": st.Message(),
+	})
+}
@@ -0,0 +1,165 @@
+package grpc_server
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/dzhordano/ecom-thing/services/order/internal/domain"
+	"github.com/dzhordano/ecom-thing/services/order/internal/interfaces/grpc_server/interceptors"
+	api "github.com/dzhordano/ecom-thing/services/order/pkg/api/order/v1"
+)
+
+// scriptedWatcher sends given orders, waits for ctx, if hold is set, and ends watch.
+type scriptedWatcher struct {
+	orders []*domain.Order
+	hold   time.Duration
+	err    error
+}
+
+func (w scriptedWatcher) WatchOrder(ctx context.Context, _ uuid.UUID) (<-chan *domain.Order, error) {
+	if w.err != nil {
+		return nil, w.err
+	}
+
+	out := make(chan *domain.Order)
+	go func() {
+		defer close(out)
+		for _, o := range w.orders {
+			select {
+			case out <- o:
+			case <-ctx.Done():
+				return
+			}
+		}
+		select {
+		case <-time.After(w.hold):
+		case <-ctx.Done():
+		}
+	}()
+
+	return out, nil
+}
+
+type recordingWatchStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent []*api.WatchOrderResponse
+}
+
+func (s *recordingWatchStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *recordingWatchStream) Send(resp *api.WatchOrderResponse) error {
+	s.sent = append(s.sent, resp)
+	return nil
+}
+
+func TestWatchingOrderServer_WatchOrder(t *testing.T) {
+	order := &domain.Order{ID: uuid.New(), Status: domain.OrderPaid}
+	srv := watchingOrderServer{
+		watch: &orderWatch{
+			watcher:   scriptedWatcher{orders: []*domain.Order{order}, hold: 50 * time.Millisecond},
+			heartbeat: 10 * time.Millisecond,
+		},
+	}
+
+	stream := &recordingWatchStream{ctx: context.Background()}
+	require.NoError(t, srv.WatchOrder(&api.WatchOrderRequest{Id: order.ID.String()}, stream))
+
+	require.GreaterOrEqual(t, len(stream.sent), 2)
+	assert.Equal(t, order.ID.String(), stream.sent[0].GetOrder().GetId())
+	for _, resp := range stream.sent[1:] {
+		assert.True(t, resp.GetHeartbeat())
+		assert.Nil(t, resp.GetOrder())
+	}
+
+	err := srv.WatchOrder(&api.WatchOrderRequest{Id: "nope"}, stream)
+	assert.ErrorIs(t, err, domain.ErrInvalidUUID)
+}
+
+func TestOrderWatch_SSE(t *testing.T) {
+	secret := []byte("test-secret")
+	userId := uuid.New()
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, interceptors.Claims{
+		Roles: []string{"user"},
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   userId.String(),
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		},
+	}).SignedString(secret)
+	require.NoError(t, err)
+
+	auth, err := interceptors.NewHMACAuthenticator(secret)
+	require.NoError(t, err)
+
+	order := &domain.Order{ID: uuid.New(), UserID: userId, Status: domain.OrderPaid}
+
+	tests := []struct {
+		name           string
+		target         string
+		watcher        scriptedWatcher
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:           "OK",
+			target:         "/orders/" + order.ID.String() + "/watch?access_token=" + token,
+			watcher:        scriptedWatcher{orders: []*domain.Order{order}, hold: 30 * time.Millisecond},
+			expectedStatus: http.StatusOK,
+			expectedBody:   "event: order\ndata: ",
+		},
+		{
+			name:           "UNAUTHENTICATED",
+			target:         "/orders/" + order.ID.String() + "/watch",
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "INVALID UUID",
+			target:         "/orders/nope/watch?access_token=" + token,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "OTHER USER",
+			target:         "/orders/" + order.ID.String() + "/watch?access_token=" + token,
+			watcher:        scriptedWatcher{err: domain.NewAppError(domain.ErrPermissionDenied, "order belongs to another user")},
+			expectedStatus: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := &orderWatch{
+				watcher:   tt.watcher,
+				heartbeat: 10 * time.Millisecond,
+				auth:      auth,
+			}
+
+			e := echo.New()
+			e.GET("/orders/:id/watch", w.sse)
+
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, tt.target, nil))
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			if tt.expectedStatus != http.StatusOK {
+				return
+			}
+
+			assert.Equal(t, "text/event-stream", rec.Header().Get(echo.HeaderContentType))
+			assert.True(t, strings.HasPrefix(rec.Body.String(), tt.expectedBody), rec.Body.String())
+			assert.Contains(t, rec.Body.String(), ": heartbeat\n\n")
+		})
+	}
+}
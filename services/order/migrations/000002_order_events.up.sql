@@ -0,0 +1,12 @@
+-- No foreign key on purpose: history outlives deleted orders.
+CREATE TABLE IF NOT EXISTS order_events(
+  id BIGSERIAL PRIMARY KEY,
+  order_id UUID NOT NULL,
+  actor_type VARCHAR(32) NOT NULL,
+  actor_id VARCHAR(255) NOT NULL,
+  event_type VARCHAR(64) NOT NULL,
+  diff JSONB NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS order_events_order_id_idx ON order_events(order_id, id);
@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS checkout_sagas(
+  order_id UUID PRIMARY KEY,
+  state VARCHAR(50) NOT NULL,
+  payment_requested BOOLEAN NOT NULL DEFAULT FALSE,
+  stock_committed BOOLEAN NOT NULL DEFAULT FALSE,
+  reason VARCHAR(255) NOT NULL DEFAULT '',
+  deadline TIMESTAMPTZ,
+  retry_at TIMESTAMPTZ,
+  version BIGINT NOT NULL DEFAULT 0,
+  created_at TIMESTAMPTZ NOT NULL,
+  updated_at TIMESTAMPTZ NOT NULL
+);
+
+-- Finished sagas have no retry_at, so worker scans only active ones.
+CREATE INDEX IF NOT EXISTS checkout_sagas_retry_at_idx ON checkout_sagas (retry_at) WHERE retry_at IS NOT NULL;
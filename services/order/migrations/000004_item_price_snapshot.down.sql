@@ -0,0 +1,5 @@
+ALTER TYPE item
+  DROP ATTRIBUTE discount,
+  DROP ATTRIBUTE currency,
+  DROP ATTRIBUTE unit_price,
+  DROP ATTRIBUTE name;
@@ -0,0 +1,19 @@
+ALTER TYPE item
+  ADD ATTRIBUTE name VARCHAR(255),
+  ADD ATTRIBUTE unit_price DECIMAL(10, 2),
+  ADD ATTRIBUTE currency VARCHAR(255),
+  ADD ATTRIBUTE discount DECIMAL(10, 2);
+
+-- Orders placed before snapshots existed only have a total, so it's spread evenly over ordered units.
+-- Line totals of such orders may differ from old total by rounding.
+UPDATE orders o SET items = ARRAY(
+  SELECT ROW(
+    i.item_id,
+    i.quantity,
+    '',
+    COALESCE(ROUND(o.total_price / NULLIF((SELECT SUM(j.quantity) FROM unnest(o.items) j), 0), 2), 0),
+    o.currency,
+    0
+  )::item
+  FROM unnest(o.items) i
+);
@@ -0,0 +1,21 @@
+CREATE TYPE item AS (
+  item_id UUID,
+  quantity INTEGER,
+  name VARCHAR(255),
+  unit_price DECIMAL(10, 2),
+  currency VARCHAR(255),
+  discount DECIMAL(10, 2)
+);
+
+ALTER TABLE orders ADD COLUMN items item[] NOT NULL DEFAULT '{}';
+
+UPDATE orders o SET items = ARRAY(
+  SELECT ROW(i.product_id, i.quantity, i.name, i.unit_price, i.currency, i.discount)::item
+  FROM order_items i
+  WHERE i.order_id = o.id
+  ORDER BY i.line_no
+);
+
+ALTER TABLE orders ALTER COLUMN items DROP DEFAULT;
+
+DROP TABLE IF EXISTS order_items;
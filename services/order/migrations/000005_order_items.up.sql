@@ -0,0 +1,23 @@
+CREATE TABLE IF NOT EXISTS order_items(
+  order_id UUID NOT NULL REFERENCES orders(id) ON DELETE CASCADE,
+  line_no INTEGER NOT NULL,
+  product_id UUID NOT NULL,
+  quantity BIGINT NOT NULL,
+  name VARCHAR(255) NOT NULL,
+  unit_price DECIMAL(10, 2) NOT NULL,
+  currency VARCHAR(255) NOT NULL,
+  discount DECIMAL(10, 2) NOT NULL,
+  PRIMARY KEY (order_id, line_no)
+);
+
+-- Search by product (optionally with quantity range) and by quantity alone.
+CREATE INDEX IF NOT EXISTS order_items_product_id_quantity_idx ON order_items(product_id, quantity);
+CREATE INDEX IF NOT EXISTS order_items_quantity_idx ON order_items(quantity);
+
+INSERT INTO order_items (order_id, line_no, product_id, quantity, name, unit_price, currency, discount)
+SELECT o.id, i.line_no, i.item_id, i.quantity, COALESCE(i.name, ''), COALESCE(i.unit_price, 0), COALESCE(i.currency, o.currency), COALESCE(i.discount, 0)
+FROM orders o, unnest(o.items) WITH ORDINALITY AS i(item_id, quantity, name, unit_price, currency, discount, line_no);
+
+ALTER TABLE orders DROP COLUMN items;
+
+DROP TYPE IF EXISTS item;
@@ -0,0 +1,17 @@
+DROP TABLE IF EXISTS coupon_redemptions;
+
+ALTER TABLE orders DROP COLUMN IF EXISTS coupon_code;
+
+ALTER TABLE coupons
+  DROP COLUMN IF EXISTS discount_type,
+  DROP COLUMN IF EXISTS amount,
+  DROP COLUMN IF EXISTS currency,
+  DROP COLUMN IF EXISTS min_order_amount,
+  DROP COLUMN IF EXISTS max_redemptions,
+  DROP COLUMN IF EXISTS max_redemptions_per_user,
+  DROP COLUMN IF EXISTS redemptions,
+  DROP COLUMN IF EXISTS product_ids,
+  DROP COLUMN IF EXISTS categories,
+  DROP COLUMN IF EXISTS active,
+  DROP COLUMN IF EXISTS created_at,
+  DROP COLUMN IF EXISTS updated_at;
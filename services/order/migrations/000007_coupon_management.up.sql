@@ -0,0 +1,30 @@
+-- Discount stays percent of percent coupons, amount is used by fixed ones.
+ALTER TABLE coupons
+  ADD COLUMN IF NOT EXISTS discount_type VARCHAR(50) NOT NULL DEFAULT 'percent',
+  ADD COLUMN IF NOT EXISTS amount DECIMAL(10, 2) NOT NULL DEFAULT 0,
+  ADD COLUMN IF NOT EXISTS currency VARCHAR(3) NOT NULL DEFAULT '',
+  ADD COLUMN IF NOT EXISTS min_order_amount DECIMAL(10, 2) NOT NULL DEFAULT 0,
+  ADD COLUMN IF NOT EXISTS max_redemptions BIGINT NOT NULL DEFAULT 0,
+  ADD COLUMN IF NOT EXISTS max_redemptions_per_user BIGINT NOT NULL DEFAULT 0,
+  ADD COLUMN IF NOT EXISTS redemptions BIGINT NOT NULL DEFAULT 0,
+  ADD COLUMN IF NOT EXISTS product_ids UUID[] NOT NULL DEFAULT '{}',
+  ADD COLUMN IF NOT EXISTS categories TEXT[] NOT NULL DEFAULT '{}',
+  ADD COLUMN IF NOT EXISTS active BOOLEAN NOT NULL DEFAULT TRUE,
+  ADD COLUMN IF NOT EXISTS created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  ADD COLUMN IF NOT EXISTS updated_at TIMESTAMPTZ NOT NULL DEFAULT now();
+
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS coupon_code VARCHAR(255) NOT NULL DEFAULT '';
+
+-- Orders are not referenced, so redemptions outlive deleted orders and still count against limits.
+CREATE TABLE IF NOT EXISTS coupon_redemptions(
+  coupon_id INTEGER NOT NULL REFERENCES coupons(id),
+  order_id UUID NOT NULL,
+  user_id UUID NOT NULL,
+  discount DECIMAL(10, 2) NOT NULL,
+  currency VARCHAR(3) NOT NULL,
+  redeemed_at TIMESTAMPTZ NOT NULL,
+  PRIMARY KEY (coupon_id, order_id)
+);
+
+CREATE INDEX IF NOT EXISTS coupon_redemptions_coupon_id_user_id_idx ON coupon_redemptions (coupon_id, user_id);
+CREATE INDEX IF NOT EXISTS coupon_redemptions_coupon_id_redeemed_at_idx ON coupon_redemptions (coupon_id, redeemed_at);
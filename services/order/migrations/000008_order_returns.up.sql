@@ -0,0 +1,25 @@
+CREATE TABLE IF NOT EXISTS order_returns(
+  id UUID PRIMARY KEY,
+  order_id UUID NOT NULL REFERENCES orders(id) ON DELETE CASCADE,
+  user_id UUID NOT NULL,
+  currency VARCHAR(3) NOT NULL,
+  reason VARCHAR(255) NOT NULL,
+  status VARCHAR(50) NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL,
+  updated_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS order_returns_order_id_idx ON order_returns(order_id);
+
+-- Same layout as order_items, prices are parts of order lines being returned.
+CREATE TABLE IF NOT EXISTS return_items(
+  return_id UUID NOT NULL REFERENCES order_returns(id) ON DELETE CASCADE,
+  line_no INTEGER NOT NULL,
+  product_id UUID NOT NULL,
+  quantity BIGINT NOT NULL,
+  name VARCHAR(255) NOT NULL,
+  unit_price DECIMAL(10, 2) NOT NULL,
+  currency VARCHAR(255) NOT NULL,
+  discount DECIMAL(10, 2) NOT NULL,
+  PRIMARY KEY (return_id, line_no)
+);
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS orders_delivery_date_id_idx;
+DROP INDEX IF EXISTS orders_total_price_id_idx;
+DROP INDEX IF EXISTS orders_created_at_id_idx;
+DROP INDEX IF EXISTS orders_user_id_created_at_id_idx;
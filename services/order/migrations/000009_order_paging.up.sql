@@ -0,0 +1,5 @@
+-- Pages are read in (sort key, id) order, user lists are the most frequent ones.
+CREATE INDEX IF NOT EXISTS orders_user_id_created_at_id_idx ON orders(user_id, created_at, id);
+CREATE INDEX IF NOT EXISTS orders_created_at_id_idx ON orders(created_at, id);
+CREATE INDEX IF NOT EXISTS orders_total_price_id_idx ON orders(total_price, id);
+CREATE INDEX IF NOT EXISTS orders_delivery_date_id_idx ON orders(delivery_date, id);
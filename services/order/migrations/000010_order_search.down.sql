@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS orders_search_vector_idx;
+ALTER TABLE orders DROP COLUMN IF EXISTS search_vector;
@@ -0,0 +1,10 @@
+-- Stored generated column is computed for every existing order while the table is rewritten,
+-- so adding it backfills the whole table.
+-- 'simple' configuration doesn't stem: descriptions and addresses come in more than one language
+-- and stemming rules of one would mangle words of another. Matching is still case-insensitive.
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS search_vector tsvector GENERATED ALWAYS AS (
+  setweight(to_tsvector('simple', description), 'A') ||
+  setweight(to_tsvector('simple', delivery_address), 'B')
+) STORED;
+
+CREATE INDEX IF NOT EXISTS orders_search_vector_idx ON orders USING GIN (search_vector);
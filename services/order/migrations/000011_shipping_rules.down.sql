@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS orders_delivery_method_delivery_date_idx;
+ALTER TABLE orders DROP COLUMN IF EXISTS shipping_fee;
+DROP TABLE IF EXISTS shipping_rule_tiers;
+DROP TABLE IF EXISTS shipping_rules;
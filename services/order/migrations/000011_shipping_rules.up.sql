@@ -0,0 +1,28 @@
+-- Amounts are taken as is in order currency, like product prices.
+-- Zero free_from, max_days and slot_capacity mean no threshold, no latest date and unlimited slots.
+CREATE TABLE IF NOT EXISTS shipping_rules(
+  delivery_method VARCHAR(255) PRIMARY KEY,
+  base_fee DECIMAL(10, 2) NOT NULL DEFAULT 0,
+  free_from DECIMAL(10, 2) NOT NULL DEFAULT 0,
+  min_days INTEGER NOT NULL DEFAULT 0,
+  max_days INTEGER NOT NULL DEFAULT 0,
+  slot_capacity BIGINT NOT NULL DEFAULT 0,
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+-- Fee of orders with at least min_items units, replaces base fee.
+CREATE TABLE IF NOT EXISTS shipping_rule_tiers(
+  delivery_method VARCHAR(255) NOT NULL REFERENCES shipping_rules(delivery_method) ON DELETE CASCADE,
+  min_items BIGINT NOT NULL,
+  fee DECIMAL(10, 2) NOT NULL,
+  PRIMARY KEY (delivery_method, min_items)
+);
+
+-- Every method starts free and unrestricted, as it was before rules existed.
+INSERT INTO shipping_rules (delivery_method) VALUES ('standard'), ('express'), ('pickup')
+ON CONFLICT DO NOTHING;
+
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS shipping_fee DECIMAL(10, 2) NOT NULL DEFAULT 0;
+
+-- Booked slots are counted per method and day.
+CREATE INDEX IF NOT EXISTS orders_delivery_method_delivery_date_idx ON orders(delivery_method, delivery_date);
@@ -0,0 +1,14 @@
+ALTER TABLE return_items
+  DROP COLUMN IF EXISTS tax_rate,
+  DROP COLUMN IF EXISTS tax,
+  DROP COLUMN IF EXISTS tax_included;
+
+ALTER TABLE order_items
+  DROP COLUMN IF EXISTS tax_rate,
+  DROP COLUMN IF EXISTS tax,
+  DROP COLUMN IF EXISTS tax_included;
+
+ALTER TABLE orders DROP COLUMN IF EXISTS tax_region;
+
+DROP TABLE IF EXISTS tax_rates;
+DROP TABLE IF EXISTS tax_regions;
@@ -0,0 +1,42 @@
+-- Rates are percents. Included regions have tax in prices (VAT), others charge it on top of them.
+CREATE TABLE IF NOT EXISTS tax_regions(
+  region VARCHAR(16) PRIMARY KEY,
+  included BOOLEAN NOT NULL,
+  default_rate DECIMAL(5, 2) NOT NULL DEFAULT 0,
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+-- Rate of product category, replaces default rate of region.
+CREATE TABLE IF NOT EXISTS tax_rates(
+  region VARCHAR(16) NOT NULL REFERENCES tax_regions(region) ON DELETE CASCADE,
+  category VARCHAR(255) NOT NULL,
+  rate DECIMAL(5, 2) NOT NULL,
+  PRIMARY KEY (region, category)
+);
+
+-- VAT is already in RUB and EUR prices, so seeded tables don't change what orders cost.
+-- US sales tax depends on state and is left for operators to fill in.
+INSERT INTO tax_regions (region, included, default_rate) VALUES
+  ('RU', TRUE, 20),
+  ('EU', TRUE, 20),
+  ('US', FALSE, 0)
+ON CONFLICT DO NOTHING;
+
+INSERT INTO tax_rates (region, category, rate) VALUES
+  ('RU', 'books', 10),
+  ('RU', 'food', 10),
+  ('RU', 'children', 10)
+ON CONFLICT DO NOTHING;
+
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS tax_region VARCHAR(16) NOT NULL DEFAULT '';
+
+-- Lines of orders placed before taxes existed are untaxed.
+ALTER TABLE order_items
+  ADD COLUMN IF NOT EXISTS tax_rate DECIMAL(5, 2) NOT NULL DEFAULT 0,
+  ADD COLUMN IF NOT EXISTS tax DECIMAL(10, 2) NOT NULL DEFAULT 0,
+  ADD COLUMN IF NOT EXISTS tax_included BOOLEAN NOT NULL DEFAULT FALSE;
+
+ALTER TABLE return_items
+  ADD COLUMN IF NOT EXISTS tax_rate DECIMAL(5, 2) NOT NULL DEFAULT 0,
+  ADD COLUMN IF NOT EXISTS tax DECIMAL(10, 2) NOT NULL DEFAULT 0,
+  ADD COLUMN IF NOT EXISTS tax_included BOOLEAN NOT NULL DEFAULT FALSE;
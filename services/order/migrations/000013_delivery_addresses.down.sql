@@ -0,0 +1,25 @@
+DROP INDEX IF EXISTS orders_delivery_postal_code_idx;
+DROP INDEX IF EXISTS orders_delivery_country_city_idx;
+
+ALTER TABLE orders
+  DROP COLUMN IF EXISTS delivery_country,
+  DROP COLUMN IF EXISTS delivery_region,
+  DROP COLUMN IF EXISTS delivery_city,
+  DROP COLUMN IF EXISTS delivery_postal_code,
+  DROP COLUMN IF EXISTS delivery_street,
+  DROP COLUMN IF EXISTS delivery_building,
+  DROP COLUMN IF EXISTS delivery_apartment,
+  DROP COLUMN IF EXISTS delivery_recipient,
+  DROP COLUMN IF EXISTS delivery_phone;
+
+DROP INDEX IF EXISTS orders_search_vector_idx;
+ALTER TABLE orders DROP COLUMN IF EXISTS search_vector;
+
+ALTER TABLE orders ALTER COLUMN delivery_address TYPE VARCHAR(255) USING left(delivery_address, 255);
+
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS search_vector tsvector GENERATED ALWAYS AS (
+  setweight(to_tsvector('simple', description), 'A') ||
+  setweight(to_tsvector('simple', delivery_address), 'B')
+) STORED;
+
+CREATE INDEX IF NOT EXISTS orders_search_vector_idx ON orders USING GIN (search_vector);
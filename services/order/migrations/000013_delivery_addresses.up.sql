@@ -0,0 +1,31 @@
+-- delivery_address stays as one-line form of the address: it is what full-text search matches.
+-- Parts may be longer than the old column together, so it is widened, which needs search_vector rebuilt.
+DROP INDEX IF EXISTS orders_search_vector_idx;
+ALTER TABLE orders DROP COLUMN IF EXISTS search_vector;
+
+ALTER TABLE orders ALTER COLUMN delivery_address TYPE TEXT;
+
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS search_vector tsvector GENERATED ALWAYS AS (
+  setweight(to_tsvector('simple', description), 'A') ||
+  setweight(to_tsvector('simple', delivery_address), 'B')
+) STORED;
+
+CREATE INDEX IF NOT EXISTS orders_search_vector_idx ON orders USING GIN (search_vector);
+
+ALTER TABLE orders
+  ADD COLUMN IF NOT EXISTS delivery_country VARCHAR(2) NOT NULL DEFAULT '',
+  ADD COLUMN IF NOT EXISTS delivery_region VARCHAR(255) NOT NULL DEFAULT '',
+  ADD COLUMN IF NOT EXISTS delivery_city VARCHAR(255) NOT NULL DEFAULT '',
+  ADD COLUMN IF NOT EXISTS delivery_postal_code VARCHAR(16) NOT NULL DEFAULT '',
+  ADD COLUMN IF NOT EXISTS delivery_street VARCHAR(255) NOT NULL DEFAULT '',
+  ADD COLUMN IF NOT EXISTS delivery_building VARCHAR(255) NOT NULL DEFAULT '',
+  ADD COLUMN IF NOT EXISTS delivery_apartment VARCHAR(255) NOT NULL DEFAULT '',
+  ADD COLUMN IF NOT EXISTS delivery_recipient VARCHAR(255) NOT NULL DEFAULT '',
+  ADD COLUMN IF NOT EXISTS delivery_phone VARCHAR(16) NOT NULL DEFAULT '';
+
+-- Free-form addresses can't be split reliably, so old orders keep the whole line as street.
+-- Their address is validated only once it is changed, see Order.ValidateChanges.
+UPDATE orders SET delivery_street = left(delivery_address, 255) WHERE delivery_street = '';
+
+CREATE INDEX IF NOT EXISTS orders_delivery_country_city_idx ON orders(delivery_country, lower(delivery_city));
+CREATE INDEX IF NOT EXISTS orders_delivery_postal_code_idx ON orders(delivery_postal_code);
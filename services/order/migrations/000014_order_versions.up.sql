@@ -0,0 +1,2 @@
+-- Bumped by every update, updates of order read at older version are refused.
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS version BIGINT NOT NULL DEFAULT 0;
@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS orders_deleted_at_idx;
+
+ALTER TABLE orders
+  DROP COLUMN IF EXISTS anonymized_at,
+  DROP COLUMN IF EXISTS deleted_by,
+  DROP COLUMN IF EXISTS deleted_at;
@@ -0,0 +1,8 @@
+-- Deleted orders are archived: kept for accounting, hidden from owners until restored or erased by retention.
+ALTER TABLE orders
+  ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMPTZ,
+  ADD COLUMN IF NOT EXISTS deleted_by UUID,
+  ADD COLUMN IF NOT EXISTS anonymized_at TIMESTAMPTZ;
+
+-- Retention scans archived orders only, which are few compared to active ones.
+CREATE INDEX IF NOT EXISTS orders_deleted_at_idx ON orders(deleted_at) WHERE deleted_at IS NOT NULL;
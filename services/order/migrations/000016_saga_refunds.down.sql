@@ -0,0 +1,4 @@
+-- Sagas waiting for refund are considered compensated, as they were before.
+UPDATE checkout_sagas SET state = 'compensated', retry_at = NULL WHERE state = 'refunding';
+
+ALTER TABLE checkout_sagas DROP COLUMN IF EXISTS payment_captured;
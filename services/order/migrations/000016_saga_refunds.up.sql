@@ -0,0 +1,5 @@
+-- Aborted checkout of captured payment waits in refunding state until refund is confirmed.
+ALTER TABLE checkout_sagas ADD COLUMN IF NOT EXISTS payment_captured BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- Stock was committed only after payment completed.
+UPDATE checkout_sagas SET payment_captured = TRUE WHERE stock_committed;
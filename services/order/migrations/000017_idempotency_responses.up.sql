@@ -0,0 +1,2 @@
+-- Response replayed to retries of completed request. Keys completed before have none.
+ALTER TABLE idempotency_keys ADD COLUMN IF NOT EXISTS response JSONB;
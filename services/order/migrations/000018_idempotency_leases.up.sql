@@ -0,0 +1,3 @@
+-- Request holding key in progress and when its lease ends. Keys left in progress before are taken over right away.
+ALTER TABLE idempotency_keys ADD COLUMN IF NOT EXISTS lock_id UUID NOT NULL DEFAULT gen_random_uuid();
+ALTER TABLE idempotency_keys ADD COLUMN IF NOT EXISTS locked_until TIMESTAMPTZ NOT NULL DEFAULT 'epoch';
@@ -0,0 +1,7 @@
+-- Units added to orders that inventory hasn't replied about yet. Rejected ones are taken off order lines.
+CREATE TABLE IF NOT EXISTS order_stock_locks(
+  id UUID PRIMARY KEY,
+  order_id UUID NOT NULL REFERENCES orders(id) ON DELETE CASCADE,
+  items JSONB NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL
+);
@@ -0,0 +1,249 @@
+// Package money provides exact monetary amounts.
+//
+// Amounts are kept as integer minor units (cents, kopecks), every supported currency has
+// two minor digits. Whenever a result falls between two minor units it is rounded
+// half away from zero: 0.005 becomes 0.01 and -0.005 becomes -0.01.
+//
+// Package is copied as is to every service that deals with money, keep the copies in sync.
+package money
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// Scale is number of minor digits of an amount.
+const Scale = 2
+
+const minorPerMajor = 100
+
+var ErrInvalidAmount = errors.New("invalid money amount")
+
+// Money is an amount in minor units of Currency.
+//
+// Arithmetic keeps currency of the receiver, operands are expected to be in the same currency.
+type Money struct {
+	Amount   int64
+	Currency string
+}
+
+func New(amount int64, currency string) Money {
+	return Money{Amount: amount, Currency: currency}
+}
+
+// Parse reads decimal amount like "12.34" exactly. More than Scale fraction digits is an error.
+func Parse(s, currency string) (Money, error) {
+	amount, err := parseDecimal(s, false)
+	if err != nil {
+		return Money{}, err
+	}
+
+	return New(amount, currency), nil
+}
+
+// FromFloat converts float amount (as found in APIs using double) to Money.
+// The shortest decimal representation of f is rounded, so 1.005 becomes 1.01 even
+// though its binary value is slightly less than that.
+func FromFloat(f float64, currency string) Money {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return New(0, currency)
+	}
+
+	amount, err := parseDecimal(strconv.FormatFloat(f, 'f', -1, 64), true)
+	if err != nil {
+		return New(0, currency)
+	}
+
+	return New(amount, currency)
+}
+
+func (m Money) Add(o Money) Money {
+	return New(m.Amount+o.Amount, m.Currency)
+}
+
+func (m Money) Sub(o Money) Money {
+	return New(m.Amount-o.Amount, m.Currency)
+}
+
+// Mul is price of n units.
+func (m Money) Mul(n uint64) Money {
+	return New(m.Amount*int64(n), m.Currency)
+}
+
+// MulRatio is m * num / den, rounded.
+func (m Money) MulRatio(num, den int64) Money {
+	if den == 0 {
+		return New(0, m.Currency)
+	}
+
+	return New(divRound(new(big.Int).Mul(big.NewInt(m.Amount), big.NewInt(num)), big.NewInt(den)), m.Currency)
+}
+
+// Percent is p percent of m. p is rounded to hundredths of percent before applying,
+// result is rounded to minor units.
+func (m Money) Percent(p float64) Money {
+	bp := FromFloat(p, "").Amount // Basis points, same scale as minor units.
+	return m.MulRatio(bp, 100*minorPerMajor)
+}
+
+func (m Money) IsZero() bool {
+	return m.Amount == 0
+}
+
+func (m Money) IsNegative() bool {
+	return m.Amount < 0
+}
+
+func (m Money) IsPositive() bool {
+	return m.Amount > 0
+}
+
+// Cmp compares amounts, currency is not taken into account.
+func (m Money) Cmp(o Money) int {
+	switch {
+	case m.Amount < o.Amount:
+		return -1
+	case m.Amount > o.Amount:
+		return 1
+	}
+	return 0
+}
+
+// String formats amount as decimal with Scale fraction digits, without currency.
+func (m Money) String() string {
+	sign := ""
+	a := m.Amount
+	if a < 0 {
+		sign = "-"
+	}
+
+	major := a / minorPerMajor
+	minor := a % minorPerMajor
+	if major < 0 {
+		major = -major
+	}
+	if minor < 0 {
+		minor = -minor
+	}
+
+	return fmt.Sprintf("%s%d.%02d", sign, major, minor)
+}
+
+// Float64 is for APIs that still transfer amounts as double. Don't compute with it.
+func (m Money) Float64() float64 {
+	f, _ := strconv.ParseFloat(m.String(), 64)
+	return f
+}
+
+// Value implements driver.Valuer. Amount is stored as decimal, currency is stored separately.
+func (m Money) Value() (driver.Value, error) {
+	return m.String(), nil
+}
+
+// Scan implements sql.Scanner. Only amount is scanned, currency is left as is.
+func (m *Money) Scan(src any) error {
+	switch v := src.(type) {
+	case string:
+		amount, err := parseDecimal(v, true)
+		if err != nil {
+			return err
+		}
+		m.Amount = amount
+	case []byte:
+		return m.Scan(string(v))
+	case int64:
+		m.Amount = v * minorPerMajor
+	case float64:
+		m.Amount = FromFloat(v, "").Amount
+	default:
+		return fmt.Errorf("%w: cannot scan %T", ErrInvalidAmount, src)
+	}
+
+	return nil
+}
+
+// parseDecimal returns s in minor units. Extra fraction digits are rounded if round is set.
+func parseDecimal(s string, round bool) (int64, error) {
+	s = strings.TrimSpace(s)
+
+	neg := strings.HasPrefix(s, "-")
+	s = strings.TrimPrefix(strings.TrimPrefix(s, "-"), "+")
+
+	intPart, fracPart, _ := strings.Cut(s, ".")
+	if intPart == "" && fracPart == "" || !isDigits(intPart) || !isDigits(fracPart) {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidAmount, s)
+	}
+
+	if len(fracPart) > Scale && !round {
+		return 0, fmt.Errorf("%w: more than %d fraction digits in %q", ErrInvalidAmount, Scale, s)
+	}
+
+	digits := new(big.Int)
+	if _, ok := digits.SetString("0"+intPart+fracPart, 10); !ok {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidAmount, s)
+	}
+	if neg {
+		digits.Neg(digits)
+	}
+
+	// digits has len(fracPart) fraction digits, bring it to Scale.
+	shift := len(fracPart) - Scale
+	if shift < 0 {
+		digits.Mul(digits, new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(-shift)), nil))
+		shift = 0
+	}
+
+	divisor := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(shift)), nil)
+	result := divRoundBig(digits, divisor)
+	if !result.IsInt64() {
+		return 0, fmt.Errorf("%w: %q is out of range", ErrInvalidAmount, s)
+	}
+
+	return result.Int64(), nil
+}
+
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+func divRound(a, b *big.Int) int64 {
+	q := divRoundBig(a, b)
+	if !q.IsInt64() {
+		if q.Sign() < 0 {
+			return math.MinInt64
+		}
+		return math.MaxInt64
+	}
+	return q.Int64()
+}
+
+// divRoundBig is a / b rounded half away from zero.
+func divRoundBig(a, b *big.Int) *big.Int {
+	q, r := new(big.Int).QuoRem(a, b, new(big.Int))
+	if r.Sign() == 0 {
+		return q
+	}
+
+	// |2r| >= |b| means remainder is at least half.
+	twiceR := new(big.Int).Abs(r)
+	twiceR.Lsh(twiceR, 1)
+	if twiceR.Cmp(new(big.Int).Abs(b)) >= 0 {
+		if (a.Sign() < 0) != (b.Sign() < 0) {
+			q.Sub(q, big.NewInt(1))
+		} else {
+			q.Add(q, big.NewInt(1))
+		}
+	}
+
+	return q
+}
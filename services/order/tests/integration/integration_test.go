@@ -7,8 +7,11 @@ import (
 	"github.com/dzhordano/ecom-thing/services/order/internal/application/service"
 	"github.com/dzhordano/ecom-thing/services/order/internal/domain"
 	"github.com/dzhordano/ecom-thing/services/order/internal/domain/repository"
+	"github.com/dzhordano/ecom-thing/services/order/internal/infrastructure/outbox"
+	"github.com/dzhordano/ecom-thing/services/order/internal/infrastructure/pricing"
 	"github.com/dzhordano/ecom-thing/services/order/internal/infrastructure/repository/pg"
 	"github.com/dzhordano/ecom-thing/services/order/pkg/logger"
+	"github.com/dzhordano/ecom-thing/services/order/pkg/money"
 	"github.com/golang-migrate/migrate/v4"
 	_ "github.com/golang-migrate/migrate/v4/database/postgres"
 	_ "github.com/golang-migrate/migrate/v4/source/file"
@@ -20,6 +23,8 @@ import (
 	"os"
 	"path/filepath"
 	"runtime"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 	"unsafe"
@@ -35,13 +40,29 @@ func (s *stubInventoryService) IsReservable(ctx context.Context, items map[strin
 }
 
 type stubProductService struct {
-	RetPrice float64
+	RetName  string
+	RetPrice money.Money
 	RetValid bool
 	RetErr   error
 }
 
-func (s *stubProductService) GetProductInfo(ctx context.Context, orderId uuid.UUID) (float64, bool, error) {
-	return s.RetPrice, s.RetValid, s.RetErr
+func (s *stubProductService) GetProductInfo(ctx context.Context, productId uuid.UUID) (*dto.ProductInfo, error) {
+	if s.RetErr != nil {
+		return nil, s.RetErr
+	}
+	return &dto.ProductInfo{Name: s.RetName, Price: s.RetPrice, IsActive: s.RetValid}, nil
+}
+
+func (s *stubProductService) GetProductsInfo(ctx context.Context, productIds []uuid.UUID) (map[uuid.UUID]*dto.ProductInfo, error) {
+	if s.RetErr != nil {
+		return nil, s.RetErr
+	}
+
+	products := make(map[uuid.UUID]*dto.ProductInfo, len(productIds))
+	for _, id := range productIds {
+		products[id], _ = s.GetProductInfo(ctx, id)
+	}
+	return products, nil
 }
 
 type Suite struct {
@@ -50,6 +71,7 @@ type Suite struct {
 	db       *pgxpool.Pool
 	repo     repository.OrderRepository
 	orderSvc interfaces.OrderService
+	checkout interfaces.Checkout
 	invSvc   unsafe.Pointer
 	prodSvc  unsafe.Pointer
 
@@ -107,10 +129,25 @@ func (s *Suite) SetupSuite() {
 	s.db = pool
 	s.repo = pg.NewOrderRepository(s.db)
 
+	s.checkout = service.NewCheckoutService(
+		testLogger,
+		service.CheckoutConfig{
+			StockTimeout:   time.Minute,
+			PaymentTimeout: time.Minute,
+			RetryInterval:  time.Minute,
+		},
+		pg.NewSagaRepository(s.db),
+		s.repo,
+		pg.NewTransactor(s.db),
+		outbox.NewInventoryCommands(),
+		outbox.NewPaymentCommands(),
+	)
+
 	s.orderSvc = service.NewOrderService(
 		testLogger,
 		&stubProductService{
-			RetPrice: 99.99,
+			RetName:  "Test Product, \"quoted\"",
+			RetPrice: money.New(9999, ""),
 			RetValid: true,
 			RetErr:   nil,
 		},
@@ -118,7 +155,14 @@ func (s *Suite) SetupSuite() {
 			RetReservable: true,
 			RetErr:        nil,
 		},
-		s.repo)
+		pricing.NewRuleShipping(pg.NewShippingRuleRepository(s.db)),
+		pricing.NewTableTaxes(pg.NewTaxRepository(s.db)),
+		s.repo,
+		pg.NewCouponRepository(s.db),
+		s.checkout,
+		pg.NewIdempotencyRepository(s.db),
+		time.Hour,
+		time.Minute)
 }
 
 func (s *Suite) TearDownSuite() {
@@ -133,6 +177,9 @@ func TestIntegration(t *testing.T) {
 	suite.Run(t, new(Suite))
 }
 
+var testAddress = domain.Address{Country: "US", City: "New York", PostalCode: "10001", Street: "5th Avenue", Building: "1",
+	Recipient: "John Doe", Phone: "+12125550100"}
+
 func (s *Suite) SetupTest() {
 
 	s.testOrder = &domain.Order{
@@ -141,27 +188,24 @@ func (s *Suite) SetupTest() {
 		Description:     "Test Description",
 		Status:          domain.OrderPending,
 		Currency:        domain.USD,
-		TotalPrice:      99.99,
 		PaymentMethod:   domain.Cash,
 		DeliveryMethod:  domain.Pickup,
-		DeliveryAddress: "Test Address",
+		DeliveryAddress: testAddress,
 		DeliveryDate:    time.Now().Add(time.Hour),
-		Items: []domain.Item{
-			{
-				ProductID: uuid.New(),
-				Quantity:  1,
-			},
-		},
-		CreatedAt: time.Now().UTC(),
-		UpdatedAt: time.Now().UTC(),
+		Items:           domain.Items{domain.NewItem(uuid.New(), 1, "Test Product", money.New(9999, "USD"), 0)},
+		CreatedAt:       time.Now().UTC(),
+		UpdatedAt:       time.Now().UTC(),
 	}
 
-	err := s.repo.Save(context.Background(), s.testOrder)
+	err := s.repo.Save(context.Background(), s.testOrder, nil)
 	s.NoError(err)
 }
 
 func (s *Suite) TearDownTest() {
-	err := s.repo.Delete(context.Background(), s.testOrder.ID.String())
+	_, err := s.db.Exec(context.Background(), "DELETE FROM orders WHERE id = $1", s.testOrder.ID)
+	s.NoError(err)
+
+	_, err = s.db.Exec(context.Background(), "DELETE FROM order_events WHERE order_id = $1", s.testOrder.ID)
 	s.NoError(err)
 }
 
@@ -172,13 +216,14 @@ func (s *Suite) Test_CreateOrder() {
 		Coupon:          "",
 		PaymentMethod:   domain.Cash.String(),
 		DeliveryMethod:  domain.Pickup.String(),
-		DeliveryAddress: "TestAddress",
+		DeliveryAddress: testAddress,
 		DeliveryDate:    time.Now().Add(time.Hour).UTC(),
 		Items:           []domain.Item{{ProductID: uuid.New(), Quantity: 1}},
 	}
 
-	o, err := s.orderSvc.CreateOrder(context.Background(), info)
+	o, err := s.orderSvc.CreateOrder(s.userCtx(), info)
 	s.NoError(err)
+	defer s.deleteOrder(o.ID)
 
 	ro, err := s.repo.GetById(context.Background(), o.ID.String())
 	s.NoError(err)
@@ -188,7 +233,7 @@ func (s *Suite) Test_CreateOrder() {
 	s.Equal(o.Description, ro.Description)
 	s.Equal(o.Status.String(), ro.Status.String())
 	s.Equal(o.Currency.String(), ro.Currency.String())
-	s.Equal(o.TotalPrice, ro.TotalPrice)
+	s.Equal(money.New(9999, "USD"), ro.TotalPrice())
 	s.Equal(o.PaymentMethod.String(), ro.PaymentMethod.String())
 	s.Equal(o.DeliveryMethod.String(), ro.DeliveryMethod.String())
 	s.Equal(o.DeliveryAddress, ro.DeliveryAddress)
@@ -196,8 +241,208 @@ func (s *Suite) Test_CreateOrder() {
 	s.Equal(o.Items, ro.Items)
 }
 
+func (s *Suite) Test_CreateOrder_IdempotencyKey() {
+	info := dto.CreateOrderRequest{
+		Description:     "TestDescription",
+		Currency:        domain.USD.String(),
+		PaymentMethod:   domain.Cash.String(),
+		DeliveryMethod:  domain.Pickup.String(),
+		DeliveryAddress: testAddress,
+		DeliveryDate:    time.Now().Add(time.Hour).UTC(),
+		Items:           []domain.Item{{ProductID: uuid.New(), Quantity: 1}},
+		IdempotencyKey:  uuid.NewString(),
+	}
+	defer func() {
+		_, err := s.db.Exec(context.Background(), "DELETE FROM idempotency_keys WHERE key = $1", info.IdempotencyKey)
+		s.NoError(err)
+	}()
+
+	o, err := s.orderSvc.CreateOrder(s.userCtx(), info)
+	s.NoError(err)
+	defer s.deleteOrder(o.ID)
+
+	replayed, err := s.orderSvc.CreateOrder(s.userCtx(), info)
+	s.NoError(err)
+	s.Equal(o.ID, replayed.ID)
+
+	info.Description = "Another Description"
+	_, err = s.orderSvc.CreateOrder(s.userCtx(), info)
+	s.ErrorIs(err, domain.ErrIdempotencyKeyReused)
+}
+
+func (s *Suite) Test_CreateOrder_Coupon() {
+	coupons := service.NewCouponService(logger.MustInit(logger.LevelError, "order-test.log", "json", false), pg.NewCouponRepository(s.db))
+
+	code := "IT" + strings.ToUpper(uuid.NewString()[:8])
+	_, err := coupons.CreateCoupon(context.Background(), dto.CreateCouponRequest{
+		Code:           code,
+		Type:           domain.DiscountFixed.String(),
+		Amount:         1000,
+		Currency:       domain.USD.String(),
+		MaxRedemptions: 1,
+		ProductIDs:     []uuid.UUID{},
+		Categories:     []string{},
+		ValidFrom:      time.Now().Add(-time.Hour).UTC(),
+		ValidTo:        time.Now().Add(time.Hour).UTC(),
+	})
+	s.Require().NoError(err)
+	defer func() {
+		for _, q := range []string{
+			"DELETE FROM coupon_redemptions WHERE coupon_id = (SELECT id FROM coupons WHERE code = $1)",
+			"DELETE FROM coupons WHERE code = $1",
+		} {
+			_, err := s.db.Exec(context.Background(), q, code)
+			s.NoError(err)
+		}
+	}()
+
+	info := dto.CreateOrderRequest{
+		Description:     "TestDescription",
+		Currency:        domain.USD.String(),
+		Coupon:          code,
+		PaymentMethod:   domain.Cash.String(),
+		DeliveryMethod:  domain.Pickup.String(),
+		DeliveryAddress: testAddress,
+		DeliveryDate:    time.Now().Add(time.Hour).UTC(),
+		Items:           []domain.Item{{ProductID: uuid.New(), Quantity: 1}},
+	}
+
+	o, err := s.orderSvc.CreateOrder(s.userCtx(), info)
+	s.Require().NoError(err)
+	defer s.deleteOrder(o.ID)
+
+	ro, err := s.repo.GetById(context.Background(), o.ID.String())
+	s.NoError(err)
+	s.Equal(code, ro.Coupon)
+	s.Equal(int64(8999), ro.TotalPrice().Amount)
+
+	usage, err := coupons.GetCouponUsage(context.Background(), code, 10)
+	s.NoError(err)
+	s.Equal(uint64(1), usage.Coupon.Redemptions)
+	s.Equal(uint64(1), usage.UniqueUsers)
+	s.Len(usage.Recent, 1)
+
+	_, err = s.orderSvc.CreateOrder(s.userCtx(), info)
+	s.ErrorIs(err, domain.ErrCouponRedemptionLimit)
+}
+
+func (s *Suite) Test_Checkout() {
+	info := dto.CreateOrderRequest{
+		Description:     "TestDescription",
+		Currency:        domain.USD.String(),
+		PaymentMethod:   domain.Cash.String(),
+		DeliveryMethod:  domain.Pickup.String(),
+		DeliveryAddress: testAddress,
+		DeliveryDate:    time.Now().Add(time.Hour).UTC(),
+		Items:           []domain.Item{{ProductID: uuid.New(), Quantity: 1}},
+	}
+
+	o, err := s.orderSvc.CreateOrder(s.userCtx(), info)
+	s.Require().NoError(err)
+	defer s.deleteOrder(o.ID)
+
+	s.NoError(s.checkout.StockReserved(context.Background(), o.ID))
+	s.NoError(s.checkout.PaymentCompleted(context.Background(), o.ID))
+	// Redelivered event is ignored.
+	s.NoError(s.checkout.PaymentCompleted(context.Background(), o.ID))
+
+	ro, err := s.repo.GetById(context.Background(), o.ID.String())
+	s.NoError(err)
+	s.Equal(domain.OrderPaid, ro.Status)
+
+	var state string
+	s.NoError(s.db.QueryRow(context.Background(), "SELECT state FROM checkout_sagas WHERE order_id = $1", o.ID).Scan(&state))
+	s.Equal(string(domain.SagaCompleted), state)
+}
+
+func (s *Suite) Test_Checkout_StockRejected() {
+	info := dto.CreateOrderRequest{
+		Description:     "TestDescription",
+		Currency:        domain.USD.String(),
+		PaymentMethod:   domain.Cash.String(),
+		DeliveryMethod:  domain.Pickup.String(),
+		DeliveryAddress: testAddress,
+		DeliveryDate:    time.Now().Add(time.Hour).UTC(),
+		Items:           []domain.Item{{ProductID: uuid.New(), Quantity: 1}},
+	}
+
+	o, err := s.orderSvc.CreateOrder(s.userCtx(), info)
+	s.Require().NoError(err)
+	defer s.deleteOrder(o.ID)
+
+	s.NoError(s.checkout.StockRejected(context.Background(), o.ID))
+
+	ro, err := s.repo.GetById(context.Background(), o.ID.String())
+	s.NoError(err)
+	s.Equal(domain.OrderCancelled, ro.Status)
+}
+
+func (s *Suite) Test_ExpirePending() {
+	info := dto.CreateOrderRequest{
+		Description:     "TestDescription",
+		Currency:        domain.USD.String(),
+		PaymentMethod:   domain.Cash.String(),
+		DeliveryMethod:  domain.Pickup.String(),
+		DeliveryAddress: testAddress,
+		DeliveryDate:    time.Now().Add(time.Hour).UTC(),
+		Items:           []domain.Item{{ProductID: uuid.New(), Quantity: 1}},
+	}
+
+	o, err := s.orderSvc.CreateOrder(s.userCtx(), info)
+	s.Require().NoError(err)
+	defer s.deleteOrder(o.ID)
+	defer func() {
+		_, err := s.db.Exec(context.Background(), "DELETE FROM outbox WHERE payload->>'order_id' = $1", o.ID.String())
+		s.NoError(err)
+	}()
+
+	_, err = s.db.Exec(context.Background(), "UPDATE orders SET created_at = $1 WHERE id = $2", time.Now().Add(-2*time.Hour), o.ID)
+	s.Require().NoError(err)
+
+	expiry := service.NewExpiryService(logger.MustInit(logger.LevelError, "order-test.log", "json", false), s.repo, time.Hour)
+
+	// Replicas running concurrently expire order once.
+	var wg sync.WaitGroup
+	counts := make([]int, 2)
+	for i := range counts {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			counts[i], _ = expiry.ExpirePending(context.Background())
+		}()
+	}
+	wg.Wait()
+
+	var expired int
+	for _, n := range counts {
+		expired += n
+	}
+	s.GreaterOrEqual(expired, 1)
+
+	ro, err := s.repo.GetById(context.Background(), o.ID.String())
+	s.NoError(err)
+	s.Equal(domain.OrderCancelled, ro.Status)
+
+	var state string
+	s.NoError(s.db.QueryRow(context.Background(), "SELECT state FROM checkout_sagas WHERE order_id = $1", o.ID).Scan(&state))
+	s.Equal(string(domain.SagaCompensated), state)
+
+	rows, err := s.db.Query(context.Background(),
+		"SELECT event_type FROM outbox WHERE payload->>'order_id' = $1 AND event_type IN ('quantity-released', 'order-cancelled')", o.ID.String())
+	s.Require().NoError(err)
+	defer rows.Close()
+
+	var events []string
+	for rows.Next() {
+		var e string
+		s.NoError(rows.Scan(&e))
+		events = append(events, e)
+	}
+	s.ElementsMatch([]string{"quantity-released", "order-cancelled"}, events)
+}
+
 func (s *Suite) Test_CancelOrder() {
-	err := s.orderSvc.CancelOrder(context.Background(), s.testOrder.ID)
+	err := s.orderSvc.CancelOrder(s.userCtx(), s.testOrder.ID)
 	s.NoError(err)
 
 	ro, err := s.repo.GetById(context.Background(), s.testOrder.ID.String())
@@ -206,7 +451,11 @@ func (s *Suite) Test_CancelOrder() {
 }
 
 func (s *Suite) Test_CompleteOrder() {
-	err := s.orderSvc.CompleteOrder(context.Background(), s.testOrder.ID)
+	s.NoError(s.orderSvc.PayOrder(s.adminCtx(), s.testOrder.ID))
+	s.NoError(s.orderSvc.ShipOrder(s.adminCtx(), s.testOrder.ID))
+	s.NoError(s.orderSvc.DeliverOrder(s.adminCtx(), s.testOrder.ID))
+
+	err := s.orderSvc.CompleteOrder(s.userCtx(), s.testOrder.ID)
 	s.NoError(err)
 
 	ro, err := s.repo.GetById(context.Background(), s.testOrder.ID.String())
@@ -214,16 +463,141 @@ func (s *Suite) Test_CompleteOrder() {
 	s.Equal(domain.OrderCompleted, ro.Status)
 }
 
+func (s *Suite) Test_CompleteOrder_NotDelivered() {
+	err := s.orderSvc.CompleteOrder(s.userCtx(), s.testOrder.ID)
+	s.ErrorIs(err, domain.ErrInvalidTransition)
+
+	ro, err := s.repo.GetById(context.Background(), s.testOrder.ID.String())
+	s.NoError(err)
+	s.Equal(domain.OrderPending, ro.Status)
+}
+
+func (s *Suite) Test_CancelOrder_Shipped() {
+	s.NoError(s.orderSvc.PayOrder(s.adminCtx(), s.testOrder.ID))
+	s.NoError(s.orderSvc.ShipOrder(s.adminCtx(), s.testOrder.ID))
+
+	err := s.orderSvc.CancelOrder(s.userCtx(), s.testOrder.ID)
+	s.ErrorIs(err, domain.ErrInvalidTransition)
+}
+
+func (s *Suite) Test_CancelOrderItems_And_Returns() {
+	o := *s.testOrder
+	o.ID = uuid.New()
+	o.Status = domain.OrderPaid
+	o.Items = domain.Items{
+		domain.NewItem(uuid.New(), 3, "First Product", money.New(1000, "USD"), 0),
+		domain.NewItem(uuid.New(), 1, "Second Product", money.New(500, "USD"), 0),
+	}
+	s.Require().NoError(s.repo.Save(context.Background(), &o, nil))
+	defer s.deleteOrder(o.ID)
+	defer func() {
+		_, err := s.db.Exec(context.Background(), "DELETE FROM outbox WHERE payload->>'order_id' = $1", o.ID.String())
+		s.NoError(err)
+	}()
+
+	ro, err := s.orderSvc.CancelOrderItems(s.userCtx(), o.ID, []domain.Item{{ProductID: o.Items[0].ProductID, Quantity: 1}})
+	s.Require().NoError(err)
+	s.Equal(uint64(2), ro.Items[0].Quantity)
+	s.Equal(money.New(2500, "USD"), ro.TotalPrice())
+
+	ro, err = s.repo.GetById(context.Background(), o.ID.String())
+	s.Require().NoError(err)
+	s.Equal(uint64(2), ro.Items[0].Quantity)
+
+	s.NoError(s.orderSvc.ShipOrder(s.adminCtx(), o.ID))
+	s.NoError(s.orderSvc.DeliverOrder(s.adminCtx(), o.ID))
+
+	returns := service.NewReturnService(logger.MustInit(logger.LevelError, "order-test.log", "json", false),
+		s.repo, pg.NewReturnRepository(s.db))
+
+	ret, err := returns.RequestReturn(s.userCtx(), dto.RequestReturnRequest{
+		OrderID: o.ID,
+		Items:   []domain.Item{{ProductID: o.Items[0].ProductID, Quantity: 2}},
+		Reason:  "Not needed",
+	})
+	s.Require().NoError(err)
+	s.Equal(money.New(2000, "USD"), ret.Total())
+
+	// Both remaining units are already being returned.
+	_, err = returns.RequestReturn(s.userCtx(), dto.RequestReturnRequest{
+		OrderID: o.ID,
+		Items:   []domain.Item{{ProductID: o.Items[0].ProductID, Quantity: 1}},
+	})
+	s.ErrorIs(err, domain.ErrInvalidArgument)
+
+	for _, status := range []domain.ReturnStatus{domain.ReturnApproved, domain.ReturnReceived, domain.ReturnRefunded} {
+		ret, err = returns.UpdateReturnStatus(s.adminCtx(), ret.ID, status.String())
+		s.Require().NoError(err)
+	}
+
+	listed, err := returns.ListOrderReturns(s.userCtx(), o.ID)
+	s.Require().NoError(err)
+	s.Require().Len(listed, 1)
+	s.Equal(domain.ReturnRefunded, listed[0].Status)
+	s.Equal(ret.Items, listed[0].Items)
+
+	rows, err := s.db.Query(context.Background(),
+		"SELECT event_type FROM outbox WHERE payload->>'order_id' = $1 AND event_type IN ('items-released', 'items-returned', 'refund-requested')", o.ID.String())
+	s.Require().NoError(err)
+	defer rows.Close()
+
+	var events []string
+	for rows.Next() {
+		var e string
+		s.NoError(rows.Scan(&e))
+		events = append(events, e)
+	}
+	s.ElementsMatch([]string{"items-released", "refund-requested", "items-returned", "refund-requested"}, events)
+}
+
+func (s *Suite) Test_GetOrderHistory() {
+	paymentCtx := domain.ContextWithEventID(
+		domain.ContextWithPrincipal(context.Background(), domain.SystemPrincipal()),
+		"payment-event-1",
+	)
+	s.NoError(s.orderSvc.PayOrder(paymentCtx, s.testOrder.ID))
+
+	newAddress := testAddress
+	newAddress.Street = "New Street"
+	_, err := s.orderSvc.UpdateOrder(s.adminCtx(), dto.UpdateOrderRequest{
+		OrderID:         s.testOrder.ID,
+		DeliveryAddress: &newAddress,
+	})
+	s.NoError(err)
+
+	s.NoError(s.orderSvc.CancelOrder(s.userCtx(), s.testOrder.ID))
+
+	entries, err := s.orderSvc.GetOrderHistory(s.userCtx(), s.testOrder.ID)
+	s.Require().NoError(err)
+	s.Require().Len(entries, 3)
+
+	s.Equal(domain.ChangeStatusChanged, entries[0].Type)
+	s.Equal(domain.Actor{Type: domain.ActorSystem, ID: "payment-event-1"}, entries[0].Actor)
+	s.Equal([]domain.FieldChange{{Field: "status", From: "pending", To: "paid"}}, entries[0].Diff)
+
+	s.Equal(domain.ChangeUpdated, entries[1].Type)
+	s.Equal(domain.ActorAdmin, entries[1].Actor.Type)
+	s.Equal([]domain.FieldChange{{Field: "delivery_address", From: s.testOrder.DeliveryAddress.String(), To: newAddress.String()}}, entries[1].Diff)
+
+	s.Equal(domain.ChangeStatusChanged, entries[2].Type)
+	s.Equal(domain.Actor{Type: domain.ActorUser, ID: s.testOrder.UserID.String()}, entries[2].Actor)
+	s.Equal([]domain.FieldChange{{Field: "status", From: "paid", To: "cancelled"}}, entries[2].Diff)
+
+	_, err = s.orderSvc.GetOrderHistory(domain.ContextWithPrincipal(context.Background(), domain.Principal{
+		UserID: uuid.New(),
+		Roles:  []domain.Role{domain.RoleUser},
+	}), s.testOrder.ID)
+	s.ErrorIs(err, domain.ErrPermissionDenied)
+}
+
 func (s *Suite) Test_UpdateOrder() {
 	s.testOrder.Description = "Updated Description"
-	s.testOrder.DeliveryAddress = "Updated Address"
+	s.testOrder.DeliveryAddress.Street = "Updated Street"
 	s.testOrder.DeliveryDate = time.Now().UTC().Add(time.Hour)
 
-	o, err := s.orderSvc.UpdateOrder(context.Background(), dto.UpdateOrderRequest{
+	o, err := s.orderSvc.UpdateOrder(s.userCtx(), dto.UpdateOrderRequest{
 		OrderID:         s.testOrder.ID,
 		Description:     &s.testOrder.Description,
-		Status:          ToPtr(s.testOrder.Status.String()),
-		TotalPrice:      &s.testOrder.TotalPrice,
 		PaymentMethod:   ToPtr(s.testOrder.PaymentMethod.String()),
 		DeliveryMethod:  ToPtr(s.testOrder.DeliveryMethod.String()),
 		DeliveryAddress: &s.testOrder.DeliveryAddress,
@@ -241,7 +615,7 @@ func (s *Suite) Test_UpdateOrder() {
 	s.Equal(s.testOrder.DeliveryDate.Unix(), ro.DeliveryDate.Unix())
 	s.Equal(s.testOrder.Status.String(), ro.Status.String())
 	s.Equal(s.testOrder.Currency.String(), ro.Currency.String())
-	s.Equal(s.testOrder.TotalPrice, ro.TotalPrice)
+	s.Equal(s.testOrder.TotalPrice(), ro.TotalPrice())
 	s.Equal(s.testOrder.PaymentMethod.String(), ro.PaymentMethod.String())
 	s.Equal(s.testOrder.DeliveryMethod.String(), ro.DeliveryMethod.String())
 	s.Equal(s.testOrder.Items, ro.Items)
@@ -249,36 +623,166 @@ func (s *Suite) Test_UpdateOrder() {
 	s.Equal(s.testOrder.UpdatedAt.Unix(), ro.UpdatedAt.Unix())
 }
 
-func (s *Suite) Test_DeleteOrder() {
-	o := &domain.Order{
-		ID:              uuid.New(),
-		UserID:          uuid.UUID{},
-		Description:     "",
-		Status:          "",
-		Currency:        "",
-		TotalPrice:      0,
-		PaymentMethod:   "",
-		DeliveryMethod:  "",
-		DeliveryAddress: "",
-		DeliveryDate:    time.Time{},
-		Items:           nil,
-		CreatedAt:       time.Time{},
-		UpdatedAt:       time.Time{},
+// Test_UpdateOrder_Conflict saves two copies of one order, the one read before the other was saved is refused.
+func (s *Suite) Test_UpdateOrder_Conflict() {
+	mine, err := s.repo.GetById(context.Background(), s.testOrder.ID.String())
+	s.Require().NoError(err)
+	theirs, err := s.repo.GetById(context.Background(), s.testOrder.ID.String())
+	s.Require().NoError(err)
+
+	theirs.Description = "Theirs"
+	s.Require().NoError(s.repo.Update(context.Background(), theirs, nil))
+	s.Equal(mine.Version+1, theirs.Version)
+
+	mine.Description = "Mine"
+	s.ErrorIs(s.repo.Update(context.Background(), mine, nil), domain.ErrOrderConflict)
+
+	ro, err := s.repo.GetById(context.Background(), s.testOrder.ID.String())
+	s.Require().NoError(err)
+	s.Equal("Theirs", ro.Description)
+	s.Equal(theirs.Version, ro.Version)
+
+	stale := mine.Version
+	_, err = s.orderSvc.UpdateOrder(s.userCtx(), dto.UpdateOrderRequest{OrderID: s.testOrder.ID, Description: &mine.Description, Version: &stale})
+	s.ErrorIs(err, domain.ErrOrderVersionMismatch)
+}
+
+func (s *Suite) Test_UpdateOrder_Items() {
+	o, err := s.repo.GetById(context.Background(), s.testOrder.ID.String())
+	s.Require().NoError(err)
+	defer func() {
+		_, err := s.db.Exec(context.Background(), "DELETE FROM outbox WHERE payload->>'order_id' = $1", o.ID.String())
+		s.NoError(err)
+	}()
+
+	before := o.Clone()
+	added := domain.NewItem(uuid.New(), 2, "Added Product", money.New(500, "USD"), 0)
+	o.Items = append(o.Items[1:], added)
+
+	change, err := domain.NewStockChange(o.ID, before.Items, o.Items)
+	s.Require().NoError(err)
+	s.Require().NoError(s.repo.UpdateItems(context.Background(), o, change, domain.NewHistoryEntry(s.userCtx(), before, o)))
+
+	ro, err := s.repo.GetById(context.Background(), o.ID.String())
+	s.Require().NoError(err)
+	s.Equal(o.Items, ro.Items)
+
+	rows, err := s.db.Query(context.Background(),
+		"SELECT event_type, payload->>'adjustment_id' FROM outbox WHERE payload->>'order_id' = $1 AND event_type IN ('items-locked', 'items-released')", o.ID.String())
+	s.Require().NoError(err)
+	defer rows.Close()
+
+	events := map[string]string{}
+	for rows.Next() {
+		var e, id string
+		s.NoError(rows.Scan(&e, &id))
+		events[e] = id
 	}
+	s.Equal(map[string]string{"items-locked": change.LockID.String(), "items-released": change.ReleaseID.String()}, events)
+
+	locked, err := s.repo.TakeStockLock(context.Background(), o.ID.String(), change.LockID.String())
+	s.Require().NoError(err)
+	s.Equal(domain.Items{{ProductID: added.ProductID, Quantity: added.Quantity}}, locked)
 
-	err := s.repo.Save(context.TODO(), o)
+	_, err = s.repo.TakeStockLock(context.Background(), o.ID.String(), change.LockID.String())
+	s.ErrorIs(err, domain.ErrStockLockNotFound)
+}
+
+func (s *Suite) Test_DeleteOrder() {
+	s.NoError(s.orderSvc.CancelOrder(s.userCtx(), s.testOrder.ID))
+
+	err := s.orderSvc.DeleteOrder(s.userCtx(), s.testOrder.ID)
 	s.NoError(err)
 
-	err = s.orderSvc.DeleteOrder(context.TODO(), o.ID)
+	// Owner no longer sees archived order, staff still does.
+	_, err = s.orderSvc.GetById(s.userCtx(), s.testOrder.ID)
+	s.ErrorIs(err, domain.ErrOrderNotFound)
+
+	o, err := s.orderSvc.GetById(s.adminCtx(), s.testOrder.ID)
 	s.NoError(err)
+	s.False(o.DeletedAt.IsZero())
+	s.Equal(s.testOrder.UserID, o.DeletedBy)
 
-	_, err = s.orderSvc.GetById(context.TODO(), o.ID)
+	page, err := s.orderSvc.SearchOrders(s.adminCtx(), searchFilters())
+	s.NoError(err)
+	s.Empty(page.Orders)
 
+	filters := searchFilters()
+	filters["includeDeleted"] = true
+	page, err = s.orderSvc.SearchOrders(s.adminCtx(), filters)
+	s.NoError(err)
+	s.Len(page.Orders, 1)
+
+	_, err = s.orderSvc.SearchOrders(s.userCtx(), filters)
+	s.ErrorIs(err, domain.ErrPermissionDenied)
+
+	restored, err := s.orderSvc.RestoreOrder(s.adminCtx(), s.testOrder.ID)
+	s.NoError(err)
+	s.True(restored.DeletedAt.IsZero())
+
+	_, err = s.orderSvc.GetById(s.userCtx(), s.testOrder.ID)
+	s.NoError(err)
+
+	history, err := s.orderSvc.GetOrderHistory(s.adminCtx(), s.testOrder.ID)
+	s.NoError(err)
+	s.Require().GreaterOrEqual(len(history), 2)
+	s.Equal(domain.ChangeDeleted, history[len(history)-2].Type)
+	s.Equal(domain.ChangeRestored, history[len(history)-1].Type)
+}
+
+func (s *Suite) Test_DeleteOrder_NotSettled() {
+	err := s.orderSvc.DeleteOrder(s.userCtx(), s.testOrder.ID)
+	s.ErrorIs(err, domain.ErrOrderNotArchivable)
+
+	// Cancelled order whose checkout still releases stock stays until compensation is sent.
+	s.NoError(s.orderSvc.CancelOrder(s.userCtx(), s.testOrder.ID))
+	_, err = s.db.Exec(context.Background(), "INSERT INTO checkout_sagas (order_id, state, created_at, updated_at) VALUES ($1, $2, NOW(), NOW())",
+		s.testOrder.ID, domain.SagaCompensating)
+	s.NoError(err)
+	defer s.deleteOrder(s.testOrder.ID)
+
+	err = s.orderSvc.DeleteOrder(s.userCtx(), s.testOrder.ID)
+	s.ErrorIs(err, domain.ErrOrderNotArchivable)
+
+	o, err := s.repo.GetById(context.Background(), s.testOrder.ID.String())
+	s.NoError(err)
+	s.False(o.IsDeleted())
+}
+
+func (s *Suite) Test_EraseArchived() {
+	s.NoError(s.orderSvc.CancelOrder(s.userCtx(), s.testOrder.ID))
+	s.NoError(s.orderSvc.DeleteOrder(s.userCtx(), s.testOrder.ID))
+
+	// Not archived long enough.
+	n, err := s.repo.AnonymizeArchived(context.Background(), time.Now().Add(-time.Hour), 10)
+	s.NoError(err)
+	s.Zero(n)
+
+	n, err = s.repo.AnonymizeArchived(context.Background(), time.Now().Add(time.Minute), 10)
+	s.NoError(err)
+	s.EqualValues(1, n)
+
+	o, err := s.repo.GetById(context.Background(), s.testOrder.ID.String())
+	s.NoError(err)
+	s.Equal(uuid.Nil, o.UserID)
+	s.Empty(o.Description)
+	s.Empty(o.DeliveryAddress.Street)
+	s.Equal(s.testOrder.DeliveryAddress.Country, o.DeliveryAddress.Country)
+	s.False(o.AnonymizedAt.IsZero())
+
+	_, err = s.orderSvc.RestoreOrder(s.adminCtx(), s.testOrder.ID)
+	s.ErrorIs(err, domain.ErrOrderNotRestorable)
+
+	n, err = s.repo.PurgeArchived(context.Background(), time.Now().Add(time.Minute), 10)
+	s.NoError(err)
+	s.EqualValues(1, n)
+
+	_, err = s.repo.GetById(context.Background(), s.testOrder.ID.String())
 	s.ErrorIs(err, domain.ErrOrderNotFound)
 }
 
 func (s *Suite) Test_GetById() {
-	o, err := s.orderSvc.GetById(context.TODO(), s.testOrder.ID)
+	o, err := s.orderSvc.GetById(s.userCtx(), s.testOrder.ID)
 
 	s.NoError(err)
 	s.Equal(s.testOrder.ID.String(), o.ID.String())
@@ -287,7 +791,7 @@ func (s *Suite) Test_GetById() {
 	s.Equal(s.testOrder.DeliveryDate.Unix(), o.DeliveryDate.Unix())
 	s.Equal(s.testOrder.Status.String(), o.Status.String())
 	s.Equal(s.testOrder.Currency.String(), o.Currency.String())
-	s.Equal(s.testOrder.TotalPrice, o.TotalPrice)
+	s.Equal(s.testOrder.TotalPrice(), o.TotalPrice())
 	s.Equal(s.testOrder.PaymentMethod.String(), o.PaymentMethod.String())
 	s.Equal(s.testOrder.DeliveryMethod.String(), o.DeliveryMethod.String())
 	s.Equal(s.testOrder.Items, o.Items)
@@ -295,11 +799,289 @@ func (s *Suite) Test_GetById() {
 	s.Equal(s.testOrder.UpdatedAt.Unix(), o.UpdatedAt.Unix())
 }
 
-// TODO Когда добавлю логику получения айди пользователя из контекста
-func (s *Suite) Test_ListByUser() {}
+func (s *Suite) Test_GetById_OtherUser() {
+	ctx := domain.ContextWithPrincipal(context.Background(), domain.Principal{
+		UserID: uuid.New(),
+		Roles:  []domain.Role{domain.RoleUser},
+	})
+
+	_, err := s.orderSvc.GetById(ctx, s.testOrder.ID)
+
+	s.ErrorIs(err, domain.ErrPermissionDenied)
+}
+
+func (s *Suite) Test_GetById_Unauthenticated() {
+	_, err := s.orderSvc.GetById(context.Background(), s.testOrder.ID)
+
+	s.ErrorIs(err, domain.ErrUnauthenticated)
+}
+
+func (s *Suite) Test_ListByUser() {
+	page, err := s.orderSvc.ListByUser(s.userCtx(), map[string]any{"withTotal": true})
+	s.NoError(err)
+
+	s.Len(page.Orders, 1)
+	s.Equal(s.testOrder.ID.String(), page.Orders[0].ID.String())
+	s.Empty(page.NextPageToken)
+	s.Equal(uint64(1), *page.Total)
+}
 
 func (s *Suite) Test_SearchOrders() {
+	page, err := s.orderSvc.SearchOrders(s.userCtx(), searchFilters())
+	s.NoError(err)
+	s.Len(page.Orders, 1)
+
+	// Other users must not see orders they do not own.
+	otherCtx := domain.ContextWithPrincipal(context.Background(), domain.Principal{
+		UserID: uuid.New(),
+		Roles:  []domain.Role{domain.RoleUser},
+	})
+
+	page, err = s.orderSvc.SearchOrders(otherCtx, searchFilters())
+	s.NoError(err)
+	s.Empty(page.Orders)
+
+	page, err = s.orderSvc.SearchOrders(s.adminCtx(), searchFilters())
+	s.NoError(err)
+	s.Len(page.Orders, 1)
+}
+
+func (s *Suite) Test_SearchOrders_ByItem() {
+	item := s.testOrder.Items[0]
+
+	filters := searchFilters()
+	filters["productId"] = &item.ProductID
+	filters["minItemQuantity"] = &item.Quantity
+	page, err := s.orderSvc.SearchOrders(s.adminCtx(), filters)
+	s.NoError(err)
+	s.Len(page.Orders, 1)
+	s.Equal(s.testOrder.Items, page.Orders[0].Items)
+
+	more := item.Quantity + 1
+	filters["minItemQuantity"] = &more
+	page, err = s.orderSvc.SearchOrders(s.adminCtx(), filters)
+	s.NoError(err)
+	s.Empty(page.Orders)
+
+	otherProduct := uuid.New()
+	filters = searchFilters()
+	filters["productId"] = &otherProduct
+	page, err = s.orderSvc.SearchOrders(s.adminCtx(), filters)
+	s.NoError(err)
+	s.Empty(page.Orders)
+}
+
+// Test_SearchOrders_FullText matches words of description and address regardless of case,
+// description matches rank higher.
+func (s *Suite) Test_SearchOrders_FullText() {
+	userId := uuid.New()
+	ctx := domain.ContextWithPrincipal(context.Background(), domain.Principal{
+		UserID: userId,
+		Roles:  []domain.Role{domain.RoleUser},
+	})
+
+	save := func(description, street string) uuid.UUID {
+		o := s.testOrder.Clone()
+		o.ID = uuid.New()
+		o.UserID = userId
+		o.Description = description
+		o.DeliveryAddress.Street = street
+		s.NoError(s.repo.Save(context.Background(), o, nil))
+		s.T().Cleanup(func() { s.deleteOrder(o.ID) })
+		return o.ID
+	}
+
+	inDescription := save("Birthday gift for mom", "Main street 1")
+	inAddress := save("Books", "Gift shop lane 5")
+	express := save("Gift wrapping express", "Main street 2")
+
+	search := func(query string) []uuid.UUID {
+		filters := searchFilters()
+		filters["query"] = &query
+		page, err := s.orderSvc.SearchOrders(ctx, filters)
+		s.Require().NoError(err)
+
+		var ids []uuid.UUID
+		for _, o := range page.Orders {
+			ids = append(ids, o.ID)
+		}
+		return ids
+	}
+
+	found := search("GIFT")
+	s.Require().Len(found, 3)
+	s.Equal(inAddress, found[2])
+
+	s.ElementsMatch([]uuid.UUID{inDescription, inAddress}, search("gift -express"))
+	s.Equal([]uuid.UUID{express}, search(`"gift wrapping"`))
+
+	// Enum columns are not part of the text, they have exact filters of their own.
+	s.Empty(search("pending"))
+}
+
+// Test_SearchOrders_Address filters orders by parts of delivery address.
+func (s *Suite) Test_SearchOrders_Address() {
+	userId := uuid.New()
+	ctx := domain.ContextWithPrincipal(context.Background(), domain.Principal{
+		UserID: userId,
+		Roles:  []domain.Role{domain.RoleUser},
+	})
 
+	save := func(country, city, postalCode string) uuid.UUID {
+		o := s.testOrder.Clone()
+		o.ID = uuid.New()
+		o.UserID = userId
+		o.DeliveryAddress.Country = country
+		o.DeliveryAddress.City = city
+		o.DeliveryAddress.PostalCode = postalCode
+		s.NoError(s.repo.Save(context.Background(), o, nil))
+		s.T().Cleanup(func() { s.deleteOrder(o.ID) })
+		return o.ID
+	}
+
+	newYork := save("US", "New York", "10001")
+	boston := save("US", "Boston", "02108")
+	moscow := save("RU", "Moscow", "101000")
+
+	search := func(key, value string) []uuid.UUID {
+		filters := searchFilters()
+		filters[key] = &value
+		page, err := s.orderSvc.SearchOrders(ctx, filters)
+		s.Require().NoError(err)
+
+		var ids []uuid.UUID
+		for _, o := range page.Orders {
+			ids = append(ids, o.ID)
+		}
+		return ids
+	}
+
+	s.ElementsMatch([]uuid.UUID{newYork, boston}, search("deliveryCountry", "us"))
+	s.Equal([]uuid.UUID{boston}, search("deliveryCity", "BOSTON"))
+	s.Equal([]uuid.UUID{moscow}, search("deliveryPostalCode", "101000"))
+}
+
+// Test_SearchOrders_Pages walks user orders page by page in every sort, no order is skipped or repeated.
+func (s *Suite) Test_SearchOrders_Pages() {
+	userId := uuid.New()
+	ctx := domain.ContextWithPrincipal(context.Background(), domain.Principal{
+		UserID: userId,
+		Roles:  []domain.Role{domain.RoleUser},
+	})
+
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	want := map[uuid.UUID]bool{}
+	for i := range 5 {
+		o := s.testOrder.Clone()
+		o.ID = uuid.New()
+		o.UserID = userId
+		o.Items[0].Quantity = uint64(1 + i%2) // Equal totals, so id breaks ties.
+		o.DeliveryDate = now.Add(time.Duration(i%3) * time.Hour)
+		o.CreatedAt = now
+		o.UpdatedAt = now
+		s.NoError(s.repo.Save(context.Background(), o, nil))
+		want[o.ID] = true
+
+		s.T().Cleanup(func() { s.deleteOrder(o.ID) })
+	}
+
+	limit := uint64(2)
+	for _, sortBy := range []string{"created_at", "total_price", "delivery_date"} {
+		for _, sortOrder := range []string{"asc", "desc"} {
+			filters := searchFilters()
+			filters["limit"] = &limit
+			filters["sortBy"] = &sortBy
+			filters["sortOrder"] = &sortOrder
+			filters["withTotal"] = true
+
+			seen := map[uuid.UUID]bool{}
+			for pages := 0; ; pages++ {
+				s.Less(pages, 3, "%s %s", sortBy, sortOrder)
+
+				page, err := s.orderSvc.SearchOrders(ctx, filters)
+				s.Require().NoError(err)
+				s.Equal(uint64(len(want)), *page.Total)
+
+				for _, o := range page.Orders {
+					s.False(seen[o.ID], "%s %s: order repeated", sortBy, sortOrder)
+					seen[o.ID] = true
+				}
+
+				if page.NextPageToken == "" {
+					break
+				}
+				filters["pageToken"] = page.NextPageToken
+			}
+
+			s.Equal(want, seen, "%s %s", sortBy, sortOrder)
+		}
+	}
+
+	// Token of one sort can't be used with another.
+	filters := searchFilters()
+	filters["limit"] = &limit
+	page, err := s.orderSvc.SearchOrders(ctx, filters)
+	s.Require().NoError(err)
+
+	asc := "asc"
+	filters["sortOrder"] = &asc
+	filters["pageToken"] = page.NextPageToken
+	_, err = s.orderSvc.SearchOrders(ctx, filters)
+	s.ErrorIs(err, domain.ErrInvalidArgument)
+}
+
+// searchFilters returns filters map with all keys present, as handler passes it.
+func searchFilters() map[string]any {
+	return map[string]any{
+		"limit":              (*uint64)(nil),
+		"offset":             (*uint64)(nil),
+		"query":              (*string)(nil),
+		"description":        (*string)(nil),
+		"status":             (*string)(nil),
+		"currency":           (*string)(nil),
+		"minPrice":           (*int64)(nil),
+		"maxPrice":           (*int64)(nil),
+		"deliveryMethod":     (*string)(nil),
+		"paymentMethod":      (*string)(nil),
+		"deliveryCountry":    (*string)(nil),
+		"deliveryCity":       (*string)(nil),
+		"deliveryPostalCode": (*string)(nil),
+		"deliveryDateFrom":   time.Time{},
+		"deliveryDateTo":     time.Time{},
+		"minItemsAmount":     (*uint64)(nil),
+		"maxItemsAmount":     (*uint64)(nil),
+		"productId":          (*uuid.UUID)(nil),
+		"minItemQuantity":    (*uint64)(nil),
+		"maxItemQuantity":    (*uint64)(nil),
+		"includeDeleted":     false,
+	}
+}
+
+// deleteOrder removes order created through service along with its checkout saga.
+func (s *Suite) deleteOrder(id uuid.UUID) {
+	for _, q := range []string{
+		"DELETE FROM orders WHERE id = $1",
+		"DELETE FROM order_events WHERE order_id = $1",
+		"DELETE FROM checkout_sagas WHERE order_id = $1",
+	} {
+		_, err := s.db.Exec(context.Background(), q, id)
+		s.NoError(err)
+	}
+}
+
+// userCtx returns context authenticated as owner of test order.
+func (s *Suite) userCtx() context.Context {
+	return domain.ContextWithPrincipal(context.Background(), domain.Principal{
+		UserID: s.testOrder.UserID,
+		Roles:  []domain.Role{domain.RoleUser},
+	})
+}
+
+func (s *Suite) adminCtx() context.Context {
+	return domain.ContextWithPrincipal(context.Background(), domain.Principal{
+		UserID: uuid.New(),
+		Roles:  []domain.Role{domain.RoleAdmin},
+	})
 }
 
 func ToPtr[T any](val T) *T {
@@ -12,10 +12,12 @@ import (
 	"github.com/dzhordano/ecom-thing/services/payment/internal/application/service"
 	"github.com/dzhordano/ecom-thing/services/payment/internal/config"
 	"github.com/dzhordano/ecom-thing/services/payment/internal/infrastructure/billing"
+	"github.com/dzhordano/ecom-thing/services/payment/internal/infrastructure/idempotency"
 	"github.com/dzhordano/ecom-thing/services/payment/internal/infrastructure/kafka"
 	"github.com/dzhordano/ecom-thing/services/payment/internal/infrastructure/outbox"
 	"github.com/dzhordano/ecom-thing/services/payment/internal/infrastructure/repository/pg"
 	grpc_server "github.com/dzhordano/ecom-thing/services/payment/internal/interfaces/grpc_server"
+	"github.com/dzhordano/ecom-thing/services/payment/internal/interfaces/grpc_server/interceptors"
 	"github.com/dzhordano/ecom-thing/services/payment/pkg/logger"
 )
 
@@ -41,7 +43,11 @@ func main() {
 
 	billingSvc := billing.NewStubBilling()
 
-	svc := service.NewPaymerService(log, repo)
+	keys := pg.NewIdempotencyRepository(db)
+	keysCleaner := idempotency.NewCleaner(log, keys, cfg.Idempotency.CleanupInterval)
+	go keysCleaner.Start(ctx)
+
+	svc := service.NewPaymerService(log, repo, keys, cfg.Idempotency.KeyTTL)
 
 	wg.Add(1)
 	tp, err := tracer.NewTracerProvider(cfg.Tracing.URL, "payment")
@@ -94,11 +100,27 @@ func main() {
 	q := make(chan os.Signal, 1)
 	signal.Notify(q, syscall.SIGTERM, syscall.SIGINT, os.Interrupt)
 
+	var auth *interceptors.Authenticator
+	if cfg.Auth.JWKSFile != "" {
+		auth, err = interceptors.NewJWKSAuthenticator(cfg.Auth.JWKSFile)
+		if err != nil {
+			log.Error("error creating jwks authenticator", "error", err)
+			return
+		}
+	} else {
+		auth, err = interceptors.NewHMACAuthenticator([]byte(cfg.Auth.HMACSecret))
+		if err != nil {
+			log.Error("error creating hmac authenticator", "error", err)
+			return
+		}
+	}
+
 	srv := grpc_server.MustNew(
 		log,
 		grpc_server.NewPaymentHandler(svc),
 		grpc_server.WithAddr(cfg.GRPC.Addr()),
 		grpc_server.WithTracerProvider(tp),
+		grpc_server.WithAuthenticator(auth),
 	)
 
 	go func() {
@@ -1,14 +1,19 @@
 package dto
 
-import "github.com/google/uuid"
+import (
+	"github.com/dzhordano/ecom-thing/services/payment/pkg/money"
+	"github.com/google/uuid"
+)
 
 type CreatePaymentRequest struct {
 	OrderId    uuid.UUID
 	UserId     uuid.UUID
-	Currency   string
-	TotalPrice float64
+	TotalPrice money.Money // Currency of payment is currency of total price.
 
 	PaymentMethod string
 	Description   string
 	RedirectURL   string // TODO нужен ли?
+
+	// IdempotencyKey is optional, request repeated with the same key creates no new payment.
+	IdempotencyKey string
 }
@@ -0,0 +1,15 @@
+package dto
+
+import (
+	"github.com/dzhordano/ecom-thing/services/payment/pkg/money"
+	"github.com/google/uuid"
+)
+
+type RefundPaymentRequest struct {
+	// RefundId is chosen by requester, request repeated with the same id refunds nothing more.
+	RefundId uuid.UUID
+	OrderId  uuid.UUID
+	UserId   uuid.UUID
+	Amount   money.Money // Has to be in currency of payment.
+	Reason   string
+}
@@ -5,12 +5,15 @@ import (
 
 	"github.com/dzhordano/ecom-thing/services/payment/internal/application/dto"
 	"github.com/dzhordano/ecom-thing/services/payment/internal/domain"
+	"github.com/dzhordano/ecom-thing/services/payment/pkg/money"
 	"github.com/google/uuid"
 )
 
 type Billing interface {
 	// NewPayment handles process of payment. Is BLOCKING (supposedly) operation.
-	NewPayment(ctx context.Context, currency string, totalPrice float64, paymentDescription string) error
+	NewPayment(ctx context.Context, totalPrice money.Money, paymentDescription string) error
+	// Refund returns amount of completed payment. Is BLOCKING (supposedly) operation too.
+	Refund(ctx context.Context, amount money.Money, reason string) error
 }
 
 type PaymentService interface {
@@ -19,5 +22,10 @@ type PaymentService interface {
 	RetryPayment(ctx context.Context, paymentId, userId uuid.UUID) error
 	// TODO Тут понять юзкейсы
 	CancelPayment(ctx context.Context, paymentId, userId uuid.UUID) error
+	// CancelOrderPayment cancels pending payment of order. It is called when order checkout is aborted.
+	CancelOrderPayment(ctx context.Context, orderId uuid.UUID) error
+	// RefundPayment returns money of completed order payment. It is called when paid order is cancelled
+	// (in part or in whole) or its items are returned.
+	RefundPayment(ctx context.Context, req dto.RefundPaymentRequest) (*domain.Refund, error)
 	ConfirmPayment(ctx context.Context, paymentId, userId uuid.UUID) error
 }
@@ -0,0 +1,82 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+
+	"github.com/dzhordano/ecom-thing/services/payment/internal/domain"
+	"github.com/google/uuid"
+)
+
+// Operations idempotency keys are scoped to.
+const (
+	idempotentCreatePayment = "create_payment"
+)
+
+// requestHash fingerprints request payload. Request must not contain the key itself.
+func requestHash(req any) (string, error) {
+	b, err := json.Marshal(req)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// acquireKey reserves idempotency key for request. If the key was already used, stored key is returned:
+// it is completed one whose result should be replayed, otherwise an error is.
+func (p *PaymentService) acquireKey(ctx context.Context, rawKey string, userId uuid.UUID, operation string, req any) (key, stored *domain.IdempotencyKey, err error) {
+	hash, err := requestHash(req)
+	if err != nil {
+		p.log.Error("failed to hash request", "error", err, "operation", operation)
+		return nil, nil, domain.NewAppError(err, "failed to hash request")
+	}
+
+	key, err = domain.NewIdempotencyKey(rawKey, userId, operation, hash, p.now(), p.keyTTL)
+	if err != nil {
+		p.log.Error("failed to acquire idempotency key", "error", err, "operation", operation)
+		return nil, nil, domain.NewAppError(err, err.Error())
+	}
+
+	stored, err = p.keys.Acquire(ctx, key)
+	if err != nil {
+		p.log.Error("failed to acquire idempotency key", "error", err, "operation", operation)
+		return nil, nil, domain.NewAppError(err, "failed to acquire idempotency key")
+	}
+
+	if stored == nil {
+		return key, nil, nil
+	}
+
+	if stored.RequestHash != hash {
+		p.log.Error("failed to acquire idempotency key", "error", domain.ErrIdempotencyKeyReused, "operation", operation)
+		return nil, nil, domain.NewAppError(domain.ErrIdempotencyKeyReused, "idempotency key was used with another request")
+	}
+
+	if !stored.IsCompleted() {
+		p.log.Error("failed to acquire idempotency key", "error", domain.ErrRequestInProgress, "operation", operation)
+		return nil, nil, domain.NewAppError(domain.ErrRequestInProgress, "request with this idempotency key is in progress")
+	}
+
+	return key, stored, nil
+}
+
+// releaseKey lets request that failed be retried with the same key.
+func (p *PaymentService) releaseKey(ctx context.Context, key *domain.IdempotencyKey) {
+	if err := p.keys.Release(ctx, key); err != nil {
+		p.log.Error("failed to release idempotency key", "error", err, "operation", key.Operation)
+	}
+}
+
+// completeKey remembers resource created by request.
+func (p *PaymentService) completeKey(ctx context.Context, key *domain.IdempotencyKey, resourceId string) {
+	key.Complete(resourceId)
+
+	// Resource is already created, so failure is only logged. Retries are rejected as in progress until key expires.
+	if err := p.keys.Complete(ctx, key); err != nil {
+		p.log.Error("failed to complete idempotency key", "error", err, "operation", key.Operation, "resource_id", resourceId)
+	}
+}
@@ -2,33 +2,81 @@ package service
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	"github.com/dzhordano/ecom-thing/services/payment/internal/application/dto"
 	"github.com/dzhordano/ecom-thing/services/payment/internal/application/interfaces"
 	"github.com/dzhordano/ecom-thing/services/payment/internal/domain"
 	"github.com/dzhordano/ecom-thing/services/payment/internal/domain/repository"
 	"github.com/dzhordano/ecom-thing/services/payment/pkg/logger"
+	"github.com/dzhordano/ecom-thing/services/payment/pkg/money"
 	"github.com/google/uuid"
 )
 
 type PaymentService struct {
 	log  logger.Logger
 	repo repository.PaymentRepository
+	keys repository.IdempotencyRepository
+	// How long idempotency key is remembered.
+	keyTTL time.Duration
+	now    func() time.Time
 }
 
-func NewPaymerService(log logger.Logger, repo repository.PaymentRepository) interfaces.PaymentService {
+func NewPaymerService(log logger.Logger, repo repository.PaymentRepository, keys repository.IdempotencyRepository,
+	keyTTL time.Duration) interfaces.PaymentService {
 	return &PaymentService{
-		log:  log,
-		repo: repo,
+		log:    log,
+		repo:   repo,
+		keys:   keys,
+		keyTTL: keyTTL,
+		now:    func() time.Time { return time.Now().UTC() },
 	}
 }
 
 // CreatePayment implements interfaces.PaymentService.
+//
+// With idempotency key, repeated request returns payment created by the first one.
 func (p *PaymentService) CreatePayment(ctx context.Context, req dto.CreatePaymentRequest) (*domain.Payment, error) {
+	if req.IdempotencyKey == "" {
+		return p.createPayment(ctx, req)
+	}
+
+	payload := req
+	payload.IdempotencyKey = ""
+
+	key, stored, err := p.acquireKey(ctx, req.IdempotencyKey, req.UserId, idempotentCreatePayment, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	if stored != nil {
+		payment, err := p.repo.GetById(ctx, stored.ResourceID, req.UserId.String())
+		if err != nil {
+			p.log.Error("create payment error", "error", err, "payment_id", stored.ResourceID)
+			return nil, domain.NewAppError(err, "failed to get payment")
+		}
+
+		p.log.Debug("create payment replayed", "payment_id", payment.ID.String())
+
+		return payment, nil
+	}
+
+	payment, err := p.createPayment(ctx, req)
+	if err != nil {
+		p.releaseKey(ctx, key)
+		return nil, err
+	}
+
+	p.completeKey(ctx, key, payment.ID.String())
+
+	return payment, nil
+}
+
+func (p *PaymentService) createPayment(ctx context.Context, req dto.CreatePaymentRequest) (*domain.Payment, error) {
 	payment, err := domain.NewPayment(
 		req.OrderId,
 		req.UserId,
-		req.Currency,
 		req.TotalPrice,
 		req.PaymentMethod,
 		req.Description,
@@ -123,6 +171,64 @@ func (p *PaymentService) CancelPayment(ctx context.Context, paymentId, userId uu
 	return nil
 }
 
+// CancelOrderPayment implements interfaces.PaymentService.
+//
+// Payment that doesn't exist or is not pending anymore is left as is, so repeated calls are no-op.
+func (p *PaymentService) CancelOrderPayment(ctx context.Context, orderId uuid.UUID) error {
+	payment, err := p.repo.GetByOrderId(ctx, orderId.String())
+	if err != nil {
+		if errors.Is(err, domain.ErrPaymentNotFound) {
+			p.log.Debug("no payment to cancel", "order_id", orderId.String())
+			return nil
+		}
+		p.log.Error("cancel order payment error", "error", err, "order_id", orderId.String())
+		return domain.NewAppError(err, "failed to get payment")
+	}
+
+	if payment.Status != domain.PaymentPending {
+		p.log.Debug("payment is not pending, nothing to cancel", "order_id", orderId.String(), "status", payment.Status.String())
+		return nil
+	}
+
+	payment.SetStatus(domain.PaymentCancelled)
+
+	if err = p.repo.Update(ctx, payment); err != nil {
+		p.log.Error("cancel order payment error", "error", err, "order_id", orderId.String())
+		return domain.NewAppError(err, "failed to update payment")
+	}
+
+	p.log.Debug("cancel order payment success", "order_id", orderId.String())
+
+	return nil
+}
+
+// RefundPayment implements interfaces.PaymentService.
+//
+// Refund requested again with the same id is not applied twice, ErrRefundAlreadyExists is returned for it.
+func (p *PaymentService) RefundPayment(ctx context.Context, req dto.RefundPaymentRequest) (*domain.Refund, error) {
+	refund, err := p.repo.Refund(ctx, req.OrderId.String(), req.RefundId.String(),
+		func(payment *domain.Payment, refunded money.Money) (*domain.Refund, error) {
+			if payment.UserID != req.UserId {
+				return nil, domain.ErrPaymentNotFound
+			}
+
+			return payment.Refund(req.RefundId, req.Amount, refunded, req.Reason, p.now())
+		})
+	if err != nil {
+		if errors.Is(err, domain.ErrRefundAlreadyExists) {
+			p.log.Debug("refund already requested", "refund_id", req.RefundId.String(), "order_id", req.OrderId.String())
+			return nil, domain.NewAppError(err, "refund already exists")
+		}
+		p.log.Error("refund payment error", "error", err, "refund_id", req.RefundId.String(), "order_id", req.OrderId.String())
+		return nil, domain.NewAppError(err, "failed to refund payment")
+	}
+
+	p.log.Debug("refund payment success", "refund_id", refund.ID.String(), "order_id", req.OrderId.String(),
+		"amount", refund.Amount.String())
+
+	return refund, nil
+}
+
 // ConfirmPayment implements interfaces.PaymentService.
 func (p *PaymentService) ConfirmPayment(ctx context.Context, paymentId, userId uuid.UUID) error {
 	payment, err := p.repo.GetById(ctx, paymentId.String(), userId.String())
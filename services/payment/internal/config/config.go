@@ -1,6 +1,7 @@
 package config
 
 import (
+	"errors"
 	"log"
 	"net"
 	"time"
@@ -18,6 +19,8 @@ type Config struct {
 	CircuitBreaker   CircuitBreakerConfig
 	Kafka            KafkaConfig
 	Tracing          TracingConfig
+	Auth             AuthConfig
+	Idempotency      IdempotencyConfig
 	ProfilingEnabled bool `env:"PROFILING_ENABLED" env-default:"false"`
 }
 
@@ -83,6 +86,35 @@ type TracingConfig struct {
 	URL string `env:"JAEGER_EXP_URL" env-default:"http://localhost:14268/api/traces"`
 }
 
+// AuthConfig describes how bearer tokens are verified.
+//
+// Exactly one of them has to be set: if JWKSFile is set, tokens are verified against its public keys.
+// Otherwise, HMACSecret is used.
+type AuthConfig struct {
+	HMACSecret string `env:"AUTH_HMAC_SECRET"`
+	JWKSFile   string `env:"AUTH_JWKS_FILE"`
+}
+
+// Validate checks that tokens are verified with either JWKS file or secret, not both or none.
+func (a AuthConfig) Validate() error {
+	switch {
+	case a.JWKSFile != "" && a.HMACSecret != "":
+		return errors.New("only one of AUTH_JWKS_FILE and AUTH_HMAC_SECRET may be set")
+	case a.JWKSFile == "" && a.HMACSecret == "":
+		return errors.New("either AUTH_JWKS_FILE or AUTH_HMAC_SECRET must be set")
+	}
+
+	return nil
+}
+
+// IdempotencyConfig describes how idempotency keys of create requests are kept.
+type IdempotencyConfig struct {
+	// How long key is remembered after first request.
+	KeyTTL time.Duration `env:"IDEMPOTENCY_KEY_TTL" env-default:"24h"`
+	// How often expired keys are deleted.
+	CleanupInterval time.Duration `env:"IDEMPOTENCY_CLEANUP_INTERVAL" env-default:"1h"`
+}
+
 // MustNew Reads .env file and returns Config.
 func MustNew() *Config {
 	if err := godotenv.Load(); err != nil {
@@ -95,5 +127,9 @@ func MustNew() *Config {
 		log.Fatalf("error reading config: %v", err)
 	}
 
+	if err := cfg.Auth.Validate(); err != nil {
+		log.Fatalf("invalid auth config: %v", err)
+	}
+
 	return &cfg
 }
@@ -15,6 +15,13 @@ var (
 	ErrPaymentAlreadyCompleted = errors.New("payment already completed")
 	ErrPaymentAlreadyExists    = errors.New("payment already exists") // Payment for a certain order is already created.
 	ErrPaymentNotFound         = errors.New("payment not found")
+	ErrPaymentNotRefundable    = errors.New("payment can't be refunded")
+	ErrRefundExceedsPayment    = errors.New("refund exceeds payment")
+	ErrRefundAlreadyExists     = errors.New("refund already exists") // Refund with the same id was requested before.
+
+	ErrInvalidIdempotencyKey = errors.New("invalid idempotency key")
+	ErrIdempotencyKeyReused  = errors.New("idempotency key was used with another request")
+	ErrRequestInProgress     = errors.New("request with this idempotency key is in progress")
 
 	// Critical ones --->
 	ErrPaymentCancelled = errors.New("payment cancelled") // FIXME надо ли?
@@ -70,6 +77,18 @@ func (e *AppError) GRPCCode() codes.Code {
 		return codes.AlreadyExists
 	case errors.Is(e.Code, ErrPaymentNotFound):
 		return codes.NotFound
+	case errors.Is(e.Code, ErrPaymentNotRefundable):
+		return codes.FailedPrecondition
+	case errors.Is(e.Code, ErrRefundExceedsPayment):
+		return codes.FailedPrecondition
+	case errors.Is(e.Code, ErrRefundAlreadyExists):
+		return codes.AlreadyExists
+	case errors.Is(e.Code, ErrInvalidIdempotencyKey):
+		return codes.InvalidArgument
+	case errors.Is(e.Code, ErrIdempotencyKeyReused):
+		return codes.AlreadyExists
+	case errors.Is(e.Code, ErrRequestInProgress):
+		return codes.Aborted
 	case errors.Is(e.Code, ErrPaymentCancelled):
 		return codes.InvalidArgument
 	case errors.Is(e.Code, ErrPaymentFailed):
@@ -0,0 +1,46 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const MaxIdempotencyKeyLength = 255
+
+// IdempotencyKey remembers request that was made with a client supplied key,
+// so retries of the same request are answered with the original result.
+type IdempotencyKey struct {
+	Key       string
+	UserID    uuid.UUID
+	Operation string
+	// RequestHash is fingerprint of request payload, same key with another payload is rejected.
+	RequestHash string
+	// ResourceID is id of resource created by request. Empty while request is in progress.
+	ResourceID string
+	CreatedAt  time.Time
+	ExpiresAt  time.Time
+}
+
+func NewIdempotencyKey(key string, userId uuid.UUID, operation, requestHash string, now time.Time, ttl time.Duration) (*IdempotencyKey, error) {
+	if key == "" || len(key) > MaxIdempotencyKeyLength {
+		return nil, ErrInvalidIdempotencyKey
+	}
+
+	return &IdempotencyKey{
+		Key:         key,
+		UserID:      userId,
+		Operation:   operation,
+		RequestHash: requestHash,
+		CreatedAt:   now,
+		ExpiresAt:   now.Add(ttl),
+	}, nil
+}
+
+func (k *IdempotencyKey) IsCompleted() bool {
+	return k.ResourceID != ""
+}
+
+func (k *IdempotencyKey) Complete(resourceId string) {
+	k.ResourceID = resourceId
+}
@@ -6,6 +6,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/dzhordano/ecom-thing/services/payment/pkg/money"
 	"github.com/google/uuid"
 )
 
@@ -21,7 +22,7 @@ type Payment struct {
 	UserID        uuid.UUID
 	OrderID       uuid.UUID
 	Currency      Currency
-	TotalPrice    float64
+	TotalPrice    money.Money // In Currency.
 	PaymentMethod PaymentMethod
 	Description   string
 	RedirectURL   string
@@ -62,6 +63,7 @@ const (
 	PaymentCompleted Status = "completed"
 	PaymentCancelled Status = "cancelled"
 	PaymentFailed    Status = "failed"
+	PaymentRefunded  Status = "refunded" // Completed payment was refunded in full.
 )
 
 var statusMap = map[string]Status{
@@ -69,6 +71,7 @@ var statusMap = map[string]Status{
 	"completed": PaymentCompleted,
 	"cancelled": PaymentCancelled,
 	"failed":    PaymentFailed,
+	"refunded":  PaymentRefunded,
 }
 
 func NewStatus(s string) (Status, error) {
@@ -107,13 +110,14 @@ func (s PaymentMethod) String() string {
 	return string(s)
 }
 
-func NewPayment(orderId, userId uuid.UUID, currency string, totalPrice float64, paymentMethod, paymentDescription, redirectURL, status string) (*Payment, error) {
+// NewPayment creates payment of totalPrice in its currency.
+func NewPayment(orderId, userId uuid.UUID, totalPrice money.Money, paymentMethod, paymentDescription, redirectURL, status string) (*Payment, error) {
 	paymentId, err := uuid.NewUUID()
 	if err != nil {
 		return nil, err
 	}
 
-	c, err := NewCurrency(currency)
+	c, err := NewCurrency(totalPrice.Currency)
 	if err != nil {
 		return nil, err
 	}
@@ -151,7 +155,7 @@ func (p *Payment) Validate() error {
 		errs = append(errs, err.Error())
 	}
 
-	if p.TotalPrice <= 0 {
+	if !p.TotalPrice.IsPositive() {
 		errs = append(errs, "total price must be greater than 0")
 	}
 
@@ -205,7 +209,7 @@ type OrderEvent struct {
 	OrderID       string
 	UserID        string
 	Currency      string
-	TotalPrice    float64
+	TotalPrice    money.Money
 	PaymentMethod string
 	Description   string
 }
@@ -233,7 +237,7 @@ func (e OrderEvent) MarshalJSON() ([]byte, error) {
 		OrderID:       e.OrderID,
 		UserID:        e.UserID,
 		Currency:      e.Currency,
-		TotalPrice:    fmt.Sprintf("%.2f", e.TotalPrice), // TODO норм ли это
+		TotalPrice:    e.TotalPrice.String(),
 		PaymentMethod: e.PaymentMethod,
 		Description:   e.Description,
 	})
@@ -241,12 +245,12 @@ func (e OrderEvent) MarshalJSON() ([]byte, error) {
 
 func (e *OrderEvent) UnmarshalJSON(data []byte) error {
 	var aux struct {
-		OrderID       string  `json:"order_id"`
-		UserID        string  `json:"user_id"`
-		Currency      string  `json:"currency"`
-		TotalPrice    float64 `json:"total_price,string"`
-		PaymentMethod string  `json:"payment_method"`
-		Description   string  `json:"description"`
+		OrderID       string `json:"order_id"`
+		UserID        string `json:"user_id"`
+		Currency      string `json:"currency"`
+		TotalPrice    string `json:"total_price"`
+		PaymentMethod string `json:"payment_method"`
+		Description   string `json:"description"`
 	}
 	if err := json.Unmarshal(data, &aux); err != nil {
 		return err
@@ -254,7 +258,11 @@ func (e *OrderEvent) UnmarshalJSON(data []byte) error {
 	e.OrderID = aux.OrderID
 	e.UserID = aux.UserID
 	e.Currency = aux.Currency
-	e.TotalPrice = aux.TotalPrice
+	totalPrice, err := money.Parse(aux.TotalPrice, aux.Currency)
+	if err != nil {
+		return err
+	}
+	e.TotalPrice = totalPrice
 	e.PaymentMethod = aux.PaymentMethod
 	e.Description = aux.Description
 	return nil
@@ -0,0 +1,52 @@
+package domain
+
+import (
+	"context"
+	"slices"
+
+	"github.com/google/uuid"
+)
+
+type Role string
+
+const (
+	RoleUser   Role = "user"   // Regular authorized user.
+	RoleAdmin  Role = "admin"  // Platform administrator.
+	RoleSystem Role = "system" // Internal caller, e.g. kafka consumer. Never issued in tokens.
+)
+
+// Principal is an authenticated caller identity.
+type Principal struct {
+	UserID uuid.UUID
+	Roles  []Role
+}
+
+// SystemPrincipal is used for calls that originate inside the service (consumers, workers).
+func SystemPrincipal() Principal {
+	return Principal{Roles: []Role{RoleSystem}}
+}
+
+func (p Principal) HasRole(r Role) bool {
+	return slices.Contains(p.Roles, r)
+}
+
+// IsPrivileged reports whether principal may act on resources of other users.
+func (p Principal) IsPrivileged() bool {
+	return p.HasRole(RoleAdmin) || p.HasRole(RoleSystem)
+}
+
+// CanAccess reports whether principal is allowed to access resource owned by ownerId.
+func (p Principal) CanAccess(ownerId uuid.UUID) bool {
+	return p.IsPrivileged() || (p.UserID != uuid.Nil && p.UserID == ownerId)
+}
+
+type principalCtxKey struct{}
+
+func ContextWithPrincipal(ctx context.Context, p Principal) context.Context {
+	return context.WithValue(ctx, principalCtxKey{}, p)
+}
+
+func PrincipalFromContext(ctx context.Context) (Principal, bool) {
+	p, ok := ctx.Value(principalCtxKey{}).(Principal)
+	return p, ok
+}
@@ -0,0 +1,128 @@
+package domain
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/dzhordano/ecom-thing/services/payment/pkg/money"
+	"github.com/google/uuid"
+)
+
+// Refund returns part or all of completed payment. Its id is chosen by requester (order service),
+// so request that is delivered again refunds nothing more.
+type Refund struct {
+	ID        uuid.UUID
+	PaymentID uuid.UUID
+	OrderID   uuid.UUID
+	Amount    money.Money // In currency of payment.
+	Reason    string
+	Status    RefundStatus
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type RefundStatus string
+
+const (
+	RefundRequested RefundStatus = "requested" // Refund is accepted, billing is yet to return money.
+	RefundCompleted RefundStatus = "completed" // Money was returned by billing.
+)
+
+// Refund takes amount back from completed payment. Refunded is sum of its earlier refunds, together they
+// can't exceed payment. Payment refunded in full is marked as refunded.
+func (p *Payment) Refund(refundId uuid.UUID, amount, refunded money.Money, reason string, now time.Time) (*Refund, error) {
+	if p.Status != PaymentCompleted {
+		return nil, fmt.Errorf("%w: payment is %s", ErrPaymentNotRefundable, p.Status)
+	}
+
+	if amount.Currency != p.Currency.String() || !amount.IsPositive() {
+		return nil, fmt.Errorf("%w: invalid refund amount %s %s", ErrInvalidArgument, amount, amount.Currency)
+	}
+
+	if len(reason) > MaxPaymentDataLength {
+		return nil, fmt.Errorf("%w: refund reason must be less than 256 characters", ErrInvalidArgument)
+	}
+
+	total := refunded.Add(amount)
+	switch total.Cmp(p.TotalPrice) {
+	case 1:
+		return nil, fmt.Errorf("%w: %s of %s is refunded already", ErrRefundExceedsPayment, refunded, p.TotalPrice)
+	case 0:
+		p.SetStatus(PaymentRefunded)
+		p.UpdatedAt = now
+	}
+
+	return &Refund{
+		ID:        refundId,
+		PaymentID: p.ID,
+		OrderID:   p.OrderID,
+		Amount:    amount,
+		Reason:    reason,
+		Status:    RefundRequested,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}, nil
+}
+
+// RefundEvent is refund requested by order service. Saved refund is passed on to billing as one too.
+type RefundEvent struct {
+	RefundID string
+	OrderID  string
+	UserID   string
+	Amount   money.Money
+	Reason   string
+}
+
+func (r *Refund) RefundEvent() RefundEvent {
+	return RefundEvent{
+		RefundID: r.ID.String(),
+		OrderID:  r.OrderID.String(),
+		Amount:   r.Amount,
+		Reason:   r.Reason,
+	}
+}
+
+func (e RefundEvent) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		RefundID string `json:"refund_id"`
+		OrderID  string `json:"order_id"`
+		UserID   string `json:"user_id"`
+		Amount   string `json:"amount"`
+		Currency string `json:"currency"`
+		Reason   string `json:"reason"`
+	}{
+		RefundID: e.RefundID,
+		OrderID:  e.OrderID,
+		UserID:   e.UserID,
+		Amount:   e.Amount.String(),
+		Currency: e.Amount.Currency,
+		Reason:   e.Reason,
+	})
+}
+
+func (e *RefundEvent) UnmarshalJSON(data []byte) error {
+	var aux struct {
+		RefundID string `json:"refund_id"`
+		OrderID  string `json:"order_id"`
+		UserID   string `json:"user_id"`
+		Amount   string `json:"amount"`
+		Currency string `json:"currency"`
+		Reason   string `json:"reason"`
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	amount, err := money.Parse(aux.Amount, aux.Currency)
+	if err != nil {
+		return err
+	}
+
+	e.RefundID = aux.RefundID
+	e.OrderID = aux.OrderID
+	e.UserID = aux.UserID
+	e.Amount = amount
+	e.Reason = aux.Reason
+	return nil
+}
@@ -0,0 +1,117 @@
+package domain
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/dzhordano/ecom-thing/services/payment/pkg/money"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPayment_Refund(t *testing.T) {
+	now := time.Date(2025, time.April, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		status     Status
+		amount     money.Money
+		refunded   money.Money
+		wantErr    error
+		wantStatus Status
+	}{
+		{
+			name:       "partial",
+			status:     PaymentCompleted,
+			amount:     money.New(3000, "USD"),
+			refunded:   money.New(0, "USD"),
+			wantStatus: PaymentCompleted,
+		},
+		{
+			name:       "rest of partially refunded",
+			status:     PaymentCompleted,
+			amount:     money.New(7000, "USD"),
+			refunded:   money.New(3000, "USD"),
+			wantStatus: PaymentRefunded,
+		},
+		{
+			name:     "more than left",
+			status:   PaymentCompleted,
+			amount:   money.New(7001, "USD"),
+			refunded: money.New(3000, "USD"),
+			wantErr:  ErrRefundExceedsPayment,
+		},
+		{
+			name:     "other currency",
+			status:   PaymentCompleted,
+			amount:   money.New(3000, "EUR"),
+			refunded: money.New(0, "USD"),
+			wantErr:  ErrInvalidArgument,
+		},
+		{
+			name:     "zero amount",
+			status:   PaymentCompleted,
+			amount:   money.New(0, "USD"),
+			refunded: money.New(0, "USD"),
+			wantErr:  ErrInvalidArgument,
+		},
+		{
+			name:     "pending",
+			status:   PaymentPending,
+			amount:   money.New(3000, "USD"),
+			refunded: money.New(0, "USD"),
+			wantErr:  ErrPaymentNotRefundable,
+		},
+		{
+			name:     "refunded",
+			status:   PaymentRefunded,
+			amount:   money.New(3000, "USD"),
+			refunded: money.New(10000, "USD"),
+			wantErr:  ErrPaymentNotRefundable,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &Payment{
+				ID:         uuid.New(),
+				OrderID:    uuid.New(),
+				Currency:   USD,
+				TotalPrice: money.New(10000, "USD"),
+				Status:     tt.status,
+			}
+			refundId := uuid.New()
+
+			refund, err := p.Refund(refundId, tt.amount, tt.refunded, "items cancelled", now)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Equal(t, tt.status, p.Status)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, refundId, refund.ID)
+			assert.Equal(t, p.ID, refund.PaymentID)
+			assert.Equal(t, p.OrderID, refund.OrderID)
+			assert.Equal(t, tt.amount, refund.Amount)
+			assert.Equal(t, RefundRequested, refund.Status)
+			assert.Equal(t, tt.wantStatus, p.Status)
+		})
+	}
+}
+
+func TestRefundEvent_JSON(t *testing.T) {
+	data := []byte(`{"refund_id":"r","order_id":"o","user_id":"u","amount":"12.30","currency":"RUB","reason":"items returned"}`)
+
+	var e RefundEvent
+	require.NoError(t, json.Unmarshal(data, &e))
+	assert.Equal(t, RefundEvent{RefundID: "r", OrderID: "o", UserID: "u", Amount: money.New(1230, "RUB"), Reason: "items returned"}, e)
+
+	b, err := json.Marshal(e)
+	require.NoError(t, err)
+	assert.JSONEq(t, string(data), string(b))
+
+	assert.Error(t, json.Unmarshal([]byte(`{"amount":"1.234","currency":"RUB"}`), &e))
+}
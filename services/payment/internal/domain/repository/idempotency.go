@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/dzhordano/ecom-thing/services/payment/internal/domain"
+)
+
+// IdempotencyRepository stores idempotency keys. Key is unique per user and operation.
+type IdempotencyRepository interface {
+	// Acquire saves key as in progress. If unexpired key is already stored, it is returned
+	// and nothing is saved. Expired key is replaced.
+	Acquire(ctx context.Context, key *domain.IdempotencyKey) (*domain.IdempotencyKey, error)
+	// Complete records ResourceID of the key.
+	Complete(ctx context.Context, key *domain.IdempotencyKey) error
+	// Release deletes key that is still in progress, so request can be retried with it.
+	Release(ctx context.Context, key *domain.IdempotencyKey) error
+	// DeleteExpired deletes keys expired by now and returns their count.
+	DeleteExpired(ctx context.Context, now time.Time) (int64, error)
+}
@@ -4,12 +4,21 @@ import (
 	"context"
 
 	"github.com/dzhordano/ecom-thing/services/payment/internal/domain"
+	"github.com/dzhordano/ecom-thing/services/payment/pkg/money"
 )
 
+// RefundFunc returns refund of payment, refunded is sum of its earlier refunds.
+// Changes fn makes to payment are saved along with refund.
+type RefundFunc func(payment *domain.Payment, refunded money.Money) (*domain.Refund, error)
+
 type PaymentRepository interface {
 	Save(ctx context.Context, payment *domain.Payment) error
 	GetById(ctx context.Context, paymentId, userId string) (*domain.Payment, error)
+	GetByOrderId(ctx context.Context, orderId string) (*domain.Payment, error)
 	ListByUser(ctx context.Context, userId string, limit, offset uint64) ([]*domain.Payment, error)
 	Update(ctx context.Context, payment *domain.Payment) error
 	Delete(ctx context.Context, paymentId string) error
+	// Refund locks payment of order and saves refund fn returns for it, billing is asked to return money
+	// once it's saved. Refund with id saved before is not applied again, ErrRefundAlreadyExists is returned.
+	Refund(ctx context.Context, orderId, refundId string, fn RefundFunc) (*domain.Refund, error)
 }
@@ -3,6 +3,7 @@ package billing
 import (
 	"context"
 	"github.com/dzhordano/ecom-thing/services/payment/internal/application/interfaces"
+	"github.com/dzhordano/ecom-thing/services/payment/pkg/money"
 	"log"
 	"time"
 )
@@ -14,21 +15,26 @@ func NewStubBilling() interfaces.Billing {
 	return &StubBilling{}
 }
 
-func (s *StubBilling) NewPayment(ctx context.Context, currency string, totalPrice float64, paymentData string) error {
+func (s *StubBilling) NewPayment(ctx context.Context, totalPrice money.Money, paymentData string) error {
 	const op = "billing.StubBilling.NewPayment"
 
-	log.Printf("creating payment with currency: %s, totalPrice: %f, paymentData: %s", currency, totalPrice, paymentData)
+	log.Printf("creating payment with currency: %s, totalPrice: %s, paymentData: %s", totalPrice.Currency, totalPrice, paymentData)
 	time.Sleep(5 * time.Second)
 
 	// if rand.IntN(50) > 0 {
 	// 	return fmt.Errorf("%s: %w", op, domain.ErrPaymentFailed) // FIXME oh no oh no it failed...
 	// }
 
-	log.Printf("success creating payment with currency: %s, totalPrice: %f, paymentData: %s", currency, totalPrice, paymentData)
+	log.Printf("success creating payment with currency: %s, totalPrice: %s, paymentData: %s", totalPrice.Currency, totalPrice, paymentData)
 
 	return nil
 }
 
+func (s *StubBilling) Refund(ctx context.Context, amount money.Money, reason string) error {
+	log.Printf("refunding %s %s, reason: %s", amount, amount.Currency, reason)
+	return nil
+}
+
 func (s *StubBilling) CancelPayment(ctx context.Context, paymentId string) error {
 	log.Printf("canceling payment with paymentId: %s", paymentId)
 	return nil
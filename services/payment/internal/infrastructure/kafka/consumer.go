@@ -22,8 +22,9 @@ var (
 
 	// To filter out unnecessary events
 	events = map[string]bool{
-		"order-created":   true,
-		"order-cancelled": true,
+		"order-created":    true,
+		"order-cancelled":  true,
+		"refund-requested": true,
 	}
 )
 
@@ -198,16 +199,11 @@ func (c *Consumer) CreateTopics(_ context.Context, partitions, replicationFactor
 }
 
 func (c *Consumer) executeEvent(ctx context.Context, m kafka.Message) error {
-	var pmtEv domain.OrderEvent
-	if err := pmtEv.UnmarshalJSON(m.Value); err != nil {
-		return err
-	}
-
 	// FIXME Тут наверно все же в цикле перебрать. Хотя и хедер всего один всегда...
 
 	var eventType string
 	for _, h := range m.Headers {
-		if string(h.Key) == "event_type" {
+		if string(h.Key) == EventTypeHeaderKey {
 			eventType = string(h.Value)
 			break
 		}
@@ -216,6 +212,16 @@ func (c *Consumer) executeEvent(ctx context.Context, m kafka.Message) error {
 		return ErrInvalidEventType
 	}
 
+	// Refund has payload of its own.
+	if eventType == "refund-requested" {
+		return c.refund(ctx, m)
+	}
+
+	var pmtEv domain.OrderEvent
+	if err := pmtEv.UnmarshalJSON(m.Value); err != nil {
+		return err
+	}
+
 	orderID, err := uuid.Parse(pmtEv.OrderID)
 	if err != nil {
 		return err
@@ -232,19 +238,55 @@ func (c *Consumer) executeEvent(ctx context.Context, m kafka.Message) error {
 		if _, err = c.ps.CreatePayment(ctx, dto.CreatePaymentRequest{
 			OrderId:       orderID,
 			UserId:        userID,
-			Currency:      pmtEv.Currency,
 			TotalPrice:    pmtEv.TotalPrice,
 			PaymentMethod: pmtEv.PaymentMethod,
 			Description:   pmtEv.Description,
 			RedirectURL:   fmt.Sprintf("localhost:1337/payment/%s", orderID), // FIXME тут неправильно пока
-		}); err != nil {
+		}); err != nil && !errors.Is(err, domain.ErrPaymentAlreadyExists) {
+			// Order checkout may request payment again, existing one is kept.
 			return err
 		}
 	case "order-cancelled":
-		if err := c.ps.CancelPayment(ctx, orderID, userID); err != nil {
+		if err := c.ps.CancelOrderPayment(ctx, orderID); err != nil {
 			return err
 		}
 	}
 
 	return nil
 }
+
+// refund applies refund requested by order service. Requests are delivered at least once and checkout
+// requests its refund again until it's confirmed, so refund that already exists is not an error.
+func (c *Consumer) refund(ctx context.Context, m kafka.Message) error {
+	var refundEv domain.RefundEvent
+	if err := refundEv.UnmarshalJSON(m.Value); err != nil {
+		return err
+	}
+
+	refundID, err := uuid.Parse(refundEv.RefundID)
+	if err != nil {
+		return err
+	}
+
+	orderID, err := uuid.Parse(refundEv.OrderID)
+	if err != nil {
+		return err
+	}
+
+	userID, err := uuid.Parse(refundEv.UserID)
+	if err != nil {
+		return err
+	}
+
+	if _, err := c.ps.RefundPayment(ctx, dto.RefundPaymentRequest{
+		RefundId: refundID,
+		OrderId:  orderID,
+		UserId:   userID,
+		Amount:   refundEv.Amount,
+		Reason:   refundEv.Reason,
+	}); err != nil && !errors.Is(err, domain.ErrRefundAlreadyExists) {
+		return err
+	}
+
+	return nil
+}
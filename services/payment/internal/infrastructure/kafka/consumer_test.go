@@ -0,0 +1,103 @@
+package kafka
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/dzhordano/ecom-thing/services/payment/internal/application/dto"
+	"github.com/dzhordano/ecom-thing/services/payment/internal/domain"
+	mock_interfaces "github.com/dzhordano/ecom-thing/services/payment/internal/interfaces/grpc_server/mocks"
+	"github.com/dzhordano/ecom-thing/services/payment/pkg/money"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/segmentio/kafka-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func refundMessage(eventType string, refundId, orderId, userId uuid.UUID, amount string) kafka.Message {
+	return kafka.Message{
+		Key: []byte(uuid.NewString()),
+		Value: []byte(fmt.Sprintf(`{"refund_id":%q,"order_id":%q,"user_id":%q,"amount":%q,"currency":"USD","reason":"items cancelled"}`,
+			refundId, orderId, userId, amount)),
+		Headers: []kafka.Header{{Key: EventTypeHeaderKey, Value: []byte(eventType)}},
+	}
+}
+
+func TestConsumer_executeEvent_RefundRequested(t *testing.T) {
+	refundId, orderId, userId := uuid.New(), uuid.New(), uuid.New()
+
+	expectedReq := dto.RefundPaymentRequest{
+		RefundId: refundId,
+		OrderId:  orderId,
+		UserId:   userId,
+		Amount:   money.New(1250, "USD"),
+		Reason:   "items cancelled",
+	}
+
+	tests := []struct {
+		name        string
+		msg         kafka.Message
+		mockBehav   func(s *mock_interfaces.MockPaymentService)
+		expectedErr error
+	}{
+		{
+			name: "OK",
+			msg:  refundMessage("refund-requested", refundId, orderId, userId, "12.50"),
+			mockBehav: func(s *mock_interfaces.MockPaymentService) {
+				s.EXPECT().RefundPayment(gomock.Any(), expectedReq).Return(&domain.Refund{ID: refundId}, nil)
+			},
+		},
+		{
+			// Refund requested again is applied once already.
+			name: "ALREADY REFUNDED",
+			msg:  refundMessage("refund-requested", refundId, orderId, userId, "12.50"),
+			mockBehav: func(s *mock_interfaces.MockPaymentService) {
+				s.EXPECT().RefundPayment(gomock.Any(), expectedReq).
+					Return(nil, domain.NewAppError(domain.ErrRefundAlreadyExists, "refund already exists"))
+			},
+		},
+		{
+			// Left uncommitted, so it's retried.
+			name: "SERVICE ERROR",
+			msg:  refundMessage("refund-requested", refundId, orderId, userId, "12.50"),
+			mockBehav: func(s *mock_interfaces.MockPaymentService) {
+				s.EXPECT().RefundPayment(gomock.Any(), expectedReq).
+					Return(nil, domain.NewAppError(domain.ErrPaymentNotRefundable, "failed to refund payment"))
+			},
+			expectedErr: domain.ErrPaymentNotRefundable,
+		},
+		{
+			name:        "INVALID AMOUNT",
+			msg:         refundMessage("refund-requested", refundId, orderId, userId, "12.505"),
+			mockBehav:   func(s *mock_interfaces.MockPaymentService) {},
+			expectedErr: money.ErrInvalidAmount,
+		},
+		{
+			name:        "UNKNOWN EVENT TYPE",
+			msg:         refundMessage("refund-completed", refundId, orderId, userId, "12.50"),
+			mockBehav:   func(s *mock_interfaces.MockPaymentService) {},
+			expectedErr: ErrInvalidEventType,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ps := mock_interfaces.NewMockPaymentService(ctrl)
+			tt.mockBehav(ps)
+
+			c := &Consumer{ps: ps}
+
+			err := c.executeEvent(context.Background(), tt.msg)
+			if tt.expectedErr == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.True(t, errors.Is(err, tt.expectedErr), "got %v, want %v", err, tt.expectedErr)
+		})
+	}
+}
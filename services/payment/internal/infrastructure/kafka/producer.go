@@ -9,13 +9,21 @@ import (
 )
 
 type Producer interface {
-	Produce(ctx context.Context, eventType, key, orderId string) error
+	Produce(ctx context.Context, eventType, key, orderId string, headers ...Header) error
 }
 
 var (
 	EventTypeHeaderKey = "event_type"
+	// Set on refund events, refund id is chosen by order service.
+	RefundIDHeaderKey = "refund_id"
 )
 
+// Header is header of produced message added to event type one.
+type Header struct {
+	Key   string
+	Value string
+}
+
 type KafkaProducer struct {
 	ws []*kafka.Writer
 
@@ -46,7 +54,7 @@ func NewProducer(brokers []string, topics []string, retryBackoff time.Duration,
 	return &KafkaProducer{ws: ws, brokers: brokers, topics: topics, retryBackoff: retryBackoff, retries: retries}
 }
 
-func (p *KafkaProducer) Produce(ctx context.Context, eventType, key, orderId string) error {
+func (p *KafkaProducer) Produce(ctx context.Context, eventType, key, orderId string, headers ...Header) error {
 	m := kafka.Message{
 		Key:   []byte(key),
 		Value: []byte(orderId),
@@ -57,6 +65,9 @@ func (p *KafkaProducer) Produce(ctx context.Context, eventType, key, orderId str
 			},
 		},
 	}
+	for _, h := range headers {
+		m.Headers = append(m.Headers, kafka.Header{Key: h.Key, Value: []byte(h.Value)})
+	}
 
 	for i := range p.ws {
 		err := p.ws[i].WriteMessages(ctx, m)
@@ -12,6 +12,13 @@ import (
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
+const (
+	// Written by payment repository along with refund.
+	eventRefundRequested = "refund-requested"
+	// Tells order service that money of refund was returned.
+	eventRefunded = "refunded"
+)
+
 type OutboxProcessor struct {
 	log      logger.Logger
 	db       *pgxpool.Pool
@@ -81,30 +88,16 @@ func (op *OutboxProcessor) processOutbox(ctx context.Context) {
 		return
 	}
 
-	var pmtEv domain.OrderEvent
 	for _, msg := range messages {
-		if err = json.Unmarshal(msg.Payload, &pmtEv); err != nil {
-			op.log.Error("failed to unmarshal outbox message", "error", err)
-			continue
+		var err error
+		switch msg.EventType {
+		case eventRefundRequested:
+			err = op.processRefund(ctx, msg)
+		default:
+			err = op.processPayment(ctx, msg)
 		}
-
-		err = op.biller.NewPayment(ctx, pmtEv.Currency, pmtEv.TotalPrice, pmtEv.Description)
 		if err != nil {
-			op.log.Error("failed to process outbox message", "error", err)
-
-			// FIXME Сейчас тут cancelled, а по факту, если не прошло -> failed.
-			// Cancelled - обрабатывать отдельно. Оно также должно быть правильно обработано в Order сервисе.
-			err = op.prod.Produce(ctx, domain.PaymentCancelled.String(), msg.ID, pmtEv.OrderID)
-			if err != nil {
-				op.log.Error("failed to send Kafka message", "error", err)
-				continue
-			}
-		} else {
-			err = op.prod.Produce(ctx, domain.PaymentCompleted.String(), msg.ID, pmtEv.OrderID)
-			if err != nil {
-				op.log.Error("failed to send Kafka message", "error", err)
-				continue
-			}
+			continue
 		}
 
 		// Обновляем запись в Outbox, помечая как обработанную
@@ -116,3 +109,79 @@ func (op *OutboxProcessor) processOutbox(ctx context.Context) {
 		}
 	}
 }
+
+// processPayment bills created or retried payment and reports result to order service.
+func (op *OutboxProcessor) processPayment(ctx context.Context, msg OutboxMessage) error {
+	var pmtEv domain.OrderEvent
+	if err := json.Unmarshal(msg.Payload, &pmtEv); err != nil {
+		op.log.Error("failed to unmarshal outbox message", "error", err)
+		return err
+	}
+
+	status := domain.PaymentCompleted
+	if err := op.biller.NewPayment(ctx, pmtEv.TotalPrice, pmtEv.Description); err != nil {
+		op.log.Error("failed to process outbox message", "error", err)
+
+		// FIXME Сейчас тут cancelled, а по факту, если не прошло -> failed.
+		// Cancelled - обрабатывать отдельно. Оно также должно быть правильно обработано в Order сервисе.
+		status = domain.PaymentFailed
+	}
+
+	// Billing result is recorded before order service learns it, so captured payment can be refunded right away.
+	// Payment cancelled while it was billed is captured all the same.
+	if _, err := op.db.Exec(ctx, `UPDATE payments SET status = $1, updated_at = NOW() WHERE order_id = $2`,
+		status, pmtEv.OrderID); err != nil {
+		op.log.Error("failed to update payment status", "error", err, "order_id", pmtEv.OrderID)
+		return err
+	}
+
+	eventType := domain.PaymentCompleted.String()
+	if status == domain.PaymentFailed {
+		eventType = domain.PaymentCancelled.String()
+	}
+
+	if err := op.prod.Produce(ctx, eventType, msg.ID, pmtEv.OrderID); err != nil {
+		op.log.Error("failed to send Kafka message", "error", err)
+		return err
+	}
+
+	return nil
+}
+
+// processRefund has billing return money of refund and confirms it to order service. Refund announced again
+// (because its confirmation was missed) was returned already, it's only confirmed.
+func (op *OutboxProcessor) processRefund(ctx context.Context, msg OutboxMessage) error {
+	var refundEv domain.RefundEvent
+	if err := json.Unmarshal(msg.Payload, &refundEv); err != nil {
+		op.log.Error("failed to unmarshal outbox message", "error", err)
+		return err
+	}
+
+	var status domain.RefundStatus
+	if err := op.db.QueryRow(ctx, `SELECT status FROM refunds WHERE id = $1`, refundEv.RefundID).Scan(&status); err != nil {
+		op.log.Error("failed to get refund", "error", err, "refund_id", refundEv.RefundID)
+		return err
+	}
+
+	if status == domain.RefundRequested {
+		// Failed refund is left in outbox and tried again.
+		if err := op.biller.Refund(ctx, refundEv.Amount, refundEv.Reason); err != nil {
+			op.log.Error("failed to refund payment", "error", err, "refund_id", refundEv.RefundID)
+			return err
+		}
+
+		if _, err := op.db.Exec(ctx, `UPDATE refunds SET status = $1, updated_at = NOW() WHERE id = $2`,
+			domain.RefundCompleted, refundEv.RefundID); err != nil {
+			op.log.Error("failed to update refund status", "error", err, "refund_id", refundEv.RefundID)
+			return err
+		}
+	}
+
+	if err := op.prod.Produce(ctx, eventRefunded, msg.ID, refundEv.OrderID,
+		kafka.Header{Key: kafka.RefundIDHeaderKey, Value: refundEv.RefundID}); err != nil {
+		op.log.Error("failed to send Kafka message", "error", err)
+		return err
+	}
+
+	return nil
+}
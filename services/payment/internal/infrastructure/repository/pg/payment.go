@@ -111,6 +111,44 @@ func (r *PaymentRepository) GetById(ctx context.Context, paymentId string, userI
 		}
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
+	payment.TotalPrice.Currency = payment.Currency.String()
+
+	return &payment, nil
+}
+
+// GetByOrderId implements repository.PaymentRepository.
+func (r *PaymentRepository) GetByOrderId(ctx context.Context, orderId string) (*domain.Payment, error) {
+	const op = "repository.PaymentRepository.GetByOrderId"
+
+	selQuery := sq.Select("id", "user_id", "order_id", "currency", "total_price", "status", "payment_method", "description", "created_at", "updated_at").
+		From(paymentsTable).
+		Where(sq.Eq{"order_id": orderId}).
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := selQuery.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var payment domain.Payment
+	if err = r.db.QueryRow(ctx, query, args...).Scan(
+		&payment.ID,
+		&payment.UserID,
+		&payment.OrderID,
+		&payment.Currency,
+		&payment.TotalPrice,
+		&payment.Status,
+		&payment.PaymentMethod,
+		&payment.Description,
+		&payment.CreatedAt,
+		&payment.UpdatedAt,
+	); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%s: %w", op, domain.ErrPaymentNotFound)
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	payment.TotalPrice.Currency = payment.Currency.String()
 
 	return &payment, nil
 }
@@ -155,6 +193,7 @@ func (r *PaymentRepository) ListByUser(ctx context.Context, userId string, limit
 		); err != nil {
 			return nil, fmt.Errorf("%s: %w", op, err)
 		}
+		payment.TotalPrice.Currency = payment.Currency.String()
 
 		payments = append(payments, &payment)
 	}
@@ -189,6 +228,11 @@ func (r *PaymentRepository) Update(ctx context.Context, payment *domain.Payment)
 			return fmt.Errorf("%s: %w", op, err)
 		}
 
+		// Only pending payment (e.g. retried one) has to go through billing again.
+		if payment.Status != domain.PaymentPending {
+			return nil
+		}
+
 		insQuery := sq.Insert(outboxTable).
 			Columns("topic", "event_type", "payload", "created_at").
 			Values(kafkaPaymentEvents, kafkaPaymentCreatedEvent, payment.OrderEvent(), payment.UpdatedAt).
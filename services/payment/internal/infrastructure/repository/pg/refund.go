@@ -0,0 +1,187 @@
+package pg
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/dzhordano/ecom-thing/services/payment/internal/domain"
+	"github.com/dzhordano/ecom-thing/services/payment/internal/domain/repository"
+	"github.com/dzhordano/ecom-thing/services/payment/pkg/money"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+const (
+	refundsTable = "refunds"
+
+	// Processed by outbox processor, which asks billing to return money.
+	kafkaRefundRequestedEvent = "refund-requested"
+)
+
+// Refund implements repository.PaymentRepository.
+func (r *PaymentRepository) Refund(ctx context.Context, orderId, refundId string, fn repository.RefundFunc) (*domain.Refund, error) {
+	const op = "repository.PaymentRepository.Refund"
+
+	var refund *domain.Refund
+	var exists bool
+	err := r.withTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		// Payment is locked first, so concurrent refunds of it are applied one by one.
+		payment, err := lockPaymentOfOrder(ctx, tx, orderId)
+		if err != nil {
+			return err
+		}
+
+		stored, err := getRefund(ctx, tx, refundId)
+		switch {
+		case err == nil:
+			exists = true
+			// Requester missed confirmation of completed refund, so it's sent again.
+			// Refund that is not completed yet is confirmed once billing returns money.
+			if stored.Status == domain.RefundCompleted {
+				return insertRefundOutbox(ctx, tx, stored)
+			}
+			return nil
+		case !errors.Is(err, pgx.ErrNoRows):
+			return err
+		}
+
+		refunded := money.New(0, payment.Currency.String())
+		if err := tx.QueryRow(ctx, `SELECT COALESCE(SUM(amount), 0) FROM refunds WHERE payment_id = $1`,
+			payment.ID).Scan(&refunded); err != nil {
+			return err
+		}
+
+		refund, err = fn(payment, refunded)
+		if err != nil {
+			return err
+		}
+
+		insQuery := sq.Insert(refundsTable).
+			Columns("id", "payment_id", "order_id", "amount", "reason", "status", "created_at", "updated_at").
+			Values(refund.ID, refund.PaymentID, refund.OrderID, refund.Amount, refund.Reason, refund.Status, refund.CreatedAt, refund.UpdatedAt).
+			PlaceholderFormat(sq.Dollar)
+
+		query, args, err := insQuery.ToSql()
+		if err != nil {
+			return err
+		}
+
+		if _, err := tx.Exec(ctx, query, args...); err != nil {
+			var pgErr *pgconn.PgError
+			if errors.As(err, &pgErr) && pgErr.Code == pgerrcode.UniqueViolation {
+				return domain.ErrRefundAlreadyExists
+			}
+			return err
+		}
+
+		updQuery := sq.Update(paymentsTable).
+			Set("status", payment.Status).
+			Set("updated_at", payment.UpdatedAt).
+			Where(sq.Eq{"id": payment.ID}).
+			PlaceholderFormat(sq.Dollar)
+
+		query, args, err = updQuery.ToSql()
+		if err != nil {
+			return err
+		}
+
+		if _, err := tx.Exec(ctx, query, args...); err != nil {
+			return err
+		}
+
+		return insertRefundOutbox(ctx, tx, refund)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if exists {
+		return nil, fmt.Errorf("%s: %w", op, domain.ErrRefundAlreadyExists)
+	}
+
+	return refund, nil
+}
+
+func getRefund(ctx context.Context, tx pgx.Tx, refundId string) (*domain.Refund, error) {
+	selQuery := sq.Select("r.id", "r.payment_id", "r.order_id", "r.amount", "p.currency", "r.reason", "r.status", "r.created_at", "r.updated_at").
+		From(refundsTable + " r").
+		Join(paymentsTable + " p ON p.id = r.payment_id").
+		Where(sq.Eq{"r.id": refundId}).
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := selQuery.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var refund domain.Refund
+	if err := tx.QueryRow(ctx, query, args...).Scan(
+		&refund.ID,
+		&refund.PaymentID,
+		&refund.OrderID,
+		&refund.Amount,
+		&refund.Amount.Currency,
+		&refund.Reason,
+		&refund.Status,
+		&refund.CreatedAt,
+		&refund.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	return &refund, nil
+}
+
+// insertRefundOutbox asks outbox processor to have refund returned by billing (unless it was already) and confirmed.
+func insertRefundOutbox(ctx context.Context, tx pgx.Tx, refund *domain.Refund) error {
+	insQuery := sq.Insert(outboxTable).
+		Columns("topic", "event_type", "payload", "created_at").
+		Values(kafkaPaymentEvents, kafkaRefundRequestedEvent, refund.RefundEvent(), refund.UpdatedAt).
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := insQuery.ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(ctx, query, args...)
+	return err
+}
+
+func lockPaymentOfOrder(ctx context.Context, tx pgx.Tx, orderId string) (*domain.Payment, error) {
+	selQuery := sq.Select("id", "user_id", "order_id", "currency", "total_price", "status", "payment_method", "description", "created_at", "updated_at").
+		From(paymentsTable).
+		Where(sq.Eq{"order_id": orderId}).
+		Suffix("FOR UPDATE").
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := selQuery.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var payment domain.Payment
+	if err = tx.QueryRow(ctx, query, args...).Scan(
+		&payment.ID,
+		&payment.UserID,
+		&payment.OrderID,
+		&payment.Currency,
+		&payment.TotalPrice,
+		&payment.Status,
+		&payment.PaymentMethod,
+		&payment.Description,
+		&payment.CreatedAt,
+		&payment.UpdatedAt,
+	); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrPaymentNotFound
+		}
+		return nil, err
+	}
+	payment.TotalPrice.Currency = payment.Currency.String()
+
+	return &payment, nil
+}
@@ -7,7 +7,9 @@ import (
 
 	"github.com/dzhordano/ecom-thing/services/payment/internal/application/dto"
 	"github.com/dzhordano/ecom-thing/services/payment/internal/application/interfaces"
+	"github.com/dzhordano/ecom-thing/services/payment/internal/domain"
 	api "github.com/dzhordano/ecom-thing/services/payment/pkg/api/payment/v1"
+	"github.com/dzhordano/ecom-thing/services/payment/pkg/money"
 	"github.com/google/uuid"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -40,21 +42,23 @@ func (h *PaymentHandler) CreatePayment(ctx context.Context, req *api.CreatePayme
 		return nil, status.Error(codes.InvalidArgument, "invalid order uuid")
 	}
 
-	userId, err := uuid.Parse(req.Order.GetUserId())
+	userId, err := paymentOwnerFromCtx(ctx, req.Order.GetUserId())
 	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, "invalid user uuid")
+		return nil, err
 	}
 
 	span.AddEvent("call service")
 
+	total := req.Order.GetTotalPrice()
+
 	p, err := h.service.CreatePayment(ctx, dto.CreatePaymentRequest{
-		OrderId:       orderId,
-		UserId:        userId,
-		Currency:      req.Order.GetCurrency(),
-		TotalPrice:    req.Order.GetTotalPrice(),
-		PaymentMethod: req.GetPaymentMethod(),
-		Description:   req.GetPaymentDescription(),
-		RedirectURL:   req.GetRedirectUrl(),
+		OrderId:        orderId,
+		UserId:         userId,
+		TotalPrice:     money.New(total.GetAmount(), total.GetCurrency()),
+		PaymentMethod:  req.GetPaymentMethod(),
+		Description:    req.GetPaymentDescription(),
+		RedirectURL:    req.GetRedirectUrl(),
+		IdempotencyKey: idempotencyKeyFromCtx(ctx),
 	})
 	if err != nil {
 		return nil, err
@@ -83,7 +87,7 @@ func (h *PaymentHandler) GetPaymentStatus(ctx context.Context, req *api.GetPayme
 		return nil, status.Error(codes.InvalidArgument, "invalid payment uuid")
 	}
 
-	userId, err := parseUUIDfromCtx(ctx)
+	userId, err := userIdFromCtx(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -122,7 +126,7 @@ func (h *PaymentHandler) RetryPayment(ctx context.Context, req *api.RetryPayment
 		return nil, status.Error(codes.InvalidArgument, "invalid payment uuid")
 	}
 
-	userId, err := parseUUIDfromCtx(ctx)
+	userId, err := userIdFromCtx(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -158,7 +162,7 @@ func (h *PaymentHandler) CancelPayment(ctx context.Context, req *api.CancelPayme
 		return nil, status.Error(codes.InvalidArgument, "invalid payment uuid")
 	}
 
-	userId, err := parseUUIDfromCtx(ctx)
+	userId, err := userIdFromCtx(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -194,7 +198,7 @@ func (h *PaymentHandler) ConfirmPayment(ctx context.Context, req *api.ConfirmPay
 		return nil, status.Error(codes.InvalidArgument, "invalid payment uuid")
 	}
 
-	userId, err := parseUUIDfromCtx(ctx)
+	userId, err := userIdFromCtx(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -214,16 +218,32 @@ func (h *PaymentHandler) ConfirmPayment(ctx context.Context, req *api.ConfirmPay
 	return &api.ConfirmPaymentResponse{}, nil
 }
 
-func parseUUIDfromCtx(ctx context.Context) (uuid.UUID, error) {
-	userIdStr, ok := ctx.Value("userId").(string)
-	if !ok {
-		return uuid.UUID{}, status.Error(codes.InvalidArgument, "no user uuid in context")
+// userIdFromCtx returns id of user authenticated by auth interceptor.
+// paymentOwnerFromCtx returns user payment is created for. That's the caller, only admin may create payment
+// for other user. Requested user id may be left empty.
+func paymentOwnerFromCtx(ctx context.Context, requested string) (uuid.UUID, error) {
+	callerId, err := userIdFromCtx(ctx)
+	if err != nil || requested == "" {
+		return callerId, err
 	}
 
-	userId, err := uuid.Parse(userIdStr)
+	userId, err := uuid.Parse(requested)
 	if err != nil {
 		return uuid.UUID{}, status.Error(codes.InvalidArgument, "invalid user uuid")
 	}
 
+	if p, _ := domain.PrincipalFromContext(ctx); userId != callerId && !p.HasRole(domain.RoleAdmin) {
+		return uuid.UUID{}, status.Error(codes.PermissionDenied, "payment can't be created for other user")
+	}
+
 	return userId, nil
 }
+
+func userIdFromCtx(ctx context.Context) (uuid.UUID, error) {
+	p, ok := domain.PrincipalFromContext(ctx)
+	if !ok || p.UserID == uuid.Nil {
+		return uuid.UUID{}, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+
+	return p.UserID, nil
+}
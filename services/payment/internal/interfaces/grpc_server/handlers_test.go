@@ -2,9 +2,11 @@ package grpc_server
 
 import (
 	"context"
+	"github.com/dzhordano/ecom-thing/services/payment/internal/application/dto"
 	"github.com/dzhordano/ecom-thing/services/payment/internal/domain"
 	mock_interfaces "github.com/dzhordano/ecom-thing/services/payment/internal/interfaces/grpc_server/mocks"
 	api "github.com/dzhordano/ecom-thing/services/payment/pkg/api/payment/v1"
+	"github.com/dzhordano/ecom-thing/services/payment/pkg/money"
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -16,7 +18,89 @@ import (
 
 // TODO
 func TestPaymentHandler_CreatePayment(t *testing.T) {
-	t.Skip("todo")
+	testOrderId := uuid.New()
+	testUserId := uuid.New()
+	otherUserId := uuid.New()
+
+	principal := func(userId uuid.UUID, roles ...domain.Role) context.Context {
+		return domain.ContextWithPrincipal(context.Background(), domain.Principal{UserID: userId, Roles: roles})
+	}
+
+	tests := []struct {
+		name          string
+		ctx           context.Context
+		userId        string
+		expectedOwner uuid.UUID
+		expectedErr   error
+	}{
+		{
+			name:          "OK",
+			ctx:           principal(testUserId, domain.RoleUser),
+			userId:        testUserId.String(),
+			expectedOwner: testUserId,
+		},
+		{
+			name:          "OWNER IS CALLER IF NOT REQUESTED",
+			ctx:           principal(testUserId, domain.RoleUser),
+			expectedOwner: testUserId,
+		},
+		{
+			name:        "OTHER USER",
+			ctx:         principal(testUserId, domain.RoleUser),
+			userId:      otherUserId.String(),
+			expectedErr: status.Error(codes.PermissionDenied, "payment can't be created for other user"),
+		},
+		{
+			name:          "ADMIN FOR OTHER USER",
+			ctx:           principal(testUserId, domain.RoleAdmin),
+			userId:        otherUserId.String(),
+			expectedOwner: otherUserId,
+		},
+		{
+			name:        "INVALID USER UUID",
+			ctx:         principal(testUserId, domain.RoleUser),
+			userId:      "invalid uuid",
+			expectedErr: status.Error(codes.InvalidArgument, "invalid user uuid"),
+		},
+		{
+			name:        "UNAUTHENTICATED",
+			ctx:         context.Background(),
+			userId:      testUserId.String(),
+			expectedErr: status.Error(codes.Unauthenticated, "unauthenticated"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			paymentService := mock_interfaces.NewMockPaymentService(ctrl)
+			paymentId := uuid.New()
+			if test.expectedErr == nil {
+				paymentService.EXPECT().CreatePayment(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, req dto.CreatePaymentRequest) (*domain.Payment, error) {
+						assert.Equal(t, testOrderId, req.OrderId)
+						assert.Equal(t, test.expectedOwner, req.UserId)
+						assert.Equal(t, money.New(1050, "USD"), req.TotalPrice)
+						return &domain.Payment{ID: paymentId}, nil
+					}).Times(1)
+			}
+
+			h := NewPaymentHandler(paymentService)
+			resp, err := h.CreatePayment(test.ctx, &api.CreatePaymentRequest{
+				Order: &api.Order{Id: testOrderId.String(), UserId: test.userId, TotalPrice: &api.Money{Amount: 1050, Currency: "USD"}},
+			})
+
+			if test.expectedErr != nil {
+				assert.Equal(t, test.expectedErr, err)
+				assert.Nil(t, resp)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, paymentId.String(), resp.Id)
+			}
+		})
+	}
 }
 
 func TestPaymentHandler_RetryPayment(t *testing.T) {
@@ -35,7 +119,7 @@ func TestPaymentHandler_RetryPayment(t *testing.T) {
 	}{
 		{
 			name: "OK",
-			ctx:  context.WithValue(context.Background(), "userId", testUserId.String()),
+			ctx:  domain.ContextWithPrincipal(context.Background(), domain.Principal{UserID: testUserId}),
 			req: &api.RetryPaymentRequest{
 				Id: testPaymentId.String(),
 			},
@@ -51,7 +135,7 @@ func TestPaymentHandler_RetryPayment(t *testing.T) {
 		},
 		{
 			name: "INVALID UUID",
-			ctx:  context.WithValue(context.Background(), "userId", testUserId.String()),
+			ctx:  domain.ContextWithPrincipal(context.Background(), domain.Principal{UserID: testUserId}),
 			req: &api.RetryPaymentRequest{
 				Id: "invalid uuid",
 			},
@@ -61,7 +145,7 @@ func TestPaymentHandler_RetryPayment(t *testing.T) {
 		},
 		{
 			name: "ERROR",
-			ctx:  context.WithValue(context.Background(), "userId", testUserId.String()),
+			ctx:  domain.ContextWithPrincipal(context.Background(), domain.Principal{UserID: testUserId}),
 			req: &api.RetryPaymentRequest{
 				Id: testPaymentId.String(),
 			},
@@ -116,7 +200,7 @@ func TestPaymentHandler_GetPaymentStatus(t *testing.T) {
 	}{
 		{
 			name: "OK",
-			ctx:  context.WithValue(context.Background(), "userId", testUserId.String()),
+			ctx:  domain.ContextWithPrincipal(context.Background(), domain.Principal{UserID: testUserId}),
 			req: &api.GetPaymentStatusRequest{
 				Id: testPaymentId.String(),
 			},
@@ -134,7 +218,7 @@ func TestPaymentHandler_GetPaymentStatus(t *testing.T) {
 		},
 		{
 			name: "INVALID UUID",
-			ctx:  context.WithValue(context.Background(), "userId", testUserId.String()),
+			ctx:  domain.ContextWithPrincipal(context.Background(), domain.Principal{UserID: testUserId}),
 			req: &api.GetPaymentStatusRequest{
 				Id: "invalid uuid",
 			},
@@ -143,18 +227,18 @@ func TestPaymentHandler_GetPaymentStatus(t *testing.T) {
 			expectedErr:   status.Error(codes.InvalidArgument, "invalid payment uuid"),
 		},
 		{
-			name: "INVALID USER UUID",
-			ctx:  context.WithValue(context.Background(), "userId", "invalid uuid"),
+			name: "UNAUTHENTICATED",
+			ctx:  context.Background(),
 			req: &api.GetPaymentStatusRequest{
 				Id: testPaymentId.String(),
 			},
 			mockBehaviour: func(s *mock_interfaces.MockPaymentService, paymentId, userId uuid.UUID) {},
 			expectedResp:  nil,
-			expectedErr:   status.Error(codes.InvalidArgument, "invalid user uuid"),
+			expectedErr:   status.Error(codes.Unauthenticated, "unauthenticated"),
 		},
 		{
 			name: "ERROR",
-			ctx:  context.WithValue(context.Background(), "userId", testUserId.String()),
+			ctx:  domain.ContextWithPrincipal(context.Background(), domain.Principal{UserID: testUserId}),
 			req: &api.GetPaymentStatusRequest{
 				Id: testPaymentId.String(),
 			},
@@ -209,7 +293,7 @@ func TestPaymentHandler_CancelPayment(t *testing.T) {
 	}{
 		{
 			name: "OK",
-			ctx:  context.WithValue(context.Background(), "userId", testUserId.String()),
+			ctx:  domain.ContextWithPrincipal(context.Background(), domain.Principal{UserID: testUserId}),
 			req: &api.CancelPaymentRequest{
 				Id: testPaymentId.String(),
 			},
@@ -225,7 +309,7 @@ func TestPaymentHandler_CancelPayment(t *testing.T) {
 		},
 		{
 			name: "INVALID UUID",
-			ctx:  context.WithValue(context.Background(), "userId", testUserId.String()),
+			ctx:  domain.ContextWithPrincipal(context.Background(), domain.Principal{UserID: testUserId}),
 			req: &api.CancelPaymentRequest{
 				Id: "invalid uuid",
 			},
@@ -234,18 +318,18 @@ func TestPaymentHandler_CancelPayment(t *testing.T) {
 			expectedErr:   status.Error(codes.InvalidArgument, "invalid payment uuid"),
 		},
 		{
-			name: "INVALID USER UUID",
-			ctx:  context.WithValue(context.Background(), "userId", "invalid uuid"),
+			name: "UNAUTHENTICATED",
+			ctx:  context.Background(),
 			req: &api.CancelPaymentRequest{
 				Id: testPaymentId.String(),
 			},
 			mockBehaviour: func(s *mock_interfaces.MockPaymentService, paymentId, userId uuid.UUID) {},
 			expectedResp:  nil,
-			expectedErr:   status.Error(codes.InvalidArgument, "invalid user uuid"),
+			expectedErr:   status.Error(codes.Unauthenticated, "unauthenticated"),
 		},
 		{
 			name: "ERROR",
-			ctx:  context.WithValue(context.Background(), "userId", testUserId.String()),
+			ctx:  domain.ContextWithPrincipal(context.Background(), domain.Principal{UserID: testUserId}),
 			req: &api.CancelPaymentRequest{
 				Id: testPaymentId.String(),
 			},
@@ -300,7 +384,7 @@ func TestPaymentHandler_ConfirmPayment(t *testing.T) {
 	}{
 		{
 			name: "OK",
-			ctx:  context.WithValue(context.Background(), "userId", testUserId.String()),
+			ctx:  domain.ContextWithPrincipal(context.Background(), domain.Principal{UserID: testUserId}),
 			req: &api.ConfirmPaymentRequest{
 				Id: testPaymentId.String(),
 			},
@@ -316,7 +400,7 @@ func TestPaymentHandler_ConfirmPayment(t *testing.T) {
 		},
 		{
 			name: "INVALID UUID",
-			ctx:  context.WithValue(context.Background(), "userId", testUserId.String()),
+			ctx:  domain.ContextWithPrincipal(context.Background(), domain.Principal{UserID: testUserId}),
 			req: &api.ConfirmPaymentRequest{
 				Id: "invalid uuid",
 			},
@@ -325,18 +409,18 @@ func TestPaymentHandler_ConfirmPayment(t *testing.T) {
 			expectedErr:   status.Error(codes.InvalidArgument, "invalid payment uuid"),
 		},
 		{
-			name: "INVALID USER UUID",
-			ctx:  context.WithValue(context.Background(), "userId", "invalid uuid"),
+			name: "UNAUTHENTICATED",
+			ctx:  context.Background(),
 			req: &api.ConfirmPaymentRequest{
 				Id: testPaymentId.String(),
 			},
 			mockBehaviour: func(s *mock_interfaces.MockPaymentService, paymentId, userId uuid.UUID) {},
 			expectedResp:  nil,
-			expectedErr:   status.Error(codes.InvalidArgument, "invalid user uuid"),
+			expectedErr:   status.Error(codes.Unauthenticated, "unauthenticated"),
 		},
 		{
 			name: "ERROR",
-			ctx:  context.WithValue(context.Background(), "userId", testUserId.String()),
+			ctx:  domain.ContextWithPrincipal(context.Background(), domain.Principal{UserID: testUserId}),
 			req: &api.ConfirmPaymentRequest{
 				Id: testPaymentId.String(),
 			},
@@ -375,7 +459,7 @@ func TestPaymentHandler_ConfirmPayment(t *testing.T) {
 	}
 }
 
-func Test_parseUUIDfromCtx(t *testing.T) {
+func Test_userIdFromCtx(t *testing.T) {
 	testUserId := uuid.New()
 
 	type args struct {
@@ -390,15 +474,15 @@ func Test_parseUUIDfromCtx(t *testing.T) {
 		{
 			name: "OK",
 			args: args{
-				ctx: context.WithValue(context.Background(), "userId", testUserId.String()),
+				ctx: domain.ContextWithPrincipal(context.Background(), domain.Principal{UserID: testUserId}),
 			},
 			want:    testUserId,
 			wantErr: false,
 		},
 		{
-			name: "INVALID UUID",
+			name: "NO PRINCIPAL",
 			args: args{
-				ctx: context.WithValue(context.Background(), "userId", "invalid uuid"),
+				ctx: context.Background(),
 			},
 			want:    uuid.UUID{},
 			wantErr: true,
@@ -407,13 +491,13 @@ func Test_parseUUIDfromCtx(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := parseUUIDfromCtx(tt.args.ctx)
+			got, err := userIdFromCtx(tt.args.ctx)
 			if (err != nil) != tt.wantErr {
-				t.Errorf("parseUUIDfromCtx() error = %v, wantErr %v", err, tt.wantErr)
+				t.Errorf("userIdFromCtx() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
 			if !reflect.DeepEqual(got, tt.want) {
-				t.Errorf("parseUUIDfromCtx() got = %v, want %v", got, tt.want)
+				t.Errorf("userIdFromCtx() got = %v, want %v", got, tt.want)
 			}
 		})
 	}
@@ -0,0 +1,33 @@
+package grpc_server
+
+import (
+	"context"
+	"net/textproto"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc/metadata"
+)
+
+// IdempotencyKeyMD is metadata key of optional idempotency key for create requests.
+// Over HTTP it is passed as Idempotency-Key header.
+const IdempotencyKeyMD = "idempotency-key"
+
+var idempotencyKeyHeader = textproto.CanonicalMIMEHeaderKey(IdempotencyKeyMD)
+
+// gatewayHeaderMatcher forwards Idempotency-Key header to gRPC as is, other headers are handled by default matcher.
+func gatewayHeaderMatcher(key string) (string, bool) {
+	if textproto.CanonicalMIMEHeaderKey(key) == idempotencyKeyHeader {
+		return IdempotencyKeyMD, true
+	}
+
+	return runtime.DefaultHeaderMatcher(key)
+}
+
+func idempotencyKeyFromCtx(ctx context.Context) string {
+	values := metadata.ValueFromIncomingContext(ctx, IdempotencyKeyMD)
+	if len(values) == 0 {
+		return ""
+	}
+
+	return values[0]
+}
@@ -0,0 +1,205 @@
+package interceptors
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/dzhordano/ecom-thing/services/payment/internal/domain"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+var testSecret = []byte("test-secret")
+
+func signHMAC(t *testing.T, claims Claims) string {
+	t.Helper()
+
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(testSecret)
+	require.NoError(t, err)
+
+	return token
+}
+
+func validClaims(userId uuid.UUID, roles ...string) Claims {
+	return Claims{
+		Roles: roles,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   userId.String(),
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		},
+	}
+}
+
+func TestAuthenticator_UnaryServerInterceptor(t *testing.T) {
+	userId := uuid.New()
+
+	expired := validClaims(userId)
+	expired.ExpiresAt = jwt.NewNumericDate(time.Now().Add(-time.Minute))
+
+	tests := []struct {
+		name          string
+		md            metadata.MD
+		expectedCode  codes.Code
+		expectedRoles []domain.Role
+		anonymous     bool
+	}{
+		{
+			name:          "OK",
+			md:            metadata.Pairs("authorization", "Bearer "+signHMAC(t, validClaims(userId, "user"))),
+			expectedCode:  codes.OK,
+			expectedRoles: []domain.Role{domain.RoleUser},
+		},
+		{
+			name:          "SYSTEM ROLE STRIPPED",
+			md:            metadata.Pairs("authorization", "Bearer "+signHMAC(t, validClaims(userId, "system", "admin"))),
+			expectedCode:  codes.OK,
+			expectedRoles: []domain.Role{domain.RoleAdmin},
+		},
+		{
+			name:         "NO METADATA",
+			md:           nil,
+			expectedCode: codes.OK,
+			anonymous:    true,
+		},
+		{
+			name:         "NOT BEARER",
+			md:           metadata.Pairs("authorization", "Basic dXNlcjpwYXNz"),
+			expectedCode: codes.Unauthenticated,
+		},
+		{
+			name:         "EXPIRED",
+			md:           metadata.Pairs("authorization", "Bearer "+signHMAC(t, expired)),
+			expectedCode: codes.Unauthenticated,
+		},
+		{
+			name:         "INVALID SUBJECT",
+			md:           metadata.Pairs("authorization", "Bearer "+signHMAC(t, Claims{RegisteredClaims: jwt.RegisteredClaims{Subject: "nope", ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour))}})),
+			expectedCode: codes.Unauthenticated,
+		},
+		{
+			name:         "WRONG SECRET",
+			md:           metadata.Pairs("authorization", "Bearer "+mustSign(jwt.SigningMethodHS256, validClaims(userId), []byte("other"))),
+			expectedCode: codes.Unauthenticated,
+		},
+	}
+
+	a, err := NewHMACAuthenticator(testSecret)
+	require.NoError(t, err)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.md != nil {
+				ctx = metadata.NewIncomingContext(ctx, tt.md)
+			}
+
+			var (
+				got      domain.Principal
+				hasPrinc bool
+			)
+			handler := func(ctx context.Context, req any) (any, error) {
+				got, hasPrinc = domain.PrincipalFromContext(ctx)
+				return nil, nil
+			}
+
+			_, err := a.UnaryServerInterceptor()(ctx, nil, &grpc.UnaryServerInfo{}, handler)
+
+			assert.Equal(t, tt.expectedCode, status.Code(err))
+			if tt.anonymous {
+				assert.False(t, hasPrinc)
+				return
+			}
+			if tt.expectedCode == codes.OK {
+				assert.Equal(t, userId, got.UserID)
+				assert.Equal(t, tt.expectedRoles, got.Roles)
+			}
+		})
+	}
+}
+
+func TestNewJWKSAuthenticator(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	writeJWKS(t, path, "k1", &key.PublicKey)
+
+	a, err := NewJWKSAuthenticator(path)
+	require.NoError(t, err)
+
+	userId := uuid.New()
+
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, validClaims(userId, "user"))
+	token.Header["kid"] = "k1"
+	signed, err := token.SignedString(key)
+	require.NoError(t, err)
+
+	p, err := a.Authenticate(signed)
+	require.NoError(t, err)
+	assert.Equal(t, userId, p.UserID)
+
+	// Rotated key is picked up on unknown kid.
+	rotated, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	writeJWKS(t, path, "k2", &rotated.PublicKey)
+
+	token = jwt.NewWithClaims(jwt.SigningMethodRS256, validClaims(userId, "user"))
+	token.Header["kid"] = "k2"
+	signed, err = token.SignedString(rotated)
+	require.NoError(t, err)
+
+	_, err = a.Authenticate(signed)
+	assert.NoError(t, err)
+
+	// HMAC token must not be accepted by RSA authenticator.
+	_, err = a.Authenticate(signHMAC(t, validClaims(userId)))
+	assert.Error(t, err)
+}
+
+func mustSign(m jwt.SigningMethod, claims Claims, key any) string {
+	s, err := jwt.NewWithClaims(m, claims).SignedString(key)
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+func writeJWKS(t *testing.T, path, kid string, pub *rsa.PublicKey) {
+	t.Helper()
+
+	data, err := json.Marshal(map[string]any{
+		"keys": []map[string]string{
+			{
+				"kty": "RSA",
+				"kid": kid,
+				"alg": "RS256",
+				"n":   base64.RawURLEncoding.EncodeToString(pub.N.Bytes()),
+				"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes()),
+			},
+		},
+	})
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(path, data, 0o600))
+}
+
+func TestNewHMACAuthenticator_EmptySecret(t *testing.T) {
+	_, err := NewHMACAuthenticator(nil)
+	assert.ErrorIs(t, err, ErrEmptySecret)
+
+	_, err = NewHMACAuthenticator([]byte{})
+	assert.ErrorIs(t, err, ErrEmptySecret)
+}
@@ -10,10 +10,62 @@ import (
 
 	dto "github.com/dzhordano/ecom-thing/services/payment/internal/application/dto"
 	domain "github.com/dzhordano/ecom-thing/services/payment/internal/domain"
+	money "github.com/dzhordano/ecom-thing/services/payment/pkg/money"
 	gomock "github.com/golang/mock/gomock"
 	uuid "github.com/google/uuid"
 )
 
+// MockBilling is a mock of Billing interface.
+type MockBilling struct {
+	ctrl     *gomock.Controller
+	recorder *MockBillingMockRecorder
+}
+
+// MockBillingMockRecorder is the mock recorder for MockBilling.
+type MockBillingMockRecorder struct {
+	mock *MockBilling
+}
+
+// NewMockBilling creates a new mock instance.
+func NewMockBilling(ctrl *gomock.Controller) *MockBilling {
+	mock := &MockBilling{ctrl: ctrl}
+	mock.recorder = &MockBillingMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBilling) EXPECT() *MockBillingMockRecorder {
+	return m.recorder
+}
+
+// NewPayment mocks base method.
+func (m *MockBilling) NewPayment(ctx context.Context, totalPrice money.Money, paymentDescription string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewPayment", ctx, totalPrice, paymentDescription)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// NewPayment indicates an expected call of NewPayment.
+func (mr *MockBillingMockRecorder) NewPayment(ctx, totalPrice, paymentDescription interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewPayment", reflect.TypeOf((*MockBilling)(nil).NewPayment), ctx, totalPrice, paymentDescription)
+}
+
+// Refund mocks base method.
+func (m *MockBilling) Refund(ctx context.Context, amount money.Money, reason string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Refund", ctx, amount, reason)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Refund indicates an expected call of Refund.
+func (mr *MockBillingMockRecorder) Refund(ctx, amount, reason interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Refund", reflect.TypeOf((*MockBilling)(nil).Refund), ctx, amount, reason)
+}
+
 // MockPaymentService is a mock of PaymentService interface.
 type MockPaymentService struct {
 	ctrl     *gomock.Controller
@@ -37,6 +89,20 @@ func (m *MockPaymentService) EXPECT() *MockPaymentServiceMockRecorder {
 	return m.recorder
 }
 
+// CancelOrderPayment mocks base method.
+func (m *MockPaymentService) CancelOrderPayment(ctx context.Context, orderId uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelOrderPayment", ctx, orderId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CancelOrderPayment indicates an expected call of CancelOrderPayment.
+func (mr *MockPaymentServiceMockRecorder) CancelOrderPayment(ctx, orderId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelOrderPayment", reflect.TypeOf((*MockPaymentService)(nil).CancelOrderPayment), ctx, orderId)
+}
+
 // CancelPayment mocks base method.
 func (m *MockPaymentService) CancelPayment(ctx context.Context, paymentId, userId uuid.UUID) error {
 	m.ctrl.T.Helper()
@@ -95,6 +161,21 @@ func (mr *MockPaymentServiceMockRecorder) GetPaymentStatus(ctx, paymentId, userI
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPaymentStatus", reflect.TypeOf((*MockPaymentService)(nil).GetPaymentStatus), ctx, paymentId, userId)
 }
 
+// RefundPayment mocks base method.
+func (m *MockPaymentService) RefundPayment(ctx context.Context, req dto.RefundPaymentRequest) (*domain.Refund, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefundPayment", ctx, req)
+	ret0, _ := ret[0].(*domain.Refund)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RefundPayment indicates an expected call of RefundPayment.
+func (mr *MockPaymentServiceMockRecorder) RefundPayment(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefundPayment", reflect.TypeOf((*MockPaymentService)(nil).RefundPayment), ctx, req)
+}
+
 // RetryPayment mocks base method.
 func (m *MockPaymentService) RetryPayment(ctx context.Context, paymentId, userId uuid.UUID) error {
 	m.ctrl.T.Helper()
@@ -0,0 +1,16 @@
+package grpc_server
+
+import (
+	"github.com/dzhordano/ecom-thing/services/payment/internal/domain"
+	"github.com/dzhordano/ecom-thing/services/payment/internal/interfaces/grpc_server/interceptors"
+	api "github.com/dzhordano/ecom-thing/services/payment/pkg/api/payment/v1"
+)
+
+// methodPolicy mirrors security scopes declared in payment.proto.
+var methodPolicy = interceptors.Policy{
+	api.PaymentService_CreatePayment_FullMethodName:    {domain.RoleUser, domain.RoleAdmin},
+	api.PaymentService_GetPaymentStatus_FullMethodName: {domain.RoleUser, domain.RoleAdmin},
+	api.PaymentService_CancelPayment_FullMethodName:    {domain.RoleUser, domain.RoleAdmin},
+	api.PaymentService_ConfirmPayment_FullMethodName:   {domain.RoleUser, domain.RoleAdmin},
+	api.PaymentService_RetryPayment_FullMethodName:     {domain.RoleUser, domain.RoleAdmin},
+}
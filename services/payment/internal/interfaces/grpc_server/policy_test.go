@@ -0,0 +1,78 @@
+package grpc_server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/dzhordano/ecom-thing/services/payment/internal/domain"
+	"github.com/dzhordano/ecom-thing/services/payment/internal/interfaces/grpc_server/interceptors"
+	api "github.com/dzhordano/ecom-thing/services/payment/pkg/api/payment/v1"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestMethodPolicy_CoversAllMethods(t *testing.T) {
+	for _, m := range api.PaymentService_ServiceDesc.Methods {
+		method := "/" + api.PaymentService_ServiceDesc.ServiceName + "/" + m.MethodName
+		_, ok := methodPolicy[method]
+		assert.True(t, ok, "no policy for %s", method)
+	}
+}
+
+func TestAuthorizationInterceptor(t *testing.T) {
+	anonymous := context.Background()
+	user := domain.ContextWithPrincipal(context.Background(), domain.Principal{
+		UserID: uuid.New(),
+		Roles:  []domain.Role{domain.RoleUser},
+	})
+	admin := domain.ContextWithPrincipal(context.Background(), domain.Principal{
+		UserID: uuid.New(),
+		Roles:  []domain.Role{domain.RoleAdmin},
+	})
+	noRoles := domain.ContextWithPrincipal(context.Background(), domain.Principal{
+		UserID: uuid.New(),
+	})
+
+	tests := []struct {
+		method    string
+		anonymous codes.Code
+		noRoles   codes.Code
+		user      codes.Code
+		admin     codes.Code
+	}{
+		{api.PaymentService_CreatePayment_FullMethodName, codes.Unauthenticated, codes.PermissionDenied, codes.OK, codes.OK},
+		{api.PaymentService_GetPaymentStatus_FullMethodName, codes.Unauthenticated, codes.PermissionDenied, codes.OK, codes.OK},
+		{api.PaymentService_CancelPayment_FullMethodName, codes.Unauthenticated, codes.PermissionDenied, codes.OK, codes.OK},
+		{api.PaymentService_ConfirmPayment_FullMethodName, codes.Unauthenticated, codes.PermissionDenied, codes.OK, codes.OK},
+		{api.PaymentService_RetryPayment_FullMethodName, codes.Unauthenticated, codes.PermissionDenied, codes.OK, codes.OK},
+		{"/api.payment.v1.PaymentService/Unknown", codes.PermissionDenied, codes.PermissionDenied, codes.PermissionDenied, codes.PermissionDenied},
+	}
+
+	interceptor := interceptors.AuthorizationInterceptor(methodPolicy)
+	handler := func(ctx context.Context, req any) (any, error) {
+		return nil, nil
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.method, func(t *testing.T) {
+			info := &grpc.UnaryServerInfo{FullMethod: tt.method}
+
+			for _, c := range []struct {
+				caller   string
+				ctx      context.Context
+				expected codes.Code
+			}{
+				{"anonymous", anonymous, tt.anonymous},
+				{"no roles", noRoles, tt.noRoles},
+				{"user", user, tt.user},
+				{"admin", admin, tt.admin},
+			} {
+				_, err := interceptor(c.ctx, nil, info, handler)
+				assert.Equal(t, c.expected, status.Code(err), c.caller)
+			}
+		})
+	}
+}
@@ -42,6 +42,8 @@ type Server struct {
 
 	cb *gobreaker.Settings
 	tp *tracesdk.TracerProvider
+
+	auth *interceptors.Authenticator
 }
 
 func WithAddr(addr string) Option {
@@ -62,6 +64,13 @@ func WithTracerProvider(tp *tracesdk.TracerProvider) Option {
 	}
 }
 
+// WithAuthenticator sets authenticator used to resolve caller identity from bearer token.
+func WithAuthenticator(a *interceptors.Authenticator) Option {
+	return func(s *Server) {
+		s.auth = a
+	}
+}
+
 func WithRateLimiter(limit, burst int) Option {
 	return func(s *Server) {
 		s.ratelimiterLimit = limit
@@ -101,6 +110,10 @@ func MustNew(log logger.Logger, handler api.PaymentServiceServer, opts ...Option
 		panic("addr is required")
 	}
 
+	if s.auth == nil {
+		panic("authenticator is required")
+	}
+
 	recoveryOpts := []recovery.Option{
 		recovery.WithRecoveryHandler(func(p interface{}) (err error) {
 			log.Error("Recovered from panic", "panic", p)
@@ -141,6 +154,8 @@ func MustNew(log logger.Logger, handler api.PaymentServiceServer, opts ...Option
 			ratelimiter.RateLimiterInterceptor(),
 			recovery.UnaryServerInterceptor(recoveryOpts...),
 			logging.UnaryServerInterceptor(interceptors.InterceptorLogger(log), loggingOpts...),
+			s.auth.UnaryServerInterceptor(),
+			interceptors.AuthorizationInterceptor(methodPolicy),
 			interceptors.ErrorMapperInterceptor(),
 			interceptors.MetricsInterceptor(),
 		),
@@ -180,7 +195,9 @@ func (s *Server) Run(ctx context.Context) error {
 		return err
 	}
 
-	gwMux := runtime.NewServeMux()
+	gwMux := runtime.NewServeMux(
+		runtime.WithIncomingHeaderMatcher(gatewayHeaderMatcher),
+	)
 	dialOpts := []grpc.DialOption{
 		grpc.WithTransportCredentials(
 			insecure.NewCredentials(),
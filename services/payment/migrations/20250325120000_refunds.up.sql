@@ -0,0 +1,13 @@
+-- Refund id comes from order service, so refund requested again is recognized.
+CREATE TABLE IF NOT EXISTS refunds(
+  id UUID PRIMARY KEY,
+  payment_id UUID NOT NULL REFERENCES payments(id) ON DELETE CASCADE,
+  order_id UUID NOT NULL,
+  amount DECIMAL(10, 2) NOT NULL,
+  reason VARCHAR(255) NOT NULL DEFAULT '',
+  status VARCHAR(50) NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL,
+  updated_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS refunds_payment_id_idx ON refunds (payment_id);
@@ -0,0 +1,79 @@
+package money
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    int64
+		wantErr bool
+	}{
+		{in: "12.34", want: 1234},
+		{in: "12.3", want: 1230},
+		{in: "12", want: 1200},
+		{in: ".5", want: 50},
+		{in: "-0.05", want: -5},
+		{in: "12.345", wantErr: true},
+		{in: "1e3", wantErr: true},
+		{in: "", wantErr: true},
+		{in: "-", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := Parse(tt.in, "USD")
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidAmount)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, New(tt.want, "USD"), got)
+		})
+	}
+}
+
+func TestFromFloat(t *testing.T) {
+	assert.Equal(t, int64(101), FromFloat(1.005, "").Amount)
+	assert.Equal(t, int64(-101), FromFloat(-1.005, "").Amount)
+	assert.Equal(t, int64(9999), FromFloat(99.99, "").Amount)
+	assert.Equal(t, int64(30), FromFloat(0.1+0.2, "").Amount)
+	assert.Equal(t, int64(0), FromFloat(0.004, "").Amount)
+}
+
+func TestMoney_String(t *testing.T) {
+	assert.Equal(t, "12.34", New(1234, "").String())
+	assert.Equal(t, "0.05", New(5, "").String())
+	assert.Equal(t, "-0.05", New(-5, "").String())
+	assert.Equal(t, "-12.00", New(-1200, "").String())
+}
+
+func TestMoney_Percent(t *testing.T) {
+	// 10% of 0.99 is 0.099.
+	assert.Equal(t, int64(10), New(99, "").Percent(10).Amount)
+	// 15% of 0.10 is 0.015, half goes up.
+	assert.Equal(t, int64(2), New(10, "").Percent(15).Amount)
+	assert.Equal(t, int64(-2), New(-10, "").Percent(15).Amount)
+	// Fractional percentage is applied exactly.
+	assert.Equal(t, int64(1250), New(10000, "").Percent(12.5).Amount)
+}
+
+func TestMoney_MulRatio(t *testing.T) {
+	// Discount of 1.00 on 3 units scaled to 2 units.
+	assert.Equal(t, int64(67), New(100, "").MulRatio(2, 3).Amount)
+	assert.Equal(t, int64(0), New(100, "").MulRatio(1, 0).Amount)
+}
+
+func TestMoney_Scan(t *testing.T) {
+	m := New(0, "RUB")
+	assert.NoError(t, m.Scan("99.99"))
+	assert.Equal(t, New(9999, "RUB"), m)
+
+	assert.NoError(t, m.Scan(float64(0.3)))
+	assert.Equal(t, int64(30), m.Amount)
+
+	assert.Error(t, m.Scan(true))
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/dzhordano/ecom-thing/services/payment/internal/domain/repository"
 	"github.com/dzhordano/ecom-thing/services/payment/internal/infrastructure/repository/pg"
 	"github.com/dzhordano/ecom-thing/services/payment/pkg/logger"
+	"github.com/dzhordano/ecom-thing/services/payment/pkg/money"
 	"github.com/golang-migrate/migrate/v4"
 	_ "github.com/golang-migrate/migrate/v4/database/postgres"
 	_ "github.com/golang-migrate/migrate/v4/source/file"
@@ -84,7 +85,7 @@ func (s *Suite) SetupSuite() {
 
 	s.db = pool
 	s.repo = pg.NewPaymentRepository(s.db)
-	s.svc = service.NewPaymerService(testLogger, s.repo)
+	s.svc = service.NewPaymerService(testLogger, s.repo, pg.NewIdempotencyRepository(s.db), time.Hour)
 }
 
 func (s *Suite) TearDownSuite() {
@@ -105,7 +106,7 @@ func (s *Suite) SetupTest() {
 		UserID:        uuid.New(),
 		OrderID:       uuid.New(),
 		Currency:      domain.USD,
-		TotalPrice:    99.99,
+		TotalPrice:    money.New(9999, "USD"),
 		PaymentMethod: domain.PaymentMethodCard,
 		Description:   "Test Description",
 		RedirectURL:   "https://test.some-url.com/bruh",
@@ -129,8 +130,7 @@ func (s *Suite) Test_CreatePayment() {
 	newPayment, err := domain.NewPayment(
 		uuid.New(),
 		uuid.New(),
-		domain.USD.String(),
-		99.99,
+		money.New(9999, domain.USD.String()),
 		domain.PaymentMethodCard.String(),
 		"Test Description",
 		"https://test.some-url.com/bruh",
@@ -142,7 +142,6 @@ func (s *Suite) Test_CreatePayment() {
 	p, err := s.svc.CreatePayment(context.Background(), dto.CreatePaymentRequest{
 		OrderId:       newPayment.OrderID,
 		UserId:        newPayment.UserID,
-		Currency:      newPayment.Currency.String(),
 		TotalPrice:    newPayment.TotalPrice,
 		PaymentMethod: newPayment.PaymentMethod.String(),
 		Description:   newPayment.Description,
@@ -167,6 +166,33 @@ func (s *Suite) Test_CreatePayment() {
 	s.Equal(p.UpdatedAt.Unix(), sp.UpdatedAt.Unix())
 }
 
+func (s *Suite) Test_CreatePayment_IdempotencyKey() {
+	req := dto.CreatePaymentRequest{
+		OrderId:        uuid.New(),
+		UserId:         uuid.New(),
+		TotalPrice:     money.New(9999, domain.USD.String()),
+		PaymentMethod:  domain.PaymentMethodCard.String(),
+		Description:    "Test Description",
+		RedirectURL:    "https://test.some-url.com/bruh",
+		IdempotencyKey: uuid.NewString(),
+	}
+	defer func() {
+		_, err := s.db.Exec(context.Background(), "DELETE FROM idempotency_keys WHERE key = $1", req.IdempotencyKey)
+		s.NoError(err)
+	}()
+
+	p, err := s.svc.CreatePayment(context.Background(), req)
+	s.NoError(err)
+
+	replayed, err := s.svc.CreatePayment(context.Background(), req)
+	s.NoError(err)
+	s.Equal(p.ID, replayed.ID)
+
+	req.Description = "Another Description"
+	_, err = s.svc.CreatePayment(context.Background(), req)
+	s.ErrorIs(err, domain.ErrIdempotencyKeyReused)
+}
+
 func (s *Suite) Test_CancelPayment() {
 	err := s.svc.CancelPayment(context.Background(), s.testPayment1.ID, s.testPayment1.UserID)
 
@@ -179,6 +205,22 @@ func (s *Suite) Test_CancelPayment() {
 	s.Equal(p.Status, domain.PaymentCancelled)
 }
 
+func (s *Suite) Test_CancelOrderPayment() {
+	err := s.svc.CancelOrderPayment(context.Background(), s.testPayment1.OrderID)
+
+	s.NoError(err)
+
+	p, err := s.repo.GetByOrderId(context.Background(), s.testPayment1.OrderID.String())
+
+	s.NoError(err)
+
+	s.Equal(domain.PaymentCancelled, p.Status)
+
+	// Already cancelled or missing payment is not an error.
+	s.NoError(s.svc.CancelOrderPayment(context.Background(), s.testPayment1.OrderID))
+	s.NoError(s.svc.CancelOrderPayment(context.Background(), uuid.New()))
+}
+
 func (s *Suite) Test_ConfirmPayment() {
 	err := s.svc.ConfirmPayment(context.Background(), s.testPayment1.ID, s.testPayment1.UserID)
 
@@ -209,6 +251,46 @@ func (s *Suite) Test_RetryPayment() {
 	s.Equal(domain.PaymentPending, p.Status)
 }
 
+func (s *Suite) Test_RefundPayment() {
+	ctx := context.Background()
+	s.NoError(s.svc.ConfirmPayment(ctx, s.testPayment1.ID, s.testPayment1.UserID))
+
+	req := dto.RefundPaymentRequest{
+		RefundId: uuid.New(),
+		OrderId:  s.testPayment1.OrderID,
+		UserId:   s.testPayment1.UserID,
+		Amount:   money.New(4999, "USD"),
+		Reason:   "items cancelled",
+	}
+
+	refund, err := s.svc.RefundPayment(ctx, req)
+	s.NoError(err)
+	s.Equal(req.RefundId, refund.ID)
+	s.Equal(domain.RefundRequested, refund.Status)
+
+	// Refund requested again is not applied twice.
+	_, err = s.svc.RefundPayment(ctx, req)
+	s.ErrorIs(err, domain.ErrRefundAlreadyExists)
+
+	req.RefundId = uuid.New()
+	req.Amount = money.New(5001, "USD")
+	_, err = s.svc.RefundPayment(ctx, req)
+	s.ErrorIs(err, domain.ErrRefundExceedsPayment)
+
+	req.Amount = money.New(5000, "USD")
+	_, err = s.svc.RefundPayment(ctx, req)
+	s.NoError(err)
+
+	p, err := s.repo.GetByOrderId(ctx, s.testPayment1.OrderID.String())
+	s.NoError(err)
+	s.Equal(domain.PaymentRefunded, p.Status)
+
+	var requested int
+	s.NoError(s.db.QueryRow(ctx, `SELECT count(*) FROM outbox WHERE event_type = 'refund-requested' AND payload->>'order_id' = $1`,
+		s.testPayment1.OrderID.String()).Scan(&requested))
+	s.Equal(2, requested)
+}
+
 func (s *Suite) Test_GetPaymentStatus() {
 	ps, err := s.svc.GetPaymentStatus(context.Background(), s.testPayment1.ID, s.testPayment1.UserID)
 
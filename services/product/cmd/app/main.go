@@ -12,8 +12,11 @@ import (
 
 	"github.com/dzhordano/ecom-thing/services/product/internal/application/service"
 	"github.com/dzhordano/ecom-thing/services/product/internal/config"
+	"github.com/dzhordano/ecom-thing/services/product/internal/infrastructure/kafka"
+	"github.com/dzhordano/ecom-thing/services/product/internal/infrastructure/outbox"
 	"github.com/dzhordano/ecom-thing/services/product/internal/infrastructure/repository/pg"
 	"github.com/dzhordano/ecom-thing/services/product/internal/interfaces/grpc_server"
+	"github.com/dzhordano/ecom-thing/services/product/internal/interfaces/grpc_server/interceptors"
 	"github.com/dzhordano/ecom-thing/services/product/pkg/logger"
 )
 
@@ -25,7 +28,6 @@ import (
 // TODO:
 // Redis. [Мб сейвить количество продуктов, чтобы нагрузка на минус + другие сервисы получали быстрее ответ]
 // TLS.
-// JWT. [Тоже логика в интерцепторе]
 
 func main() {
 	ctx := context.Background()
@@ -45,6 +47,17 @@ func main() {
 
 	repo := pg.NewProductRepository(db)
 
+	// Product changes are written to outbox by repo and published from it, order service drops changed products from its cache.
+	if err := kafka.CreateTopics(cfg.Kafka.Brokers, cfg.Kafka.TopicsToProduce, 8, 2); err != nil {
+		log.Error("error creating kafka topics", "error", err)
+	}
+	kp := kafka.NewProducer(cfg.Kafka.Brokers)
+	defer kp.Close()
+
+	outboxCtx, stopOutbox := context.WithCancel(ctx)
+	defer stopOutbox()
+	go outbox.NewOutboxProcessor(log, db, kp, time.Second).Start(outboxCtx)
+
 	productService := service.NewProductService(log, repo)
 
 	tp, err := tracer.NewTracerProvider(cfg.Tracing.URL, "product")
@@ -60,6 +73,21 @@ func main() {
 	}()
 	tracer.SetGlobalTracerProvider(tp)
 
+	var auth *interceptors.Authenticator
+	if cfg.Auth.JWKSFile != "" {
+		auth, err = interceptors.NewJWKSAuthenticator(cfg.Auth.JWKSFile)
+		if err != nil {
+			log.Error("error creating jwks authenticator", "error", err)
+			return
+		}
+	} else {
+		auth, err = interceptors.NewHMACAuthenticator([]byte(cfg.Auth.HMACSecret))
+		if err != nil {
+			log.Error("error creating hmac authenticator", "error", err)
+			return
+		}
+	}
+
 	srv := grpc_server.MustNew(
 		log,
 		grpc_server.NewProductHandler(productService),
@@ -70,6 +98,7 @@ func main() {
 			cfg.CircuitBreaker.Interval,
 			cfg.CircuitBreaker.Timeout),
 		grpc_server.WithTracerProvider(tp),
+		grpc_server.WithAuthenticator(auth),
 		grpc_server.WithProfiling(),
 	)
 
@@ -85,6 +114,8 @@ func main() {
 
 	<-q
 
+	stopOutbox()
+
 	shutdownWG := &sync.WaitGroup{}
 
 	shutdownWG.Add(1)
@@ -34,7 +34,7 @@ func main() {
 		Name:     b.Babble(),
 		Desc:     "test",
 		Category: "test",
-		Price:    1.0,
+		Price:    &product_v1.Money{Amount: 100, Currency: "RUB"},
 	})
 
 	timeout := int(10 * time.Millisecond)
@@ -48,7 +48,7 @@ func main() {
 				Name:     b.Babble(),
 				Desc:     "test",
 				Category: "test",
-				Price:    1.0,
+				Price:    &product_v1.Money{Amount: 100, Currency: "RUB"},
 			})
 			if err != nil {
 				fmt.Println("failed to create grpc_server:", err)
@@ -91,7 +91,7 @@ func main() {
 	// 			Name:     b.Babble(),
 	// 			Desc:     "test",
 	// 			Category: "test",
-	// 			Price:    1.0,
+	// 			Price:    &product_v1.Money{Amount: 100, Currency: "RUB"},
 	// 		})
 	// 		if err != nil {
 	// 			fmt.Println("failed to update grpc_server:", err)
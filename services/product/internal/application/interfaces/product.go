@@ -6,13 +6,16 @@ import (
 	"github.com/google/uuid"
 
 	"github.com/dzhordano/ecom-thing/services/product/internal/domain"
+	"github.com/dzhordano/ecom-thing/services/product/pkg/money"
 )
 
 type ProductService interface {
-	CreateProduct(ctx context.Context, name, description, category string, price float64) (*domain.Product, error)
-	UpdateProduct(ctx context.Context, id uuid.UUID, name, description, category string, isActive bool, price float64) (*domain.Product, error)
+	CreateProduct(ctx context.Context, name, description, category string, price money.Money) (*domain.Product, error)
+	UpdateProduct(ctx context.Context, id uuid.UUID, name, description, category string, isActive bool, price money.Money) (*domain.Product, error)
 	DeactivateProduct(ctx context.Context, id uuid.UUID) (*domain.Product, error)
 
 	GetById(ctx context.Context, id uuid.UUID) (*domain.Product, error)
-	SearchProducts(ctx context.Context, filters map[string]any) ([]*domain.Product, error)
+	// GetByIds returns products in order of ids, inactive ones included, and ids of products that don't exist.
+	GetByIds(ctx context.Context, ids []uuid.UUID) ([]*domain.Product, []uuid.UUID, error)
+	SearchProducts(ctx context.Context, filters map[string]any) (*domain.ProductPage, error)
 }
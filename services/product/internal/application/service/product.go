@@ -2,10 +2,12 @@ package service
 
 import (
 	"context"
+	"fmt"
 	"github.com/dzhordano/ecom-thing/services/product/internal/application/interfaces"
 	"github.com/dzhordano/ecom-thing/services/product/internal/domain"
 	"github.com/dzhordano/ecom-thing/services/product/internal/domain/repository"
 	"github.com/dzhordano/ecom-thing/services/product/pkg/logger"
+	"github.com/dzhordano/ecom-thing/services/product/pkg/money"
 	"github.com/google/uuid"
 )
 
@@ -21,7 +23,7 @@ func NewProductService(log logger.Logger, repo repository.ProductRepository) int
 	}
 }
 
-func (p *ProductService) CreateProduct(ctx context.Context, name, description, category string, price float64) (*domain.Product, error) {
+func (p *ProductService) CreateProduct(ctx context.Context, name, description, category string, price money.Money) (*domain.Product, error) {
 	userId, err := uuid.NewUUID()
 	if err != nil {
 		p.log.Error("failed to create product", "error", err)
@@ -44,7 +46,7 @@ func (p *ProductService) CreateProduct(ctx context.Context, name, description, c
 	return product, nil
 }
 
-func (p *ProductService) UpdateProduct(ctx context.Context, id uuid.UUID, name, description, category string, isActive bool, price float64) (*domain.Product, error) {
+func (p *ProductService) UpdateProduct(ctx context.Context, id uuid.UUID, name, description, category string, isActive bool, price money.Money) (*domain.Product, error) {
 	product, err := p.repo.GetById(ctx, id)
 	if err != nil {
 		p.log.Error("failed to update product", "error", err, "product_id", id.String())
@@ -97,7 +99,47 @@ func (p *ProductService) GetById(ctx context.Context, id uuid.UUID) (*domain.Pro
 	return product, nil
 }
 
-func (p *ProductService) SearchProducts(ctx context.Context, filters map[string]any) ([]*domain.Product, error) {
+func (p *ProductService) GetByIds(ctx context.Context, ids []uuid.UUID) ([]*domain.Product, []uuid.UUID, error) {
+	if len(ids) == 0 || len(ids) > domain.MaxBatchSize {
+		err := fmt.Errorf("%w: from 1 to %d ids are expected, got %d", domain.ErrInvalidArgument, domain.MaxBatchSize, len(ids))
+		p.log.Error("failed to get products", "error", err)
+		return nil, nil, domain.NewAppError(err, err.Error())
+	}
+
+	found, err := p.repo.GetByIds(ctx, ids)
+	if err != nil {
+		p.log.Error("failed to get products", "error", err)
+		return nil, nil, domain.NewAppError(err, "failed to get products")
+	}
+
+	byId := make(map[uuid.UUID]*domain.Product, len(found))
+	for _, product := range found {
+		byId[product.ID] = product
+	}
+
+	// Repeated ids are answered once.
+	products := make([]*domain.Product, 0, len(found))
+	var missing []uuid.UUID
+	seen := make(map[uuid.UUID]bool, len(ids))
+	for _, id := range ids {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+
+		if product, ok := byId[id]; ok {
+			products = append(products, product)
+		} else {
+			missing = append(missing, id)
+		}
+	}
+
+	p.log.Debug("products retrieved", "count", len(products), "missing", len(missing))
+
+	return products, missing, nil
+}
+
+func (p *ProductService) SearchProducts(ctx context.Context, filters map[string]any) (*domain.ProductPage, error) {
 	params := domain.NewSearchParams(filters)
 
 	if err := params.Validate(); err != nil {
@@ -105,13 +147,33 @@ func (p *ProductService) SearchProducts(ctx context.Context, filters map[string]
 		return nil, domain.NewAppError(err, err.Error())
 	}
 
+	limit := params.Limit
+
+	// One product past the page tells whether there is a next one.
+	params.Limit++
 	products, err := p.repo.Search(ctx, params)
 	if err != nil {
 		p.log.Error("failed to search products", "error", err)
 		return nil, domain.NewAppError(err, "failed to search products")
 	}
 
-	p.log.Debug("products retrieved", "count", len(products))
+	page := &domain.ProductPage{Products: products}
+
+	if uint64(len(products)) > limit {
+		page.Products = products[:limit]
+		page.NextPageToken = domain.NewPageCursor(products[limit-1], params.SortBy, params.SortOrder).Encode()
+	}
+
+	if params.WithTotal {
+		total, err := p.repo.Count(ctx, params)
+		if err != nil {
+			p.log.Error("failed to count products", "error", err)
+			return nil, domain.NewAppError(err, "failed to search products")
+		}
+		page.Total = &total
+	}
+
+	p.log.Debug("products retrieved", "count", len(page.Products))
 
-	return products, nil
+	return page, nil
 }
@@ -1,6 +1,7 @@
 package config
 
 import (
+	"errors"
 	"log"
 	"time"
 
@@ -15,7 +16,9 @@ type Config struct {
 	PG               PostgresConfig
 	RateLimiter      RateLimiterConfig
 	CircuitBreaker   CircuitBreakerConfig
+	Kafka            KafkaConfig
 	Tracing          TracingConfig
+	Auth             AuthConfig
 	ProfilingEnabled bool `env:"PROFILING_ENABLED" env-default:"false"`
 }
 
@@ -64,6 +67,13 @@ type CircuitBreakerConfig struct {
 	Timeout     time.Duration `env:"CIRCUIT_BREAKER_TIMEOUT" env-default:"5s"`
 }
 
+type KafkaConfig struct {
+	// List of brokers to connect to.
+	Brokers []string `env:"KAFKA_BROKERS" env-default:"localhost:19092"`
+	// Topics to produce messages to. Product changes are published to product-events.
+	TopicsToProduce []string `env:"KAFKA_TOPICS_PRODUCE" env-default:"product-events"`
+}
+
 type TracingConfig struct {
 	URL string `env:"JAEGER_EXP_URL" env-default:"http://localhost:14268/api/traces"`
 }
@@ -80,5 +90,30 @@ func MustNew() *Config {
 		log.Fatalf("error reading config: %v", err)
 	}
 
+	if err := cfg.Auth.Validate(); err != nil {
+		log.Fatalf("invalid auth config: %v", err)
+	}
+
 	return &cfg
 }
+
+// AuthConfig describes how bearer tokens are verified.
+//
+// Exactly one of them has to be set: if JWKSFile is set, tokens are verified against its public keys.
+// Otherwise, HMACSecret is used.
+type AuthConfig struct {
+	HMACSecret string `env:"AUTH_HMAC_SECRET"`
+	JWKSFile   string `env:"AUTH_JWKS_FILE"`
+}
+
+// Validate checks that tokens are verified with either JWKS file or secret, not both or none.
+func (a AuthConfig) Validate() error {
+	switch {
+	case a.JWKSFile != "" && a.HMACSecret != "":
+		return errors.New("only one of AUTH_JWKS_FILE and AUTH_HMAC_SECRET may be set")
+	case a.JWKSFile == "" && a.HMACSecret == "":
+		return errors.New("either AUTH_JWKS_FILE or AUTH_HMAC_SECRET must be set")
+	}
+
+	return nil
+}
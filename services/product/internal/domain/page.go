@@ -0,0 +1,201 @@
+package domain
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/dzhordano/ecom-thing/services/product/pkg/money"
+	"github.com/google/uuid"
+)
+
+// SortKey is product column search results are sorted by. Product id breaks ties.
+type SortKey string
+
+const (
+	SortByCreatedAt SortKey = "created_at"
+	SortByPrice     SortKey = "price"
+	SortByName      SortKey = "name"
+)
+
+func (k SortKey) IsValid() bool {
+	switch k {
+	case SortByCreatedAt, SortByPrice, SortByName:
+		return true
+	}
+	return false
+}
+
+type SortOrder string
+
+const (
+	SortAsc  SortOrder = "asc"
+	SortDesc SortOrder = "desc"
+)
+
+func (o SortOrder) IsValid() bool {
+	return o == SortAsc || o == SortDesc
+}
+
+var errInvalidPageToken = errors.New("invalid page token")
+
+// PageParams select a page of products, newest first unless sorted otherwise.
+// Offset is ignored when PageToken is set.
+type PageParams struct {
+	Limit     uint64
+	Offset    uint64
+	SortBy    SortKey
+	SortOrder SortOrder
+	PageToken string
+	WithTotal bool
+}
+
+func NewPageParams(filters map[string]any) PageParams {
+	p := PageParams{
+		Limit:     DefaultLimit,
+		Offset:    DefaultOffset,
+		SortBy:    SortByCreatedAt,
+		SortOrder: SortDesc,
+	}
+
+	l, ok := filters["limit"].(*uint64)
+	if ok && l != nil && *l > 0 {
+		p.Limit = min(*l, MaxLimit)
+	}
+
+	o, ok := filters["offset"].(*uint64)
+	if ok && o != nil {
+		p.Offset = *o
+	}
+
+	sb, ok := filters["sortBy"].(*string)
+	if ok && sb != nil {
+		p.SortBy = SortKey(*sb)
+	}
+
+	so, ok := filters["sortOrder"].(*string)
+	if ok && so != nil {
+		p.SortOrder = SortOrder(*so)
+	}
+
+	t, ok := filters["pageToken"].(string)
+	if ok {
+		p.PageToken = t
+	}
+
+	wt, ok := filters["withTotal"].(bool)
+	if ok {
+		p.WithTotal = wt
+	}
+
+	return p
+}
+
+// Cursor decodes PageToken, nil means first page. Token issued for another sort is invalid.
+func (p PageParams) Cursor() (*PageCursor, error) {
+	if p.PageToken == "" {
+		return nil, nil
+	}
+
+	c, err := DecodePageCursor(p.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	if c.SortBy != p.SortBy || c.SortOrder != p.SortOrder {
+		return nil, errInvalidPageToken
+	}
+
+	return &c, nil
+}
+
+func (p PageParams) validate() []string {
+	var errs []string
+
+	if !p.SortBy.IsValid() {
+		errs = append(errs, "invalid sort key")
+	}
+
+	if !p.SortOrder.IsValid() {
+		errs = append(errs, "invalid sort order")
+	}
+
+	if _, err := p.Cursor(); err != nil {
+		errs = append(errs, errInvalidPageToken.Error())
+	}
+
+	return errs
+}
+
+// PageCursor points at the last product of a page.
+type PageCursor struct {
+	SortBy    SortKey   `json:"s"`
+	SortOrder SortOrder `json:"o"`
+	Value     string    `json:"v"`
+	ID        uuid.UUID `json:"id"`
+}
+
+func NewPageCursor(p *Product, by SortKey, dir SortOrder) PageCursor {
+	c := PageCursor{SortBy: by, SortOrder: dir, ID: p.ID}
+
+	switch by {
+	case SortByPrice:
+		c.Value = strconv.FormatInt(p.Price.Amount, 10)
+	case SortByName:
+		c.Value = p.Name
+	default:
+		c.Value = p.CreatedAt.UTC().Format(time.RFC3339Nano)
+	}
+
+	return c
+}
+
+// Encode returns opaque page token.
+func (c PageCursor) Encode() string {
+	b, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func DecodePageCursor(token string) (PageCursor, error) {
+	var c PageCursor
+
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return c, errInvalidPageToken
+	}
+
+	if err := json.Unmarshal(b, &c); err != nil || !c.SortBy.IsValid() || !c.SortOrder.IsValid() {
+		return c, errInvalidPageToken
+	}
+
+	if _, err := c.SortValue(); err != nil {
+		return c, errInvalidPageToken
+	}
+
+	return c, nil
+}
+
+// SortValue is cursor value typed as its column: money.Money for price, string for name, time for created_at.
+func (c PageCursor) SortValue() (any, error) {
+	switch c.SortBy {
+	case SortByPrice:
+		amount, err := strconv.ParseInt(c.Value, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		return money.New(amount, PriceCurrency), nil
+	case SortByName:
+		return c.Value, nil
+	}
+
+	return time.Parse(time.RFC3339Nano, c.Value)
+}
+
+// ProductPage is a page of search results. Empty NextPageToken means it is the last one.
+type ProductPage struct {
+	Products      []*Product
+	NextPageToken string
+	Total         *uint64 // Set when requested with PageParams.WithTotal.
+}
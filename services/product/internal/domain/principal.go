@@ -0,0 +1,37 @@
+package domain
+
+import (
+	"context"
+	"slices"
+
+	"github.com/google/uuid"
+)
+
+type Role string
+
+const (
+	RoleUser   Role = "user"   // Regular authorized user.
+	RoleAdmin  Role = "admin"  // Platform administrator.
+	RoleSystem Role = "system" // Internal caller. Never issued in tokens.
+)
+
+// Principal is an authenticated caller identity.
+type Principal struct {
+	UserID uuid.UUID
+	Roles  []Role
+}
+
+func (p Principal) HasRole(r Role) bool {
+	return slices.Contains(p.Roles, r)
+}
+
+type principalCtxKey struct{}
+
+func ContextWithPrincipal(ctx context.Context, p Principal) context.Context {
+	return context.WithValue(ctx, principalCtxKey{}, p)
+}
+
+func PrincipalFromContext(ctx context.Context) (Principal, bool) {
+	p, ok := ctx.Value(principalCtxKey{}).(Principal)
+	return p, ok
+}
@@ -2,10 +2,10 @@ package domain
 
 import (
 	"errors"
-	"math"
 	"strings"
 	"time"
 
+	"github.com/dzhordano/ecom-thing/services/product/pkg/money"
 	"github.com/google/uuid"
 )
 
@@ -14,8 +14,15 @@ const (
 	MaxProdNameLength     = 256
 	MaxProdDescLength     = 2048
 
-	MinPrice = 0.01
-	MaxPrice = 128000
+	// Price bounds in minor units.
+	MinPrice = 1
+	MaxPrice = 128000_00
+
+	// PriceCurrency is currency of all catalog prices, prices in other currencies are rejected.
+	PriceCurrency = "RUB"
+
+	// MaxBatchSize is how many products can be fetched by ids at once.
+	MaxBatchSize = 100
 )
 
 type Product struct {
@@ -23,13 +30,13 @@ type Product struct {
 	Name      string
 	Desc      string
 	Category  string
-	Price     float64
+	Price     money.Money
 	IsActive  bool
 	CreatedAt time.Time
 	UpdatedAt time.Time
 }
 
-func NewValidatedProduct(id uuid.UUID, name, description, category string, price float64) (*Product, error) {
+func NewValidatedProduct(id uuid.UUID, name, description, category string, price money.Money) (*Product, error) {
 	p := NewProduct(id, name, description, category, price)
 
 	if err := p.Validate(); err != nil {
@@ -39,7 +46,7 @@ func NewValidatedProduct(id uuid.UUID, name, description, category string, price
 	return p, nil
 }
 
-func NewProduct(id uuid.UUID, name, description, category string, price float64) *Product {
+func NewProduct(id uuid.UUID, name, description, category string, price money.Money) *Product {
 	return &Product{
 		ID:        id,
 		Name:      name,
@@ -103,15 +110,15 @@ func ValidateCategory(category string) bool {
 	return true
 }
 
-func ValidatePrice(price float64) bool {
-	if price < MinPrice || price > MaxPrice || math.IsNaN(price) {
+func ValidatePrice(price money.Money) bool {
+	if price.Currency != PriceCurrency || price.Amount < MinPrice || price.Amount > MaxPrice {
 		return false
 	}
 
 	return true
 }
 
-func (c *Product) Update(name, description, category string, isActive bool, price float64) {
+func (c *Product) Update(name, description, category string, isActive bool, price money.Money) {
 	c.Name = name
 	c.Desc = description
 	c.Category = category
@@ -13,5 +13,10 @@ type ProductRepository interface {
 	Deactivate(ctx context.Context, id uuid.UUID) error
 
 	GetById(ctx context.Context, id uuid.UUID) (*domain.Product, error)
+	// GetByIds returns products with given ids in no particular order, unknown ids are skipped.
+	GetByIds(ctx context.Context, ids []uuid.UUID) ([]*domain.Product, error)
+	// Search returns page of products matching params, params page token must be valid.
 	Search(ctx context.Context, params domain.SearchParams) ([]*domain.Product, error)
+	// Count returns number of products matching params, paging is ignored.
+	Count(ctx context.Context, params domain.SearchParams) (uint64, error)
 }
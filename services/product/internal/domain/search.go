@@ -3,6 +3,8 @@ package domain
 import (
 	"fmt"
 	"strings"
+
+	"github.com/dzhordano/ecom-thing/services/product/pkg/money"
 )
 
 const (
@@ -17,10 +19,9 @@ const (
 type SearchParams struct {
 	Query    *string
 	Category *string
-	MinPrice *float64
-	MaxPrice *float64
-	Limit    uint64
-	Offset   uint64
+	MinPrice *money.Money
+	MaxPrice *money.Money
+	PageParams
 }
 
 func NewSearchParams(filters map[string]any) SearchParams {
@@ -36,29 +37,17 @@ func NewSearchParams(filters map[string]any) SearchParams {
 		s.Category = c
 	}
 
-	mn, ok := filters["minPrice"].(*float64)
+	mn, ok := filters["minPrice"].(*money.Money)
 	if ok {
 		s.MinPrice = mn
 	}
 
-	mx, ok := filters["maxPrice"].(*float64)
+	mx, ok := filters["maxPrice"].(*money.Money)
 	if ok {
 		s.MaxPrice = mx
 	}
 
-	l, ok := filters["limit"].(*uint64)
-	if !ok || l == nil {
-		s.Limit = DefaultLimit
-	} else {
-		s.Limit = min(*l, MaxLimit)
-	}
-
-	o, ok := filters["offset"].(*uint64)
-	if !ok || o == nil {
-		s.Offset = DefaultOffset
-	} else {
-		s.Offset = *o
-	}
+	s.PageParams = NewPageParams(filters)
 
 	return s
 }
@@ -82,6 +71,8 @@ func (o *SearchParams) Validate() error {
 		errs = append(errs, "invalid max price")
 	}
 
+	errs = append(errs, o.PageParams.validate()...)
+
 	if len(errs) > 0 {
 		return fmt.Errorf("%w: %s", ErrInvalidArgument, strings.Join(errs, ", "))
 	}
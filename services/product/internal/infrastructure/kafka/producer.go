@@ -0,0 +1,114 @@
+package kafka
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/segmentio/kafka-go"
+)
+
+// EventTypeHeaderKey is header type of product event is sent in.
+const EventTypeHeaderKey = "event_type"
+
+type Producer interface {
+	// Produce publishes event of product to topic.
+	Produce(ctx context.Context, topic, eventType, productId string) error
+}
+
+type KafkaProducer struct {
+	w *kafka.Writer
+}
+
+// NewProducer creates KafkaProducer. Events of one product go to the same partition, so they are read in order.
+func NewProducer(brokers []string) *KafkaProducer {
+	return &KafkaProducer{
+		w: &kafka.Writer{
+			Addr:     kafka.TCP(brokers...),
+			Balancer: &kafka.Hash{},
+		},
+	}
+}
+
+func (p *KafkaProducer) Produce(ctx context.Context, topic, eventType, productId string) error {
+	if err := p.w.WriteMessages(ctx, productMessage(topic, eventType, productId)); err != nil {
+		log.Printf("error writing message to kafka: %v\n", err)
+		return err
+	}
+
+	return nil
+}
+
+// productMessage builds product event as its consumers (order service) read it:
+// both key and value are id of product, type of event is in header.
+func productMessage(topic, eventType, productId string) kafka.Message {
+	return kafka.Message{
+		Topic: topic,
+		Key:   []byte(productId),
+		Value: []byte(productId),
+		Headers: []kafka.Header{
+			{
+				Key:   EventTypeHeaderKey,
+				Value: []byte(eventType),
+			},
+		},
+	}
+}
+
+func (p *KafkaProducer) Close() {
+	if err := p.w.Close(); err != nil {
+		log.Printf("error closing KafkaProducer: %v\n", err)
+	}
+}
+
+// CreateTopics creates topics on controller of cluster. Topics that exist already are left as is.
+func CreateTopics(brokers, topics []string, partitions, replicationFactor int) error {
+	var conn *kafka.Conn
+	var err error
+	for _, broker := range brokers {
+		conn, err = kafka.Dial("tcp", broker)
+		if err == nil {
+			break
+		}
+		log.Printf("error dialing broker: %s. error: %v", broker, err)
+	}
+	if conn == nil {
+		return fmt.Errorf("error dialing brokers: %w", err)
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			log.Printf("error closing kafka conn: %v\n", err)
+		}
+	}()
+
+	ctrl, err := conn.Controller()
+	if err != nil {
+		return fmt.Errorf("error getting controller: %w", err)
+	}
+
+	controllerAddr := fmt.Sprintf("%s:%d", ctrl.Host, ctrl.Port)
+	ctrlConn, err := kafka.Dial("tcp", controllerAddr)
+	if err != nil {
+		return fmt.Errorf("error dialing controller: %w", err)
+	}
+	defer func() {
+		if err := ctrlConn.Close(); err != nil {
+			log.Printf("error closing controller connection: %v\n", err)
+		}
+	}()
+
+	var tcfgs []kafka.TopicConfig
+	for _, t := range topics {
+		tcfgs = append(tcfgs, kafka.TopicConfig{
+			Topic:             t,
+			NumPartitions:     partitions,
+			ReplicationFactor: replicationFactor,
+		})
+	}
+
+	if err := ctrlConn.CreateTopics(tcfgs...); err != nil {
+		return fmt.Errorf("error creating topics on controller %s: %w", controllerAddr, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,22 @@
+package kafka
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+// Order service invalidates cached product by id read from value, whatever type of event is.
+func TestProductMessage(t *testing.T) {
+	productId := uuid.NewString()
+
+	m := productMessage("product-events", "product-updated", productId)
+
+	assert.Equal(t, "product-events", m.Topic)
+	assert.Equal(t, productId, string(m.Key))
+	assert.Equal(t, productId, string(m.Value))
+	assert.Len(t, m.Headers, 1)
+	assert.Equal(t, EventTypeHeaderKey, m.Headers[0].Key)
+	assert.Equal(t, "product-updated", string(m.Headers[0].Value))
+}
@@ -0,0 +1,90 @@
+package outbox
+
+import (
+	"context"
+	"time"
+
+	"github.com/dzhordano/ecom-thing/services/product/internal/infrastructure/kafka"
+	"github.com/dzhordano/ecom-thing/services/product/pkg/logger"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// How many events are published per tick.
+const batchSize = 100
+
+// OutboxProcessor publishes product events written to outbox by product repository.
+// Event is published at least once: it's marked processed only after Kafka accepted it.
+type OutboxProcessor struct {
+	log      logger.Logger
+	db       *pgxpool.Pool
+	prod     kafka.Producer
+	interval time.Duration
+}
+
+type OutboxMessage struct {
+	ID        string
+	Topic     string
+	EventType string
+	ProductID string
+	CreatedAt time.Time
+}
+
+func NewOutboxProcessor(log logger.Logger, db *pgxpool.Pool, prod kafka.Producer, interval time.Duration) *OutboxProcessor {
+	return &OutboxProcessor{
+		log:      log,
+		db:       db,
+		prod:     prod,
+		interval: interval,
+	}
+}
+
+// Start processes outbox every interval until context is cancelled. Meant to be run in a separate goroutine.
+func (op *OutboxProcessor) Start(ctx context.Context) {
+	ticker := time.NewTicker(op.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			op.processOutbox(ctx)
+		case <-ctx.Done():
+			op.log.Info("outbox processor shutting down")
+			return
+		}
+	}
+}
+
+func (op *OutboxProcessor) processOutbox(ctx context.Context) {
+	rows, err := op.db.Query(ctx,
+		`SELECT id, topic, event_type, payload, created_at
+		FROM outbox
+		WHERE processed_at IS NULL
+		ORDER BY created_at ASC LIMIT $1`, batchSize)
+	if err != nil {
+		op.log.Error("failed to query outbox", "error", err)
+		return
+	}
+
+	var messages []OutboxMessage
+	for rows.Next() {
+		var msg OutboxMessage
+		if err := rows.Scan(&msg.ID, &msg.Topic, &msg.EventType, &msg.ProductID, &msg.CreatedAt); err != nil {
+			op.log.Error("failed to scan outbox row", "error", err)
+			continue
+		}
+		messages = append(messages, msg)
+	}
+	rows.Close()
+
+	for _, msg := range messages {
+		// Events are published in order they were written, so the rest is left for next tick.
+		if err := op.prod.Produce(ctx, msg.Topic, msg.EventType, msg.ProductID); err != nil {
+			op.log.Error("failed to publish product event", "error", err, "product_id", msg.ProductID)
+			return
+		}
+
+		if _, err := op.db.Exec(ctx, `UPDATE outbox SET processed_at = NOW() WHERE id = $1`, msg.ID); err != nil {
+			op.log.Error("failed to update outbox", "error", err)
+		}
+	}
+}
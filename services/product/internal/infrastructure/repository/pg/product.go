@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	sq "github.com/Masterminds/squirrel"
 	"github.com/dzhordano/ecom-thing/services/product/internal/domain"
@@ -17,6 +18,14 @@ import (
 
 const (
 	productsTableName = "products"
+	outboxTableName   = "outbox"
+
+	// Product changes are published to it by outbox processor.
+	kafkaProductEvents = "product-events"
+
+	kafkaEventProductCreated     = "product-created"
+	kafkaEventProductUpdated     = "product-updated"
+	kafkaEventProductDeactivated = "product-deactivated"
 )
 
 type ProductRepository struct {
@@ -42,7 +51,13 @@ func (p ProductRepository) Save(ctx context.Context, product *domain.Product) er
 		return fmt.Errorf("%s: %w", op, err)
 	}
 
-	_, err = p.db.Exec(ctx, query, args...)
+	err = p.withTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		if _, err := tx.Exec(ctx, query, args...); err != nil {
+			return err
+		}
+
+		return insertEvent(ctx, tx, kafkaEventProductCreated, product.ID, product.CreatedAt)
+	})
 	if err != nil {
 		var pgErr *pgconn.PgError
 
@@ -75,7 +90,13 @@ func (p ProductRepository) Update(ctx context.Context, product *domain.Product)
 		return fmt.Errorf("%s: %w", op, err)
 	}
 
-	_, err = p.db.Exec(ctx, query, args...)
+	err = p.withTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		if _, err := tx.Exec(ctx, query, args...); err != nil {
+			return err
+		}
+
+		return insertEvent(ctx, tx, kafkaEventProductUpdated, product.ID, product.UpdatedAt)
+	})
 	if err != nil {
 		var pgErr *pgconn.PgError
 
@@ -104,7 +125,19 @@ func (p ProductRepository) Deactivate(ctx context.Context, id uuid.UUID) error {
 		return fmt.Errorf("%s: %w", op, err)
 	}
 
-	_, err = p.db.Exec(ctx, query, args...)
+	err = p.withTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		tag, err := tx.Exec(ctx, query, args...)
+		if err != nil {
+			return err
+		}
+
+		// Nothing changed for unknown product, so there is nothing to publish.
+		if tag.RowsAffected() == 0 {
+			return nil
+		}
+
+		return insertEvent(ctx, tx, kafkaEventProductDeactivated, id, time.Now().UTC())
+	})
 	if err != nil {
 		return fmt.Errorf("%s: %w", op, err)
 	}
@@ -143,37 +176,67 @@ func (p ProductRepository) GetById(ctx context.Context, id uuid.UUID) (*domain.P
 
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
+	product.Price.Currency = domain.PriceCurrency
 
 	return &product, nil
 }
 
-func (p ProductRepository) Search(ctx context.Context, params domain.SearchParams) ([]*domain.Product, error) {
-	const op = "repository.ProductRepository.Search"
+func (p ProductRepository) GetByIds(ctx context.Context, ids []uuid.UUID) ([]*domain.Product, error) {
+	const op = "repository.ProductRepository.GetByIds"
 
 	selectBuilder := sq.Select("id", "name", "description", "category", "is_active", "price", "created_at", "updated_at").
 		From(productsTableName).
-		PlaceholderFormat(sq.Dollar).
-		Limit(params.Limit).
-		Offset(params.Offset)
+		Where("id = ANY(?)", ids).
+		PlaceholderFormat(sq.Dollar)
 
-	if params.Query != nil {
-		selectBuilder = selectBuilder.Where(sq.Or{
-			sq.Like{"name": fmt.Sprintf("%%%s%%", *params.Query)},
-			sq.Like{"description": fmt.Sprintf("%%%s%%", *params.Query)},
-			sq.Like{"category": fmt.Sprintf("%%%s%%", *params.Query)},
-		})
+	query, args, err := selectBuilder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
 	}
 
-	if params.Category != nil {
-		selectBuilder = selectBuilder.Where(sq.Eq{"category": *params.Category})
+	rows, err := p.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
 	}
+	defer rows.Close()
 
-	if params.MinPrice != nil {
-		selectBuilder = selectBuilder.Where(sq.GtOrEq{"price": *params.MinPrice})
+	products := make([]*domain.Product, 0, len(ids))
+
+	for rows.Next() {
+		var product domain.Product
+
+		if err := rows.Scan(
+			&product.ID,
+			&product.Name,
+			&product.Desc,
+			&product.Category,
+			&product.IsActive,
+			&product.Price,
+			&product.CreatedAt,
+			&product.UpdatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		product.Price.Currency = domain.PriceCurrency
+
+		products = append(products, &product)
 	}
 
-	if params.MaxPrice != nil {
-		selectBuilder = selectBuilder.Where(sq.LtOrEq{"price": *params.MaxPrice})
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return products, nil
+}
+
+func (p ProductRepository) Search(ctx context.Context, params domain.SearchParams) ([]*domain.Product, error) {
+	const op = "repository.ProductRepository.Search"
+
+	selectBuilder := filterProducts(sq.Select("id", "name", "description", "category", "is_active", "price", "created_at", "updated_at"), params)
+
+	selectBuilder, err := pageProducts(selectBuilder, params.PageParams)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
 	}
 
 	query, args, err := selectBuilder.ToSql()
@@ -204,9 +267,116 @@ func (p ProductRepository) Search(ctx context.Context, params domain.SearchParam
 		); err != nil {
 			return nil, fmt.Errorf("%s: %w", op, err)
 		}
+		product.Price.Currency = domain.PriceCurrency
 
 		products = append(products, &product)
 	}
 
 	return products, nil
 }
+
+func (p ProductRepository) Count(ctx context.Context, params domain.SearchParams) (uint64, error) {
+	const op = "repository.ProductRepository.Count"
+
+	query, args, err := filterProducts(sq.Select("COUNT(*)"), params).ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var total uint64
+	if err := p.db.QueryRow(ctx, query, args...).Scan(&total); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return total, nil
+}
+
+// pageProducts orders by sort key and id, then seeks past cursor instead of skipping offset rows.
+func pageProducts(selectBuilder sq.SelectBuilder, params domain.PageParams) (sq.SelectBuilder, error) {
+	dir, cmp := "DESC", "<"
+	if params.SortOrder == domain.SortAsc {
+		dir, cmp = "ASC", ">"
+	}
+
+	key := string(params.SortBy)
+	selectBuilder = selectBuilder.
+		OrderBy(key+" "+dir, "id "+dir).
+		Limit(params.Limit)
+
+	cursor, err := params.Cursor()
+	if err != nil {
+		return selectBuilder, err
+	}
+
+	if cursor == nil {
+		return selectBuilder.Offset(params.Offset), nil
+	}
+
+	v, err := cursor.SortValue()
+	if err != nil {
+		return selectBuilder, err
+	}
+
+	// Product ids are stored as text.
+	return selectBuilder.Where("("+key+", id) "+cmp+" (?, ?)", v, cursor.ID.String()), nil
+}
+
+func filterProducts(selectBuilder sq.SelectBuilder, params domain.SearchParams) sq.SelectBuilder {
+	selectBuilder = selectBuilder.
+		From(productsTableName).
+		PlaceholderFormat(sq.Dollar)
+
+	if params.Query != nil {
+		selectBuilder = selectBuilder.Where(sq.Or{
+			sq.Like{"name": fmt.Sprintf("%%%s%%", *params.Query)},
+			sq.Like{"description": fmt.Sprintf("%%%s%%", *params.Query)},
+			sq.Like{"category": fmt.Sprintf("%%%s%%", *params.Query)},
+		})
+	}
+
+	if params.Category != nil {
+		selectBuilder = selectBuilder.Where(sq.Eq{"category": *params.Category})
+	}
+
+	if params.MinPrice != nil {
+		selectBuilder = selectBuilder.Where(sq.GtOrEq{"price": *params.MinPrice})
+	}
+
+	if params.MaxPrice != nil {
+		selectBuilder = selectBuilder.Where(sq.LtOrEq{"price": *params.MaxPrice})
+	}
+
+	return selectBuilder
+}
+
+// insertEvent writes event of product to outbox, it's published once transaction commits.
+func insertEvent(ctx context.Context, tx pgx.Tx, eventType string, productId uuid.UUID, createdAt time.Time) error {
+	insertBuilder := sq.Insert(outboxTableName).
+		Columns("topic", "event_type", "payload", "created_at").
+		Values(kafkaProductEvents, eventType, productId.String(), createdAt).
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := insertBuilder.ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(ctx, query, args...)
+	return err
+}
+
+func (p ProductRepository) withTx(ctx context.Context, fn func(ctx context.Context, tx pgx.Tx) error) error {
+	tx, err := p.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := fn(ctx, tx); err != nil {
+		if rbErr := tx.Rollback(ctx); rbErr != nil {
+			return rbErr
+		}
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
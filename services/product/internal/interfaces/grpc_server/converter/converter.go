@@ -3,6 +3,7 @@ package converter
 import (
 	"github.com/dzhordano/ecom-thing/services/product/internal/domain"
 	api "github.com/dzhordano/ecom-thing/services/product/pkg/api/product/v1"
+	"github.com/dzhordano/ecom-thing/services/product/pkg/money"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -13,7 +14,7 @@ func ProductToProto(product *domain.Product) *api.Product {
 		Desc:     product.Desc,
 		Category: product.Category,
 		IsActive: product.IsActive,
-		Price:    product.Price,
+		Price:    MoneyToProto(product.Price),
 		//CreatedAt: timestamppb.New(product.CreatedAt),
 		CreatedAt: &timestamppb.Timestamp{
 			Seconds: product.CreatedAt.Unix(),
@@ -36,3 +37,25 @@ func ManyProductsToProto(products []*domain.Product) []*api.Product {
 
 	return result
 }
+
+func MoneyToProto(m money.Money) *api.Money {
+	return &api.Money{
+		Amount:   m.Amount,
+		Currency: m.Currency,
+	}
+}
+
+// MoneyFromProto converts API amount, absent one is zero without currency.
+func MoneyFromProto(m *api.Money) money.Money {
+	return money.New(m.GetAmount(), m.GetCurrency())
+}
+
+// OptionalMoneyFromProto is MoneyFromProto for optional fields, absent amount is nil.
+func OptionalMoneyFromProto(m *api.Money) *money.Money {
+	if m == nil {
+		return nil
+	}
+
+	v := MoneyFromProto(m)
+	return &v
+}
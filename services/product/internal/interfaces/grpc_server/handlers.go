@@ -34,7 +34,8 @@ func (h *ProductHandler) CreateProduct(ctx context.Context, req *api.CreateProdu
 		),
 	)
 
-	product, err := h.service.CreateProduct(ctx, req.GetName(), req.GetDesc(), req.GetCategory(), req.GetPrice())
+	product, err := h.service.CreateProduct(ctx, req.GetName(), req.GetDesc(), req.GetCategory(),
+		converter.MoneyFromProto(req.GetPrice()))
 	if err != nil {
 		return nil, err
 	}
@@ -67,7 +68,8 @@ func (h *ProductHandler) UpdateProduct(ctx context.Context, req *api.UpdateProdu
 
 	span.AddEvent("call service")
 
-	product, err := h.service.UpdateProduct(ctx, productId, req.GetName(), req.GetDesc(), req.GetCategory(), req.GetIsActive(), req.GetPrice())
+	product, err := h.service.UpdateProduct(ctx, productId, req.GetName(), req.GetDesc(), req.GetCategory(), req.GetIsActive(),
+		converter.MoneyFromProto(req.GetPrice()))
 	if err != nil {
 		return nil, err
 	}
@@ -149,6 +151,50 @@ func (h *ProductHandler) GetProduct(ctx context.Context, req *api.GetProductRequ
 	}, nil
 }
 
+func (h *ProductHandler) GetProducts(ctx context.Context, req *api.GetProductsRequest) (*api.GetProductsResponse, error) {
+	span := trace.SpanFromContext(ctx)
+	defer span.End()
+
+	span.AddEvent("parse ids",
+		trace.WithAttributes(
+			attribute.StringSlice("product_ids", req.GetIds()),
+		),
+	)
+
+	ids := make([]uuid.UUID, 0, len(req.GetIds()))
+	for _, id := range req.GetIds() {
+		productId, err := uuid.Parse(id)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid product id %q", id)
+		}
+		ids = append(ids, productId)
+	}
+
+	span.AddEvent("call service")
+
+	products, missing, err := h.service.GetByIds(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	span.AddEvent("products retrieved",
+		trace.WithAttributes(
+			attribute.Int("count", len(products)),
+			attribute.Int("missing", len(missing)),
+		),
+	)
+
+	missingIds := make([]string, 0, len(missing))
+	for _, id := range missing {
+		missingIds = append(missingIds, id.String())
+	}
+
+	return &api.GetProductsResponse{
+		Products:   converter.ManyProductsToProto(products),
+		MissingIds: missingIds,
+	}, nil
+}
+
 func (h *ProductHandler) SearchProducts(ctx context.Context, req *api.SearchProductsRequest) (*api.SearchProductsResponse, error) {
 	span := trace.SpanFromContext(ctx)
 	defer span.End()
@@ -159,13 +205,17 @@ func (h *ProductHandler) SearchProducts(ctx context.Context, req *api.SearchProd
 		),
 	)
 
-	products, err := h.service.SearchProducts(ctx, map[string]any{
-		"query":    req.Query,
-		"category": req.Category,
-		"minPrice": req.MinPrice,
-		"maxPrice": req.MaxPrice,
-		"limit":    req.Limit,
-		"offset":   req.Offset,
+	page, err := h.service.SearchProducts(ctx, map[string]any{
+		"query":     req.Query,
+		"category":  req.Category,
+		"minPrice":  converter.OptionalMoneyFromProto(req.MinPrice),
+		"maxPrice":  converter.OptionalMoneyFromProto(req.MaxPrice),
+		"limit":     req.Limit,
+		"offset":    req.Offset,
+		"pageToken": req.GetPageToken(),
+		"sortBy":    req.SortBy,
+		"sortOrder": req.SortOrder,
+		"withTotal": req.GetIncludeTotal(),
 	})
 	if err != nil {
 		return nil, err
@@ -173,11 +223,13 @@ func (h *ProductHandler) SearchProducts(ctx context.Context, req *api.SearchProd
 
 	span.AddEvent("products found",
 		trace.WithAttributes(
-			attribute.Int("count", len(products)),
+			attribute.Int("count", len(page.Products)),
 		),
 	)
 
 	return &api.SearchProductsResponse{
-		Products: converter.ManyProductsToProto(products),
+		Products:      converter.ManyProductsToProto(page.Products),
+		NextPageToken: page.NextPageToken,
+		Total:         page.Total,
 	}, nil
 }
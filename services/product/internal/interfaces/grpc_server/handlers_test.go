@@ -5,6 +5,7 @@ import (
 	"github.com/dzhordano/ecom-thing/services/product/internal/domain"
 	mock_interfaces "github.com/dzhordano/ecom-thing/services/product/internal/interfaces/grpc_server/mocks"
 	api "github.com/dzhordano/ecom-thing/services/product/pkg/api/product/v1"
+	"github.com/dzhordano/ecom-thing/services/product/pkg/money"
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -16,14 +17,14 @@ import (
 )
 
 func TestProductHandler_CreateProduct(t *testing.T) {
-	type mockBehaviour func(s *mock_interfaces.MockProductService, name, description, category string, price float64)
+	type mockBehaviour func(s *mock_interfaces.MockProductService, name, description, category string, price *api.Money)
 
 	respProduct := &domain.Product{
 		ID:        uuid.New(),
 		Name:      "test",
 		Desc:      "cool test desc",
 		Category:  "testing",
-		Price:     15.25,
+		Price:     money.New(1525, domain.PriceCurrency),
 		IsActive:  true,
 		CreatedAt: time.Now().UTC(),
 		UpdatedAt: time.Now().UTC(),
@@ -42,15 +43,15 @@ func TestProductHandler_CreateProduct(t *testing.T) {
 				Name:     respProduct.Name,
 				Category: respProduct.Category,
 				Desc:     respProduct.Desc,
-				Price:    respProduct.Price,
+				Price:    &api.Money{Amount: respProduct.Price.Amount, Currency: respProduct.Price.Currency},
 			},
-			mockBehaviour: func(s *mock_interfaces.MockProductService, name, description, category string, price float64) {
+			mockBehaviour: func(s *mock_interfaces.MockProductService, name, description, category string, price *api.Money) {
 				s.EXPECT().CreateProduct(
 					gomock.Any(),
 					gomock.Eq(name),
 					gomock.Eq(description),
 					gomock.Eq(category),
-					gomock.Eq(price),
+					gomock.Eq(money.New(price.GetAmount(), price.GetCurrency())),
 				).Return(respProduct, nil).Times(1)
 			},
 			expectedResp: &api.CreateProductResponse{
@@ -60,7 +61,7 @@ func TestProductHandler_CreateProduct(t *testing.T) {
 					Desc:     respProduct.Desc,
 					Category: respProduct.Category,
 					IsActive: respProduct.IsActive,
-					Price:    respProduct.Price,
+					Price:    &api.Money{Amount: respProduct.Price.Amount, Currency: respProduct.Price.Currency},
 					CreatedAt: &timestamppb.Timestamp{
 						Seconds: respProduct.CreatedAt.Unix(),
 						Nanos:   int32(respProduct.CreatedAt.Nanosecond()),
@@ -78,15 +79,15 @@ func TestProductHandler_CreateProduct(t *testing.T) {
 				Name:     respProduct.Name,
 				Category: respProduct.Category,
 				Desc:     respProduct.Desc,
-				Price:    respProduct.Price,
+				Price:    &api.Money{Amount: respProduct.Price.Amount, Currency: respProduct.Price.Currency},
 			},
-			mockBehaviour: func(s *mock_interfaces.MockProductService, name, description, category string, price float64) {
+			mockBehaviour: func(s *mock_interfaces.MockProductService, name, description, category string, price *api.Money) {
 				s.EXPECT().CreateProduct(
 					gomock.Any(),
 					gomock.Eq(name),
 					gomock.Eq(description),
 					gomock.Eq(category),
-					gomock.Eq(price),
+					gomock.Eq(money.New(price.GetAmount(), price.GetCurrency())),
 				).Return(nil, assert.AnError).Times(1)
 			},
 			expectedResp: nil,
@@ -145,7 +146,7 @@ func TestProductHandler_DeactivateProduct(t *testing.T) {
 					Name:      "test",
 					Desc:      "test",
 					Category:  "test",
-					Price:     10,
+					Price:     money.New(1000, domain.PriceCurrency),
 					IsActive:  false,
 					CreatedAt: timeNow,
 					UpdatedAt: timeNow,
@@ -157,7 +158,7 @@ func TestProductHandler_DeactivateProduct(t *testing.T) {
 					Category:  "test",
 					Name:      "test",
 					Desc:      "test",
-					Price:     10,
+					Price:     &api.Money{Amount: 1000, Currency: domain.PriceCurrency},
 					IsActive:  false,
 					CreatedAt: timestamppbNow,
 					UpdatedAt: timestamppbNow,
@@ -241,7 +242,7 @@ func TestProductHandler_GetProduct(t *testing.T) {
 					Name:      "test",
 					Desc:      "test",
 					Category:  "test",
-					Price:     10,
+					Price:     money.New(1000, domain.PriceCurrency),
 					IsActive:  true,
 					CreatedAt: timeNow,
 					UpdatedAt: timeNow,
@@ -253,7 +254,7 @@ func TestProductHandler_GetProduct(t *testing.T) {
 					Category:  "test",
 					Name:      "test",
 					Desc:      "test",
-					Price:     10,
+					Price:     &api.Money{Amount: 1000, Currency: domain.PriceCurrency},
 					IsActive:  true,
 					CreatedAt: timestamppbNow,
 					UpdatedAt: timestamppbNow,
@@ -308,8 +309,227 @@ func TestProductHandler_GetProduct(t *testing.T) {
 	}
 }
 
+func TestProductHandler_GetProducts(t *testing.T) {
+	type mockBehaviour func(s *mock_interfaces.MockProductService, found, missing uuid.UUID)
+
+	found, missing := uuid.New(), uuid.New()
+
+	timeNow := time.Now()
+	timestamppbNow := timestamppb.New(timeNow)
+
+	tests := []struct {
+		name          string
+		req           *api.GetProductsRequest
+		mockBehaviour mockBehaviour
+		expectedResp  *api.GetProductsResponse
+		expectedErr   error
+	}{
+		{
+			name: "OK",
+			req: &api.GetProductsRequest{
+				Ids: []string{found.String(), missing.String()},
+			},
+			mockBehaviour: func(s *mock_interfaces.MockProductService, found, missing uuid.UUID) {
+				s.EXPECT().GetByIds(
+					gomock.Any(),
+					gomock.Eq([]uuid.UUID{found, missing}),
+				).Return([]*domain.Product{{
+					ID:        found,
+					Name:      "test",
+					Desc:      "test",
+					Category:  "test",
+					Price:     money.New(1000, domain.PriceCurrency),
+					IsActive:  false,
+					CreatedAt: timeNow,
+					UpdatedAt: timeNow,
+				}}, []uuid.UUID{missing}, nil).Times(1)
+			},
+			expectedResp: &api.GetProductsResponse{
+				Products: []*api.Product{{
+					Id:        found.String(),
+					Category:  "test",
+					Name:      "test",
+					Desc:      "test",
+					Price:     &api.Money{Amount: 1000, Currency: domain.PriceCurrency},
+					IsActive:  false,
+					CreatedAt: timestamppbNow,
+					UpdatedAt: timestamppbNow,
+				}},
+				MissingIds: []string{missing.String()},
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "INVALID UUID",
+			req: &api.GetProductsRequest{
+				Ids: []string{found.String(), "invalid uuid"},
+			},
+			mockBehaviour: func(s *mock_interfaces.MockProductService, found, missing uuid.UUID) {},
+			expectedResp:  nil,
+			expectedErr:   status.Error(codes.InvalidArgument, `invalid product id "invalid uuid"`),
+		},
+		{
+			name: "ERROR",
+			req: &api.GetProductsRequest{
+				Ids: []string{found.String()},
+			},
+			mockBehaviour: func(s *mock_interfaces.MockProductService, found, missing uuid.UUID) {
+				s.EXPECT().GetByIds(
+					gomock.Any(),
+					gomock.Eq([]uuid.UUID{found}),
+				).Return(nil, nil, assert.AnError).Times(1)
+			},
+			expectedResp: nil,
+			expectedErr:  assert.AnError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(f *testing.T) {
+			c := gomock.NewController(f)
+			defer c.Finish()
+
+			productService := mock_interfaces.NewMockProductService(c)
+			tt.mockBehaviour(productService, found, missing)
+
+			productHandler := NewProductHandler(productService)
+			resp, err := productHandler.GetProducts(context.Background(), tt.req)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(f, err, tt.expectedErr)
+				assert.Nil(f, resp)
+			} else {
+				assert.Equal(f, tt.expectedResp.Products, resp.Products)
+				assert.Equal(f, tt.expectedResp.MissingIds, resp.MissingIds)
+				assert.NoError(f, err)
+			}
+		})
+	}
+}
+
 func TestProductHandler_SearchProducts(t *testing.T) {
-	t.Skip("todo")
+	type mockBehaviour func(s *mock_interfaces.MockProductService, filters map[string]any)
+
+	product := &domain.Product{
+		ID:        uuid.New(),
+		Name:      "test",
+		Category:  "test",
+		Price:     money.New(1000, domain.PriceCurrency),
+		CreatedAt: time.Now().UTC(),
+		UpdatedAt: time.Now().UTC(),
+	}
+
+	limit := uint64(1)
+	sortBy := "price"
+	total := uint64(2)
+
+	tests := []struct {
+		name          string
+		req           *api.SearchProductsRequest
+		filters       map[string]any
+		mockBehaviour mockBehaviour
+		expectedLen   int
+		expectedToken string
+		expectedTotal uint64
+		expectedErr   error
+	}{
+		{
+			name: "OK",
+			req: &api.SearchProductsRequest{
+				Limit:        &limit,
+				PageToken:    ptr("token"),
+				SortBy:       &sortBy,
+				IncludeTotal: ptr(true),
+			},
+			filters: map[string]any{
+				"query":     (*string)(nil),
+				"category":  (*string)(nil),
+				"minPrice":  (*money.Money)(nil),
+				"maxPrice":  (*money.Money)(nil),
+				"limit":     &limit,
+				"offset":    (*uint64)(nil),
+				"pageToken": "token",
+				"sortBy":    &sortBy,
+				"sortOrder": (*string)(nil),
+				"withTotal": true,
+			},
+			mockBehaviour: func(s *mock_interfaces.MockProductService, filters map[string]any) {
+				s.EXPECT().SearchProducts(
+					gomock.Any(),
+					gomock.Eq(filters),
+				).Return(&domain.ProductPage{
+					Products:      []*domain.Product{product},
+					NextPageToken: "next",
+					Total:         &total,
+				}, nil).Times(1)
+			},
+			expectedLen:   1,
+			expectedToken: "next",
+			expectedTotal: total,
+			expectedErr:   nil,
+		},
+		{
+			name: "PRICE BOUNDS",
+			req: &api.SearchProductsRequest{
+				MinPrice: &api.Money{Amount: 1050, Currency: domain.PriceCurrency},
+				MaxPrice: &api.Money{Amount: 9999, Currency: domain.PriceCurrency},
+			},
+			filters: map[string]any{
+				"query":     (*string)(nil),
+				"category":  (*string)(nil),
+				"minPrice":  ptr(money.New(1050, domain.PriceCurrency)),
+				"maxPrice":  ptr(money.New(9999, domain.PriceCurrency)),
+				"limit":     (*uint64)(nil),
+				"offset":    (*uint64)(nil),
+				"pageToken": "",
+				"sortBy":    (*string)(nil),
+				"sortOrder": (*string)(nil),
+				"withTotal": false,
+			},
+			mockBehaviour: func(s *mock_interfaces.MockProductService, filters map[string]any) {
+				s.EXPECT().SearchProducts(
+					gomock.Any(),
+					gomock.Eq(filters),
+				).Return(&domain.ProductPage{
+					Products: []*domain.Product{product},
+				}, nil).Times(1)
+			},
+			expectedLen: 1,
+		},
+		{
+			name: "ERROR",
+			req:  &api.SearchProductsRequest{},
+			mockBehaviour: func(s *mock_interfaces.MockProductService, _ map[string]any) {
+				s.EXPECT().SearchProducts(
+					gomock.Any(),
+					gomock.Any(),
+				).Return(nil, assert.AnError).Times(1)
+			},
+			expectedErr: assert.AnError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(f *testing.T) {
+			c := gomock.NewController(f)
+			defer c.Finish()
+
+			productService := mock_interfaces.NewMockProductService(c)
+			tt.mockBehaviour(productService, tt.filters)
+
+			productHandler := NewProductHandler(productService)
+			resp, err := productHandler.SearchProducts(context.Background(), tt.req)
+
+			assert.ErrorIs(f, err, tt.expectedErr)
+			assert.Len(f, resp.GetProducts(), tt.expectedLen)
+			assert.Equal(f, tt.expectedToken, resp.GetNextPageToken())
+			assert.Equal(f, tt.expectedTotal, resp.GetTotal())
+		})
+	}
+}
+
+func ptr[T any](v T) *T {
+	return &v
 }
 
 func TestProductHandler_UpdateProduct(t *testing.T) {
@@ -318,7 +538,7 @@ func TestProductHandler_UpdateProduct(t *testing.T) {
 		productId uuid.UUID,
 		name, description, category string,
 		isActive bool,
-		price float64,
+		price *api.Money,
 	)
 
 	testProductId := uuid.New()
@@ -340,10 +560,10 @@ func TestProductHandler_UpdateProduct(t *testing.T) {
 				Name:     "test",
 				Category: "test",
 				Desc:     "test",
-				Price:    10,
+				Price:    &api.Money{Amount: 1000, Currency: domain.PriceCurrency},
 				IsActive: true,
 			},
-			mockBehaviour: func(s *mock_interfaces.MockProductService, productId uuid.UUID, name, description, category string, isActive bool, price float64) {
+			mockBehaviour: func(s *mock_interfaces.MockProductService, productId uuid.UUID, name, description, category string, isActive bool, price *api.Money) {
 				s.EXPECT().UpdateProduct(
 					gomock.Any(),
 					gomock.Eq(productId),
@@ -351,13 +571,13 @@ func TestProductHandler_UpdateProduct(t *testing.T) {
 					gomock.Eq(description),
 					gomock.Eq(category),
 					gomock.Eq(isActive),
-					gomock.Eq(price),
+					gomock.Eq(money.New(price.GetAmount(), price.GetCurrency())),
 				).Return(&domain.Product{
 					ID:        productId,
 					Name:      "test",
 					Desc:      "test",
 					Category:  "test",
-					Price:     10,
+					Price:     money.New(1000, domain.PriceCurrency),
 					IsActive:  true,
 					CreatedAt: timeNow,
 					UpdatedAt: timeNow,
@@ -369,7 +589,7 @@ func TestProductHandler_UpdateProduct(t *testing.T) {
 					Category:  "test",
 					Name:      "test",
 					Desc:      "test",
-					Price:     10,
+					Price:     &api.Money{Amount: 1000, Currency: domain.PriceCurrency},
 					IsActive:  true,
 					CreatedAt: timestamppbNow,
 					UpdatedAt: timestamppbNow,
@@ -382,7 +602,7 @@ func TestProductHandler_UpdateProduct(t *testing.T) {
 			req: &api.UpdateProductRequest{
 				Id: "invalid uuid",
 			},
-			mockBehaviour: func(s *mock_interfaces.MockProductService, productId uuid.UUID, name, description, category string, isActive bool, price float64) {
+			mockBehaviour: func(s *mock_interfaces.MockProductService, productId uuid.UUID, name, description, category string, isActive bool, price *api.Money) {
 			},
 			expectedResp: nil,
 			expectedErr:  status.Error(codes.InvalidArgument, "invalid product id"),
@@ -392,7 +612,7 @@ func TestProductHandler_UpdateProduct(t *testing.T) {
 			req: &api.UpdateProductRequest{
 				Id: testProductId.String(),
 			},
-			mockBehaviour: func(s *mock_interfaces.MockProductService, productId uuid.UUID, name, description, category string, isActive bool, price float64) {
+			mockBehaviour: func(s *mock_interfaces.MockProductService, productId uuid.UUID, name, description, category string, isActive bool, price *api.Money) {
 				s.EXPECT().UpdateProduct(
 					gomock.Any(),
 					gomock.Eq(productId),
@@ -400,7 +620,7 @@ func TestProductHandler_UpdateProduct(t *testing.T) {
 					gomock.Eq(description),
 					gomock.Eq(category),
 					gomock.Eq(isActive),
-					gomock.Eq(price),
+					gomock.Eq(money.New(price.GetAmount(), price.GetCurrency())),
 				).Return(nil, assert.AnError).Times(1)
 			},
 			expectedResp: nil,
@@ -0,0 +1,61 @@
+package interceptors
+
+import (
+	"context"
+
+	"github.com/dzhordano/ecom-thing/services/product/internal/domain"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	errUnknownMethod = status.Error(codes.PermissionDenied, "method is not allowed")
+	errNoRole        = status.Error(codes.PermissionDenied, "insufficient role")
+)
+
+// Policy maps full gRPC method name to roles allowed to call it.
+//
+// Method with empty role list is public. Methods missing from policy are denied.
+type Policy map[string][]domain.Role
+
+// Public marks method that can be called without principal.
+func Public() []domain.Role {
+	return []domain.Role{}
+}
+
+// Authorize checks whether principal in ctx may call method.
+func (p Policy) Authorize(ctx context.Context, method string) error {
+	roles, ok := p[method]
+	if !ok {
+		return errUnknownMethod
+	}
+
+	if len(roles) == 0 {
+		return nil
+	}
+
+	principal, ok := domain.PrincipalFromContext(ctx)
+	if !ok {
+		return errMissingToken
+	}
+
+	for _, r := range roles {
+		if principal.HasRole(r) {
+			return nil
+		}
+	}
+
+	return errNoRole
+}
+
+// AuthorizationInterceptor must be chained after Authenticator.
+func AuthorizationInterceptor(p Policy) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if err := p.Authorize(ctx, info.FullMethod); err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
@@ -0,0 +1,18 @@
+package grpc_server
+
+import (
+	"github.com/dzhordano/ecom-thing/services/product/internal/domain"
+	"github.com/dzhordano/ecom-thing/services/product/internal/interfaces/grpc_server/interceptors"
+	api "github.com/dzhordano/ecom-thing/services/product/pkg/api/product/v1"
+)
+
+// methodPolicy mirrors security scopes declared in product.proto.
+// Catalog reads are public.
+var methodPolicy = interceptors.Policy{
+	api.ProductService_CreateProduct_FullMethodName:     {domain.RoleAdmin},
+	api.ProductService_UpdateProduct_FullMethodName:     {domain.RoleAdmin},
+	api.ProductService_DeactivateProduct_FullMethodName: {domain.RoleAdmin},
+	api.ProductService_GetProduct_FullMethodName:        interceptors.Public(),
+	api.ProductService_GetProducts_FullMethodName:       interceptors.Public(),
+	api.ProductService_SearchProducts_FullMethodName:    interceptors.Public(),
+}
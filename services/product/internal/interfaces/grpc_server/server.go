@@ -50,6 +50,8 @@ type Server struct {
 
 	cb *gobreaker.Settings
 	tp *tracesdk.TracerProvider
+
+	auth *interceptors.Authenticator
 }
 
 func WithAddr(addr string) Option {
@@ -72,6 +74,13 @@ func WithTracerProvider(tp *tracesdk.TracerProvider) Option {
 	}
 }
 
+// WithAuthenticator sets authenticator used to resolve caller identity from bearer token.
+func WithAuthenticator(a *interceptors.Authenticator) Option {
+	return func(s *Server) {
+		s.auth = a
+	}
+}
+
 func WithRateLimiter(limit, burst int) Option {
 	return func(s *Server) {
 		s.ratelimiterLimit = limit
@@ -111,6 +120,10 @@ func MustNew(log logger.Logger, handler api.ProductServiceServer, opts ...Option
 		panic("addr is required")
 	}
 
+	if s.auth == nil {
+		panic("authenticator is required")
+	}
+
 	recoveryOpts := []recovery.Option{
 		recovery.WithRecoveryHandler(func(p interface{}) (err error) {
 			log.Error("recovered from panic", "panic", p)
@@ -151,6 +164,8 @@ func MustNew(log logger.Logger, handler api.ProductServiceServer, opts ...Option
 			ratelimiter.RateLimiterInterceptor(),
 			recovery.UnaryServerInterceptor(recoveryOpts...),
 			logging.UnaryServerInterceptor(interceptors.InterceptorLogger(log), loggingOpts...),
+			s.auth.UnaryServerInterceptor(),
+			interceptors.AuthorizationInterceptor(methodPolicy),
 			interceptors.ErrorMapperInterceptor(),
 			interceptors.MetricsInterceptor(),
 		),
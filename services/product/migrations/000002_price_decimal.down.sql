@@ -0,0 +1 @@
+ALTER TABLE products ALTER COLUMN price TYPE FLOAT USING price::float8;
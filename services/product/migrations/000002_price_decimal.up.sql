@@ -0,0 +1,2 @@
+-- Prices are exact, rounded half away from zero to cents.
+ALTER TABLE products ALTER COLUMN price TYPE DECIMAL(10, 2) USING round(price::numeric, 2);
@@ -0,0 +1,4 @@
+-- Search pages are read in (sort key, id) order.
+CREATE INDEX IF NOT EXISTS products_created_at_id_idx ON products(created_at, id);
+CREATE INDEX IF NOT EXISTS products_price_id_idx ON products(price, id);
+CREATE INDEX IF NOT EXISTS products_name_id_idx ON products(name, id);
@@ -0,0 +1,10 @@
+-- Product changes are published to Kafka from here, payload is id of product.
+CREATE TABLE IF NOT EXISTS outbox (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    topic VARCHAR(100) NOT NULL,
+    event_type VARCHAR(100) NOT NULL,
+    payload TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    processed_at TIMESTAMPTZ
+);
+CREATE INDEX IF NOT EXISTS outbox_unprocessed_idx ON outbox(created_at) WHERE processed_at IS NULL;
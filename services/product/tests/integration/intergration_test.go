@@ -15,6 +15,7 @@ import (
 	"github.com/dzhordano/ecom-thing/services/product/internal/domain/repository"
 	"github.com/dzhordano/ecom-thing/services/product/internal/infrastructure/repository/pg"
 	"github.com/dzhordano/ecom-thing/services/product/pkg/logger"
+	"github.com/dzhordano/ecom-thing/services/product/pkg/money"
 	"github.com/golang-migrate/migrate/v4"
 	_ "github.com/golang-migrate/migrate/v4/database/postgres"
 	_ "github.com/golang-migrate/migrate/v4/source/file"
@@ -93,7 +94,7 @@ func (s *IntegrationSuite) SeedDatabase() {
 		Name:      "Dummy1",
 		Desc:      "Dummy1",
 		Category:  "Dummy1",
-		Price:     11.11,
+		Price:     money.New(1111, domain.PriceCurrency),
 		CreatedAt: time.Now(),
 		UpdatedAt: time.Now(),
 	}
@@ -103,7 +104,7 @@ func (s *IntegrationSuite) SeedDatabase() {
 		Name:      "Dummy2",
 		Desc:      "Dummy2",
 		Category:  "Dummy2",
-		Price:     10.10,
+		Price:     money.New(1010, domain.PriceCurrency),
 		CreatedAt: time.Now(),
 		UpdatedAt: time.Now(),
 	}
@@ -143,7 +144,7 @@ func (s *IntegrationSuite) TestA_CreateProduct() {
 		"TestName",
 		"TestDesc",
 		"TestCat",
-		10.10,
+		money.New(1010, domain.PriceCurrency),
 	)
 
 	if s.Assert().NoError(err) {
@@ -151,6 +152,13 @@ func (s *IntegrationSuite) TestA_CreateProduct() {
 
 		err = resp.Validate()
 		s.Assert().NoError(err)
+
+		// Change event is saved along with product.
+		var events int
+		err = s.db.QueryRow(context.Background(),
+			`SELECT COUNT(*) FROM outbox WHERE event_type = 'product-created' AND payload = $1`, resp.ID.String()).Scan(&events)
+		s.Assert().NoError(err)
+		s.Assert().Equal(1, events)
 	}
 }
 
@@ -168,6 +176,26 @@ func (s *IntegrationSuite) TestB_GetProduct() {
 	}
 }
 
+func (s *IntegrationSuite) TestB_GetProducts() {
+	missing := uuid.New()
+
+	products, missingIds, err := s.productService.GetByIds(
+		context.Background(),
+		[]uuid.UUID{s.testProduct2.ID, missing, s.testProduct1.ID, s.testProduct2.ID},
+	)
+
+	if s.Assert().NoError(err) {
+		if s.Assert().Len(products, 2) {
+			s.Assert().Equal(s.testProduct2.ID, products[0].ID)
+			s.Assert().Equal(s.testProduct1.ID, products[1].ID)
+		}
+		s.Assert().Equal([]uuid.UUID{missing}, missingIds)
+	}
+
+	_, _, err = s.productService.GetByIds(context.Background(), nil)
+	s.Assert().ErrorIs(err, domain.ErrInvalidArgument)
+}
+
 // Now THIS is NOT funny...
 func ptrVal[T any](val T) *T {
 	return &val
@@ -181,7 +209,7 @@ func (s *IntegrationSuite) TestC_SearchProducts() {
 		})
 
 	if s.Assert().NoError(err) {
-		s.Assert().Len(respDummyQuery, 2)
+		s.Assert().Len(respDummyQuery.Products, 2)
 	}
 
 	resp1Query, err := s.productService.SearchProducts(
@@ -191,7 +219,7 @@ func (s *IntegrationSuite) TestC_SearchProducts() {
 		})
 
 	if s.Assert().NoError(err) {
-		s.Assert().Len(resp1Query, 1)
+		s.Assert().Len(resp1Query.Products, 1)
 	}
 
 	respCatQuery, err := s.productService.SearchProducts(
@@ -201,7 +229,7 @@ func (s *IntegrationSuite) TestC_SearchProducts() {
 		})
 
 	if s.Assert().NoError(err) {
-		s.Assert().Len(respCatQuery, 1)
+		s.Assert().Len(respCatQuery.Products, 1)
 	}
 
 	respMinPriceQuery, err := s.productService.SearchProducts(
@@ -211,7 +239,7 @@ func (s *IntegrationSuite) TestC_SearchProducts() {
 		})
 
 	if s.Assert().NoError(err) {
-		s.Assert().Len(respMinPriceQuery, 3)
+		s.Assert().Len(respMinPriceQuery.Products, 3)
 	}
 
 	respMaxPriceQuery, err := s.productService.SearchProducts(
@@ -221,7 +249,7 @@ func (s *IntegrationSuite) TestC_SearchProducts() {
 		})
 
 	if s.Assert().NoError(err) {
-		s.Assert().Len(respMaxPriceQuery, 2)
+		s.Assert().Len(respMaxPriceQuery.Products, 2)
 	}
 
 	respLimitQuery, err := s.productService.SearchProducts(
@@ -231,10 +259,38 @@ func (s *IntegrationSuite) TestC_SearchProducts() {
 		})
 
 	if s.Assert().NoError(err) {
-		s.Assert().Len(respLimitQuery, 1)
+		s.Assert().Len(respLimitQuery.Products, 1)
 	}
 }
 
+func (s *IntegrationSuite) TestC_SearchProducts_Pages() {
+	filters := map[string]any{
+		"limit":     ptrVal(uint64(2)),
+		"sortBy":    ptrVal("name"),
+		"sortOrder": ptrVal("asc"),
+		"withTotal": true,
+	}
+
+	first, err := s.productService.SearchProducts(context.Background(), filters)
+	s.Require().NoError(err)
+	s.Require().Len(first.Products, 2)
+	s.Equal(uint64(3), *first.Total)
+	s.Equal("Dummy1", first.Products[0].Name)
+	s.NotEmpty(first.NextPageToken)
+
+	filters["pageToken"] = first.NextPageToken
+	second, err := s.productService.SearchProducts(context.Background(), filters)
+	s.Require().NoError(err)
+	s.Require().Len(second.Products, 1)
+	s.Equal("TestName", second.Products[0].Name)
+	s.Empty(second.NextPageToken)
+
+	// Token is bound to sort it was issued for.
+	filters["sortOrder"] = ptrVal("desc")
+	_, err = s.productService.SearchProducts(context.Background(), filters)
+	s.ErrorIs(err, domain.ErrInvalidArgument)
+}
+
 func (s *IntegrationSuite) TestD_UpdateProduct() {
 	resp, err := s.productService.UpdateProduct(
 		context.Background(),
@@ -243,7 +299,7 @@ func (s *IntegrationSuite) TestD_UpdateProduct() {
 		"NewDummy1",
 		"NewDummy1",
 		true,
-		12.12,
+		money.New(1212, domain.PriceCurrency),
 	)
 
 	if s.Assert().NoError(err) {
@@ -259,7 +315,7 @@ func (s *IntegrationSuite) TestD_UpdateProduct() {
 		s.Assert().Equal("NewDummy1", repoProd.Desc)
 		s.Assert().Equal("NewDummy1", repoProd.Category)
 		s.Assert().Equal(true, repoProd.IsActive)
-		s.Assert().Equal(12.12, repoProd.Price)
+		s.Assert().Equal(money.New(1212, domain.PriceCurrency), repoProd.Price)
 	}
 }
 